@@ -0,0 +1,115 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"ris/internal/config"
+	"ris/internal/export"
+	"ris/pkg/postgres"
+)
+
+// exportUsage describes the export command, its flags are parsed with the configuration flags
+const exportUsage = "lab1 export [-format csv|ndjson|parquet|xlsx] [-o file] [-category ...] laureates|prizes|awards"
+
+// exportFlags are the flags of the export command
+type exportFlags struct {
+	format *string
+	output *string
+	filter map[string]*string
+}
+
+func newExportFlags(fs *flag.FlagSet) *exportFlags {
+	f := &exportFlags{
+		format: fs.String("format", "", "Export: file format csv, ndjson, parquet or xlsx (default: by the extension of -o, else csv)"),
+		output: fs.String("o", "", "Export: output file (default: stdout)"),
+		filter: make(map[string]*string, len(export.FilterParams)),
+	}
+	for _, name := range export.FilterParams {
+		f.filter[name] = fs.String(name, "", "Export: filter by "+strings.ReplaceAll(name, "_", " "))
+	}
+	return f
+}
+
+// runExport writes laureates, prizes or awards to a file or to stdout
+func runExport(cfg *config.Config, flags *exportFlags, entityName string) (err error) {
+	entity, ok := export.LookupEntity(entityName)
+	if !ok {
+		return fmt.Errorf("unknown entity %q, expected laureates, prizes or awards", entityName)
+	}
+	format, err := exportFormat(*flags.format, *flags.output)
+	if err != nil {
+		return err
+	}
+	filter, err := export.ParseFilter(func(name string) string { return *flags.filter[name] })
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DB.ConnectTimeout)
+	defer cancel()
+	pool, err := postgres.NewPool(ctx, cfg.DB.URL)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	exporter := export.New(pool, export.Config{
+		BatchSize: cfg.Export.BatchSize,
+		Timeout:   cfg.Export.Timeout,
+	})
+	cursor, err := exporter.Open(context.Background(), entity, filter)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = cursor.Close() }()
+
+	var out io.Writer = os.Stdout
+	if *flags.output != "" {
+		file, createErr := os.Create(*flags.output)
+		if createErr != nil {
+			return createErr
+		}
+		defer func() {
+			// A partial file is worse than none
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				_ = os.Remove(*flags.output)
+			}
+		}()
+		out = file
+	}
+	w := bufio.NewWriter(out)
+	rows, err := cursor.Copy(w, format)
+	if err == nil {
+		err = w.Flush()
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported %d %s as %s\n", rows, entity.Name, format.Name)
+	return nil
+}
+
+// exportFormat returns the format with the name, or else the format of the output file extension, CSV by default
+func exportFormat(name, output string) (export.Format, error) {
+	if name != "" {
+		format, ok := export.LookupFormat(strings.ToLower(name))
+		if !ok {
+			return export.Format{}, fmt.Errorf("unknown format %q, expected csv, ndjson, parquet or xlsx", name)
+		}
+		return format, nil
+	}
+	if format, ok := export.LookupFormat(strings.ToLower(strings.TrimPrefix(filepath.Ext(output), "."))); ok {
+		return format, nil
+	}
+	format, _ := export.LookupFormat("csv")
+	return format, nil
+}
@@ -23,35 +23,78 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/v1/categories": {
+        "/api/v1/analytics/laureates": {
             "get": {
-                "security": [
-                    {
-                        "BearerAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    },
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Returns a list of all unique prize categories",
+                "description": "Returns the numbers of awarded persons by gender and of awarded organizations per year, decade or category.\nA laureate is counted once per prize, persons without a known gender are counted as unknown.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Analytics"
+                ],
+                "summary": "Laureate breakdown",
+                "parameters": [
+                    {
+                        "enum": [
+                            "year",
+                            "decade",
+                            "category"
+                        ],
+                        "type": "string",
+                        "default": "year",
+                        "description": "Grouping",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prize category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get all categories",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.CategoriesResponse"
+                            "$ref": "#/definitions/v1.LaureateBreakdownListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "401": {
@@ -60,17 +103,25 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/laureates": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -81,57 +132,84 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns a paginated list of Nobel laureates",
+                ]
+            }
+        },
+        "/api/v1/analytics/multiple-winners": {
+            "get": {
+                "description": "Returns the laureates with several prizes and the number of laureates per number of prizes",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Laureates"
+                    "Analytics"
                 ],
-                "summary": "List laureates",
+                "summary": "Multiple winners",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
                     },
                     {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.LaureateListResponse"
+                            "$ref": "#/definitions/v1.MultipleWinnersResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "post": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -142,44 +220,79 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Creates a new Nobel laureate",
+                ]
+            }
+        },
+        "/api/v1/analytics/not-awarded": {
+            "get": {
+                "description": "Returns the years from the first prize of a category to the last year of the dataset in which the category was not awarded.\nCategories awarded every year are not listed.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Laureates"
+                    "Analytics"
                 ],
-                "summary": "Create a new laureate",
+                "summary": "Years without an award",
                 "parameters": [
                     {
-                        "description": "Laureate data",
-                        "name": "laureate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.CreateLaureateRequest"
-                        }
+                        "type": "string",
+                        "description": "Prize category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.LaureateResponse"
+                            "$ref": "#/definitions/v1.NotAwardedYearsListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -190,11 +303,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/laureates/{id}": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -205,32 +314,81 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns a single laureate by their ID",
+                ]
+            }
+        },
+        "/api/v1/analytics/prizes": {
+            "get": {
+                "description": "Returns the numbers of awarded, not awarded, solo and shared prizes and of their laureates per year, decade or category.\nAnalytics are refreshed on every import, changes made through the API are seen after the next import.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Laureates"
+                    "Analytics"
                 ],
-                "summary": "Get laureate by ID",
+                "summary": "Prize trends",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Laureate ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "enum": [
+                            "year",
+                            "decade",
+                            "category"
+                        ],
+                        "type": "string",
+                        "default": "year",
+                        "description": "Grouping",
+                        "name": "group_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prize category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.LaureateResponse"
+                            "$ref": "#/definitions/v1.PrizeTrendListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "401": {
@@ -239,8 +397,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -251,9 +415,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -264,57 +426,73 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Updates an existing Nobel laureate",
+                ]
+            }
+        },
+        "/api/v1/analytics/shared-prizes": {
+            "get": {
+                "description": "Returns the numbers of solo, shared and not awarded prizes and the number of prizes per number of laureates",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Laureates"
+                    "Analytics"
                 ],
-                "summary": "Update a laureate",
+                "summary": "Shared and solo prizes",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Laureate ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
                     },
                     {
-                        "description": "Laureate data",
-                        "name": "laureate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.UpdateLaureateRequest"
-                        }
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.LaureateResponse"
+                            "$ref": "#/definitions/v1.SharedPrizesResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "406": {
+                        "description": "Not Acceptable",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -325,9 +503,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -338,42 +514,73 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Deletes an existing Nobel laureate",
+                ]
+            }
+        },
+        "/api/v1/analytics/shares": {
+            "get": {
+                "description": "Returns the number of laureates per prize share, a laureate received 1/share of the prize",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Laureates"
+                    "Analytics"
                 ],
-                "summary": "Delete a laureate",
+                "summary": "Share distribution",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Laureate ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.SuccessResponse"
+                            "$ref": "#/definitions/v1.ShareDistributionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -384,11 +591,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/prizes": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -399,57 +602,84 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns a paginated list of Nobel prizes",
+                ]
+            }
+        },
+        "/api/v1/categories": {
+            "get": {
+                "description": "Returns all prize categories ordered by slug",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Categories"
                 ],
-                "summary": "List prizes",
+                "summary": "Get all categories",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 1,
-                        "description": "Page number",
-                        "name": "page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
                     },
                     {
-                        "maximum": 100,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "per_page",
-                        "in": "query"
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.PrizeListResponse"
+                            "$ref": "#/definitions/v1.CategoriesResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "post": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -460,26 +690,30 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Creates a new Nobel prize",
+                ]
+            },
+            "post": {
+                "description": "Creates a prize category. Prizes can be created in it from the established year.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Categories"
                 ],
-                "summary": "Create a new prize",
+                "summary": "Create a category",
                 "parameters": [
                     {
-                        "description": "Prize data",
-                        "name": "prize",
+                        "description": "Category data",
+                        "name": "category",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/v1.CreatePrizeRequest"
+                            "$ref": "#/definitions/v1.CreateCategoryRequest"
                         }
                     }
                 ],
@@ -487,7 +721,7 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.PrizeResponse"
+                            "$ref": "#/definitions/v1.CategoryResponse"
                         }
                     },
                     "400": {
@@ -502,17 +736,31 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/prizes/category/{category}": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -523,54 +771,96 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns all prizes for a specific category with their laureates",
+                ]
+            }
+        },
+        "/api/v1/categories/{slug}": {
+            "get": {
+                "description": "Returns a prize category with its localized names",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Categories"
                 ],
-                "summary": "Get prizes by category",
+                "summary": "Get a category by slug",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Prize category (e.g., physics, chemistry, medicine, literature, peace, economics)",
-                        "name": "category",
+                        "description": "Category slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.PrizeResponse"
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
                             }
                         }
                     },
+                    "304": {
+                        "description": "Not Modified"
+                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/prizes/year/{year}": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -581,35 +871,51 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns all prizes for a specific year",
+                ]
+            },
+            "put": {
+                "description": "Updates the names and the established year of a category, the slug can not be changed.\nThe established year can not be later than the first prize of the category.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Categories"
                 ],
-                "summary": "Get prizes by year",
+                "summary": "Update a category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Prize year",
-                        "name": "year",
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Category data",
+                        "name": "category",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateCategoryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/v1.PrizeResponse"
-                            }
+                            "$ref": "#/definitions/v1.CategoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "401": {
@@ -618,17 +924,31 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/prizes/{id}": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -639,23 +959,27 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns a single prize by its ID with associated laureates",
+                ]
+            },
+            "delete": {
+                "description": "Deletes a category without prizes. Deleting a missing category is not an error.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Categories"
                 ],
-                "summary": "Get prize by ID",
+                "summary": "Delete a category",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Prize ID",
-                        "name": "id",
+                        "type": "string",
+                        "description": "Category slug",
+                        "name": "slug",
                         "in": "path",
                         "required": true
                     }
@@ -664,7 +988,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.PrizeResponse"
+                            "$ref": "#/definitions/v1.SuccessResponse"
                         }
                     },
                     "401": {
@@ -673,8 +997,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
@@ -685,9 +1021,7 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "put": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -698,41 +1032,50 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Updates an existing Nobel prize",
-                "consumes": [
-                    "application/json"
-                ],
+                ]
+            }
+        },
+        "/api/v1/events/stream": {
+            "get": {
+                "description": "Sends prize and laureate events as Server-Sent Events: \"id\" is the stream sequence, \"event\" is the event type and \"data\" is the event envelope.\nHeartbeat comments are sent to idle streams. Reconnecting EventSource clients resume after the Last-Event-ID header, other clients can pass last_event_id.",
                 "produces": [
-                    "application/json"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "Prizes"
+                    "Events"
                 ],
-                "summary": "Update a prize",
+                "summary": "Stream live events (SSE)",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated event types or prize.*, laureate.*, category.*",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated prize categories, only prize events with these categories are sent",
+                        "name": "categories",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Prize ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Stream sequence of the last received event",
+                        "name": "last_event_id",
+                        "in": "query"
                     },
                     {
-                        "description": "Prize data",
-                        "name": "prize",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/v1.UpdatePrizeRequest"
-                        }
+                        "type": "integer",
+                        "description": "Stream sequence of the last received event",
+                        "name": "Last-Event-ID",
+                        "in": "header"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Event stream",
                         "schema": {
-                            "$ref": "#/definitions/v1.PrizeResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
@@ -747,21 +1090,19 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            },
-            "delete": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -772,32 +1113,41 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Deletes an existing Nobel prize",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                ]
+            }
+        },
+        "/api/v1/events/ws": {
+            "get": {
+                "description": "Upgrades to WebSocket and sends prize and laureate events as LiveEventMessage JSON messages, id is the stream sequence.\nPing frames are sent to idle connections. Reconnecting clients resume by passing the id of the last received event as last_event_id.",
                 "tags": [
-                    "Prizes"
+                    "Events"
                 ],
-                "summary": "Delete a prize",
+                "summary": "Stream live events (WebSocket)",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated event types or prize.*, laureate.*, category.*",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated prize categories, only prize events with these categories are sent",
+                        "name": "categories",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Prize ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Stream sequence of the last received event",
+                        "name": "last_event_id",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "101": {
+                        "description": "Switching Protocols",
                         "schema": {
-                            "$ref": "#/definitions/v1.SuccessResponse"
+                            "$ref": "#/definitions/v1.LiveEventMessage"
                         }
                     },
                     "400": {
@@ -812,17 +1162,157 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "426": {
+                        "description": "Upgrade Required",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
-                    }
-                }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
             }
         },
-        "/api/v1/stats": {
+        "/api/v1/export/{entity}": {
             "get": {
+                "description": "Streams all laureates, prizes or awards (a row per laureate of a prize) matching the filters, read through a database cursor.\nThe format is chosen by the format parameter or else by the Accept header, CSV by default.\nLaureates are filtered by name and share, prizes by category and years, awards by all of them.\nA failing export ends the stream early: Parquet and XLSX files are incomplete then, CSV and NDJSON files are cut off.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.apache.parquet",
+                    "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+                ],
+                "tags": [
+                    "Export"
+                ],
+                "summary": "Export the dataset",
+                "parameters": [
+                    {
+                        "enum": [
+                            "laureates",
+                            "prizes",
+                            "awards"
+                        ],
+                        "type": "string",
+                        "description": "Exported entity",
+                        "name": "entity",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ndjson",
+                            "parquet",
+                            "xlsx"
+                        ],
+                        "type": "string",
+                        "description": "File format",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Prize category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Prize year, overrides year_from and year_to",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "First prize year",
+                        "name": "year_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Last prize year",
+                        "name": "year_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Part of the first name or surname of laureates, case insensitive",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Prize share of laureates",
+                        "name": "share",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Exported rows",
+                        "schema": {
+                            "type": "file"
+                        },
+                        "headers": {
+                            "Content-Disposition": {
+                                "type": "string",
+                                "description": "attachment with the file name"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -833,23 +1323,117 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns count of laureates, prizes, and categories",
+                ]
+            }
+        },
+        "/api/v1/laureates": {
+            "get": {
+                "description": "Returns a paginated list of Nobel laureates, optionally filtered by type, gender, birth country and birth years.\nLaureates with an unknown birth date do not match birth years.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
                 ],
                 "tags": [
-                    "Stats"
+                    "Laureates"
+                ],
+                "summary": "List laureates",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "person",
+                            "organization"
+                        ],
+                        "type": "string",
+                        "description": "Laureate type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "male",
+                            "female",
+                            "other"
+                        ],
+                        "type": "string",
+                        "description": "Gender",
+                        "name": "gender",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Code or part of the name of the birth country",
+                        "name": "country",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "First birth year",
+                        "name": "born_from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Last birth year",
+                        "name": "born_to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
                 ],
-                "summary": "Get dataset statistics",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/v1.StatsResponse"
+                            "$ref": "#/definitions/v1.LaureateListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "401": {
@@ -858,17 +1442,25 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        },
-        "/api/v1/stats/last-update": {
-            "get": {
+                },
                 "security": [
                     {
                         "BearerAuth": []
@@ -879,23 +1471,44 @@ const docTemplate = `{
                     {
                         "ApiKeyAuth": []
                     }
-                ],
-                "description": "Returns the timestamp of the last dataset update",
+                ]
+            },
+            "post": {
+                "description": "Creates a new Nobel laureate",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
                 ],
                 "tags": [
-                    "Stats"
+                    "Laureates"
+                ],
+                "summary": "Create a new laureate",
+                "parameters": [
+                    {
+                        "description": "Laureate data",
+                        "name": "laureate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateLaureateRequest"
+                        }
+                    }
                 ],
-                "summary": "Get last update timestamp",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/v1.LastUpdateResponse"
+                            "$ref": "#/definitions/v1.LaureateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
                     "401": {
@@ -904,110 +1517,2434 @@ const docTemplate = `{
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/v1.ErrorResponse"
                         }
                     }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "v1.CategoriesResponse": {
-            "description": "List of prize categories",
-            "type": "object",
-            "properties": {
-                "categories": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
                     }
-                }
+                ]
             }
         },
-        "v1.CreateLaureateRequest": {
-            "description": "Create laureate request body",
-            "type": "object",
-            "required": [
-                "firstname",
-                "id",
-                "motivation",
-                "share"
-            ],
-            "properties": {
-                "firstname": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "motivation": {
+        "/api/v1/laureates/{id}": {
+            "get": {
+                "description": "Returns a single laureate by their ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Laureates"
+                ],
+                "summary": "Get laureate by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Laureate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LaureateResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Updates an existing Nobel laureate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Laureates"
+                ],
+                "summary": "Update a laureate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Laureate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Laureate data",
+                        "name": "laureate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateLaureateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LaureateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Deletes an existing Nobel laureate",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Laureates"
+                ],
+                "summary": "Delete a laureate",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Laureate ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/prizes": {
+            "get": {
+                "description": "Returns a paginated list of Nobel prizes",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "List prizes",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Page number",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "per_page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PrizeListResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Creates a new Nobel prize",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Create a new prize",
+                "parameters": [
+                    {
+                        "description": "Prize data",
+                        "name": "prize",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreatePrizeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PrizeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/prizes/category/{category}": {
+            "get": {
+                "description": "Returns all prizes for a specific category with their laureates, the category is matched case-insensitively",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Get prizes by category",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Prize category (e.g., physics, chemistry, medicine, literature, peace, economics)",
+                        "name": "category",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.PrizeResponse"
+                            }
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/prizes/year/{year}": {
+            "get": {
+                "description": "Returns all prizes for a specific year",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Get prizes by year",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Prize year",
+                        "name": "year",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/v1.PrizeResponse"
+                            }
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/prizes/{id}": {
+            "get": {
+                "description": "Returns a single prize by its ID with associated laureates",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Get prize by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Prize ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PrizeResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Updates an existing Nobel prize",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Update a prize",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Prize ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Prize data",
+                        "name": "prize",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdatePrizeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PrizeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Deletes an existing Nobel prize",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Delete a prize",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Prize ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/prizes/{id}/laureates/{laureateId}": {
+            "put": {
+                "description": "Adds an existing laureate to an existing prize. Linking an already linked laureate does nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Link a laureate to a prize",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Prize ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Laureate ID",
+                        "name": "laureateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PrizeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Removes a laureate from a prize. Unlinking a not linked laureate does nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Prizes"
+                ],
+                "summary": "Unlink a laureate from a prize",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Prize ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Laureate ID",
+                        "name": "laureateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.PrizeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/stats": {
+            "get": {
+                "description": "Returns count of laureates, prizes, and categories",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get dataset statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.StatsResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/stats/last-update": {
+            "get": {
+                "description": "Returns the timestamp of the last dataset update",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Stats"
+                ],
+                "summary": "Get last update timestamp",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ETag of a previously received response",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last-Modified of a previously received response",
+                        "name": "If-Modified-Since",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.LastUpdateResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Entity tag of the response"
+                            },
+                            "Last-Modified": {
+                                "type": "string",
+                                "description": "Last dataset update time"
+                            }
+                        }
+                    },
+                    "304": {
+                        "description": "Not Modified"
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/webhooks": {
+            "get": {
+                "description": "Returns all webhook subscriptions without their secrets",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "post": {
+                "description": "Subscribes a URL to events. Event types are exact (prize.created), all events of an entity (prize.*) or all events (*).\nDeliveries are signed with the secret, it is returned only in this response.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/webhooks/{id}": {
+            "get": {
+                "description": "Returns a webhook subscription without its secret",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Get a webhook by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "put": {
+                "description": "Updates a webhook subscription. Activating a disabled webhook clears its failures.\nThe secret is returned only when it is set or rotated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/v1.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            },
+            "delete": {
+                "description": "Deletes a webhook subscription with its delivery history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries": {
+            "get": {
+                "description": "Returns the latest deliveries of a webhook, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml",
+                    "text/csv"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "List webhook deliveries",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "delivered",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "Delivery status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Number of deliveries",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/v1.WebhookDeliveryListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/api/v1/webhooks/{id}/deliveries/{deliveryId}/redeliver": {
+            "post": {
+                "description": "Schedules a delivery to be sent again now, a failed delivery gets a single attempt",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/xml",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "Webhooks"
+                ],
+                "summary": "Redeliver an event",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Delivery ID",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "$ref": "#/definitions/v1.SuccessResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "406": {
+                        "description": "Not Acceptable",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/v1.ErrorResponse"
+                        }
+                    }
+                },
+                "security": [
+                    {
+                        "BearerAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    },
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ]
+            }
+        },
+        "/livez": {
+            "get": {
+                "description": "Returns 200 while the process is able to serve requests. Dependencies are not checked.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Liveness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Checks PostgreSQL, NATS and the database schema version. Fails during graceful shutdown.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "summary": "Readiness probe",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.Response"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "health.CheckResult": {
+            "description": "Dependency check result",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "latency_ms": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.Response": {
+            "description": "Service health status",
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.CheckResult"
+                    }
+                },
+                "service": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.AffiliationResponse": {
+            "description": "University or institute where the laureate did the awarded work. The prize is set when the laureate is not listed within a prize.",
+            "type": "object",
+            "properties": {
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "prize_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.CategoriesResponse": {
+            "description": "List of prize categories",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.CategoryResponse"
+                    }
+                }
+            }
+        },
+        "v1.CategoryResponse": {
+            "description": "Prize category, prizes refer to it by slug",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "established_year": {
+                    "type": "integer"
+                },
+                "localized_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "ru": "Физика"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "slug": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.CreateCategoryRequest": {
+            "description": "Create category request body. The slug is lower-case letters, digits and dashes, it can not be changed.",
+            "type": "object",
+            "required": [
+                "established_year",
+                "localized_names",
+                "name",
+                "slug"
+            ],
+            "properties": {
+                "established_year": {
+                    "type": "integer",
+                    "minimum": 1901,
+                    "example": 2030
+                },
+                "localized_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "ru": "Математика"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Mathematics"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "mathematics"
+                }
+            }
+        },
+        "v1.CreateLaureateRequest": {
+            "description": "Create laureate request body, a laureate is a person unless the type is organization",
+            "type": "object",
+            "required": [
+                "firstname",
+                "id",
+                "motivation",
+                "share"
+            ],
+            "properties": {
+                "birth_city": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "birth_country": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "birth_country_code": {
+                    "type": "string",
+                    "example": "PL"
+                },
+                "birth_date": {
+                    "type": "string",
+                    "example": "1867-11-07"
+                },
+                "death_city": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "death_country": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "death_country_code": {
+                    "type": "string"
+                },
+                "death_date": {
+                    "type": "string"
+                },
+                "firstname": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female",
+                        "other"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "motivation": {
+                    "type": "string"
+                },
+                "share": {
+                    "type": "integer",
+                    "maximum": 4,
+                    "minimum": 1
+                },
+                "surname": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "organization"
+                    ]
+                }
+            }
+        },
+        "v1.CreatePrizeRequest": {
+            "description": "Create prize request body, the category is the slug of an existing category",
+            "type": "object",
+            "required": [
+                "category",
+                "year"
+            ],
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "example": "physics"
+                },
+                "laureate_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "year": {
+                    "type": "integer",
+                    "minimum": 1901
+                }
+            }
+        },
+        "v1.CreateWebhookRequest": {
+            "description": "Create webhook request body, a secret is generated when it is not set",
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "prize.created",
+                        "laureate.*"
+                    ]
+                },
+                "secret": {
+                    "type": "string",
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.ErrorResponse": {
+            "description": "Error response with message",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LastUpdateResponse": {
+            "description": "Last dataset update information",
+            "type": "object",
+            "properties": {
+                "last_update": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LaureateBreakdownListResponse": {
+            "description": "Awarded persons by gender and organizations grouped by year, decade or category",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LaureateBreakdownResponse"
+                    }
+                },
+                "group_by": {
+                    "type": "string",
+                    "enum": [
+                        "year",
+                        "decade",
+                        "category"
+                    ]
+                }
+            }
+        },
+        "v1.LaureateBreakdownResponse": {
+            "description": "Awarded persons by gender and organizations of a year, a decade or a category",
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "decade": {
+                    "type": "integer"
+                },
+                "female_count": {
+                    "type": "integer"
+                },
+                "male_count": {
+                    "type": "integer"
+                },
+                "organizations_count": {
+                    "type": "integer"
+                },
+                "other_gender_count": {
+                    "type": "integer"
+                },
+                "persons_count": {
+                    "type": "integer"
+                },
+                "unknown_gender_count": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.LaureateListResponse": {
+            "description": "List of laureates with pagination info",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LaureateResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "per_page": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.LaureateResponse": {
+            "description": "Nobel laureate information, a person or an organization. Dates are YYYY-MM-DD, the birth of an organization is its foundation.",
+            "type": "object",
+            "properties": {
+                "affiliations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.AffiliationResponse"
+                    }
+                },
+                "birth_city": {
+                    "type": "string"
+                },
+                "birth_country": {
+                    "type": "string"
+                },
+                "birth_country_code": {
+                    "type": "string",
+                    "example": "PL"
+                },
+                "birth_date": {
+                    "type": "string",
+                    "example": "1867-11-07"
+                },
+                "death_city": {
+                    "type": "string"
+                },
+                "death_country": {
+                    "type": "string"
+                },
+                "death_country_code": {
+                    "type": "string"
+                },
+                "death_date": {
+                    "type": "string"
+                },
+                "firstname": {
+                    "type": "string"
+                },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female",
+                        "other"
+                    ]
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "motivation": {
+                    "type": "string"
+                },
+                "share": {
+                    "type": "integer"
+                },
+                "surname": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "organization"
+                    ]
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.LaureatesPerPrizeResponse": {
+            "description": "Number of prizes with the number of laureates",
+            "type": "object",
+            "properties": {
+                "laureates_count": {
+                    "type": "integer"
+                },
+                "prizes_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.LiveEventMessage": {
+            "description": "Event sent over WebSocket, data is the event envelope",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "object"
+                },
+                "event": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.MultipleWinnerResponse": {
+            "description": "Laureate with several prizes",
+            "type": "object",
+            "properties": {
+                "first_year": {
+                    "type": "integer"
+                },
+                "firstname": {
                     "type": "string"
                 },
-                "share": {
-                    "type": "integer",
-                    "maximum": 4,
-                    "minimum": 1
+                "id": {
+                    "type": "integer"
+                },
+                "last_year": {
+                    "type": "integer"
+                },
+                "prizes_count": {
+                    "type": "integer"
                 },
                 "surname": {
                     "type": "string"
                 }
             }
         },
-        "v1.CreatePrizeRequest": {
-            "description": "Create prize request body",
+        "v1.MultipleWinnersResponse": {
+            "description": "Laureates with several prizes and the distribution of prizes per laureate",
             "type": "object",
-            "required": [
-                "category",
-                "year"
-            ],
             "properties": {
-                "category": {
-                    "type": "string"
-                },
-                "laureate_ids": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/v1.MultipleWinnerResponse"
                     }
                 },
-                "year": {
-                    "type": "integer",
-                    "minimum": 1901
+                "distribution": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.PrizesPerLaureateResponse"
+                    }
                 }
             }
         },
-        "v1.ErrorResponse": {
-            "description": "Error response with message",
+        "v1.NotAwardedYearsListResponse": {
+            "description": "Years without an awarded prize per category, from the first prize of the category to the last year of the dataset",
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string"
-                },
-                "message": {
-                    "type": "string"
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.NotAwardedYearsResponse"
+                    }
                 }
             }
         },
-        "v1.LastUpdateResponse": {
-            "description": "Last dataset update information",
+        "v1.NotAwardedYearsResponse": {
+            "description": "Years without an awarded prize of a category",
             "type": "object",
             "properties": {
-                "last_update": {
+                "category": {
                     "type": "string"
+                },
+                "years": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 }
             }
         },
-        "v1.LaureateListResponse": {
-            "description": "List of laureates with pagination info",
+        "v1.PrizeListResponse": {
+            "description": "List of prizes with pagination info",
             "type": "object",
             "properties": {
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v1.LaureateResponse"
+                        "$ref": "#/definitions/v1.PrizeResponse"
                     }
                 },
                 "page": {
@@ -1024,74 +3961,136 @@ const docTemplate = `{
                 }
             }
         },
-        "v1.LaureateResponse": {
-            "description": "Nobel laureate information",
+        "v1.PrizeResponse": {
+            "description": "Nobel prize information",
             "type": "object",
             "properties": {
-                "firstname": {
+                "category": {
                     "type": "string"
                 },
                 "id": {
                     "type": "integer"
                 },
-                "motivation": {
-                    "type": "string"
-                },
-                "share": {
-                    "type": "integer"
-                },
-                "surname": {
-                    "type": "string"
+                "laureates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LaureateResponse"
+                    }
                 },
                 "updated_at": {
                     "type": "string"
+                },
+                "year": {
+                    "type": "integer"
                 }
             }
         },
-        "v1.PrizeListResponse": {
-            "description": "List of prizes with pagination info",
+        "v1.PrizeTrendListResponse": {
+            "description": "Prize counts grouped by year, decade or category",
             "type": "object",
             "properties": {
+                "category": {
+                    "type": "string"
+                },
                 "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v1.PrizeResponse"
+                        "$ref": "#/definitions/v1.PrizeTrendResponse"
                     }
                 },
-                "page": {
+                "group_by": {
+                    "type": "string",
+                    "enum": [
+                        "year",
+                        "decade",
+                        "category"
+                    ]
+                }
+            }
+        },
+        "v1.PrizeTrendResponse": {
+            "description": "Prize counts of a year, a decade or a category",
+            "type": "object",
+            "properties": {
+                "awarded_count": {
                     "type": "integer"
                 },
-                "per_page": {
+                "category": {
+                    "type": "string"
+                },
+                "decade": {
                     "type": "integer"
                 },
-                "total": {
+                "laureates_count": {
                     "type": "integer"
                 },
-                "total_pages": {
+                "not_awarded_count": {
+                    "type": "integer"
+                },
+                "shared_count": {
+                    "type": "integer"
+                },
+                "solo_count": {
+                    "type": "integer"
+                },
+                "year": {
                     "type": "integer"
                 }
             }
         },
-        "v1.PrizeResponse": {
-            "description": "Nobel prize information",
+        "v1.PrizesPerLaureateResponse": {
+            "description": "Number of laureates with the number of prizes",
             "type": "object",
             "properties": {
-                "category": {
-                    "type": "string"
+                "laureates_count": {
+                    "type": "integer"
                 },
-                "id": {
+                "prizes_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ShareCountResponse": {
+            "description": "Number of laureates with the share, a laureate received 1/share of the prize",
+            "type": "object",
+            "properties": {
+                "laureates_count": {
                     "type": "integer"
                 },
-                "laureates": {
+                "share": {
+                    "type": "integer"
+                }
+            }
+        },
+        "v1.ShareDistributionResponse": {
+            "description": "Distribution of prize shares of laureates",
+            "type": "object",
+            "properties": {
+                "data": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/v1.LaureateResponse"
+                        "$ref": "#/definitions/v1.ShareCountResponse"
+                    }
+                }
+            }
+        },
+        "v1.SharedPrizesResponse": {
+            "description": "Shared, solo and not awarded prizes",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.LaureatesPerPrizeResponse"
                     }
                 },
-                "updated_at": {
-                    "type": "string"
+                "not_awarded_count": {
+                    "type": "integer"
                 },
-                "year": {
+                "shared_count": {
+                    "type": "integer"
+                },
+                "solo_count": {
                     "type": "integer"
                 }
             }
@@ -1120,8 +4119,38 @@ const docTemplate = `{
                 }
             }
         },
+        "v1.UpdateCategoryRequest": {
+            "description": "Update category request body, localized names are replaced",
+            "type": "object",
+            "required": [
+                "established_year",
+                "localized_names",
+                "name"
+            ],
+            "properties": {
+                "established_year": {
+                    "type": "integer",
+                    "minimum": 1901,
+                    "example": 2030
+                },
+                "localized_names": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    },
+                    "example": {
+                        "ru": "Математика"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "example": "Mathematics"
+                }
+            }
+        },
         "v1.UpdateLaureateRequest": {
-            "description": "Update laureate request body",
+            "description": "Update laureate request body. The bio data is replaced when any of it is given and kept otherwise, the type is kept when omitted.",
             "type": "object",
             "required": [
                 "firstname",
@@ -1129,9 +4158,47 @@ const docTemplate = `{
                 "share"
             ],
             "properties": {
+                "birth_city": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "birth_country": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "birth_country_code": {
+                    "type": "string",
+                    "example": "PL"
+                },
+                "birth_date": {
+                    "type": "string",
+                    "example": "1867-11-07"
+                },
+                "death_city": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "death_country": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "death_country_code": {
+                    "type": "string"
+                },
+                "death_date": {
+                    "type": "string"
+                },
                 "firstname": {
                     "type": "string"
                 },
+                "gender": {
+                    "type": "string",
+                    "enum": [
+                        "male",
+                        "female",
+                        "other"
+                    ]
+                },
                 "motivation": {
                     "type": "string"
                 },
@@ -1142,11 +4209,18 @@ const docTemplate = `{
                 },
                 "surname": {
                     "type": "string"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "person",
+                        "organization"
+                    ]
                 }
             }
         },
         "v1.UpdatePrizeRequest": {
-            "description": "Update prize request body",
+            "description": "Update prize request body, the category is the slug of an existing category",
             "type": "object",
             "required": [
                 "category",
@@ -1154,13 +4228,162 @@ const docTemplate = `{
             ],
             "properties": {
                 "category": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "physics"
                 },
                 "year": {
                     "type": "integer",
                     "minimum": 1901
                 }
             }
+        },
+        "v1.UpdateWebhookRequest": {
+            "description": "Update webhook request body, the secret is kept unless it is set or rotated",
+            "type": "object",
+            "required": [
+                "active",
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "prize.created",
+                        "laureate.*"
+                    ]
+                },
+                "rotate_secret": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "type": "string",
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "v1.WebhookDeliveryListResponse": {
+            "description": "Latest deliveries of a webhook",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.WebhookDeliveryResponse"
+                    }
+                }
+            }
+        },
+        "v1.WebhookDeliveryResponse": {
+            "description": "Webhook delivery attempt history",
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "completed_at": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt_at": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "delivered",
+                        "failed"
+                    ]
+                }
+            }
+        },
+        "v1.WebhookListResponse": {
+            "description": "List of webhook subscriptions",
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/v1.WebhookResponse"
+                    }
+                }
+            }
+        },
+        "v1.WebhookResponse": {
+            "description": "Webhook subscription, the secret is returned only when it is created or rotated",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "consecutive_failures": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "disabled_at": {
+                    "type": "string"
+                },
+                "disabled_reason": {
+                    "type": "string"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -1180,7 +4403,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{},
 	Title:            "Nobel Prize API",
-	Description:      "This is a sample swagger for app",
+	Description:      "This is a sample swagger for app\nResponses are JSON by default, the Accept header selects XML (application/xml), YAML (application/yaml) or, for lists, CSV (text/csv).\nErrors are sent in the negotiated format, a response without an acceptable format is 406 Not Acceptable.",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
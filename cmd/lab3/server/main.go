@@ -6,12 +6,14 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"encoding/xml"
+	"flag"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
 	"os"
 	"path/filepath"
+	"ris/internal/config"
 	"strings"
 	"sync"
 	"time"
@@ -112,31 +114,54 @@ type FileStorage struct {
 var (
 	storage    *FileStorage
 	startTime  time.Time
-	validUsers = map[string]string{
+	validUsers map[string]string
+)
+
+func main() {
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Auth.Users = map[string]string{
 		"user1": "pass1",
 		"user2": "pass2",
 		"admin": "admin",
 	}
-)
 
-func init() {
-	startTime = time.Now()
-	storageDir := "./uploaded_files"
-	os.MkdirAll(storageDir, 0755)
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) > 0 {
+		log.Fatalf("Unknown command: %v", args)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
 
+	startTime = time.Now()
+	validUsers = cfg.Auth.Users
+	if err := os.MkdirAll(cfg.Storage.Dir, 0755); err != nil {
+		log.Fatalf("Failed to create storage directory: %v", err)
+	}
 	storage = &FileStorage{
 		files:      make(map[string]FileInfo),
-		storageDir: storageDir,
-		maxStorage: 100 * 1024 * 1024, // 100 MB max storage
+		storageDir: cfg.Storage.Dir,
+		maxStorage: cfg.Storage.MaxSize,
 	}
-}
 
-func main() {
 	http.HandleFunc("/soap", handleSOAP)
 
-	log.Println("SOAP Server starting on :8080")
-	log.Println("WSDL available at: http://localhost:8080/soap?wsdl")
-	if err := http.ListenAndServe(":8080", nil); err != nil {
+	log.Printf("SOAP Server starting on %s", cfg.HTTP.Addr())
+	log.Printf("WSDL available at: http://localhost:%d/soap?wsdl", cfg.HTTP.Port)
+	if err := http.ListenAndServe(cfg.HTTP.Addr(), nil); err != nil {
 		log.Fatal(err)
 	}
 }
@@ -146,7 +171,7 @@ func handleSOAP(w http.ResponseWriter, r *http.Request) {
 	// Check for WSDL request first
 	if _, exists := r.URL.Query()["wsdl"]; exists {
 		w.Header().Set("Content-Type", "text/xml")
-		w.Write([]byte(getWSDL()))
+		w.Write([]byte(getWSDL("http://" + r.Host + "/soap")))
 		return
 	}
 
@@ -459,7 +484,7 @@ func sendSOAPFault(w http.ResponseWriter, code, message string) {
 	xml.NewEncoder(w).Encode(envelope)
 }
 
-func getWSDL() string {
+func getWSDL(location string) string {
 	return `<?xml version="1.0" encoding="UTF-8"?>
 <definitions name="FileService"
    targetNamespace="http://tempuri.org/"
@@ -631,7 +656,7 @@ func getWSDL() string {
    <service name="FileService">
       <documentation>File Upload and Management Service</documentation>
       <port name="FileServicePort" binding="tns:FileServiceBinding">
-         <soap:address location="http://localhost:8080/soap"/>
+         <soap:address location="` + location + `"/>
       </port>
    </service>
 </definitions>`
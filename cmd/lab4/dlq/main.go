@@ -0,0 +1,203 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"ris/internal/config"
+	"ris/internal/dlq"
+
+	"github.com/nats-io/nats.go"
+)
+
+const usage = `Usage: dlq [flags] <command>
+
+Commands:
+  list              list dead-lettered events
+  show <seq>        print a dead-lettered event with its headers
+  replay <seq>...   publish events to their original subjects and remove them from the DLQ
+  replay all        replay every dead-lettered event
+  delete <seq>...   remove events from the DLQ
+  purge             remove all dead-lettered events
+`
+
+func main() {
+	limit := flag.Int("limit", 100, "Maximum number of events listed (0 for all)")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage, "\nFlags:\n")
+		flag.PrintDefaults()
+	}
+
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Log.AddSource = false
+
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
+
+	natsConn, err := nats.Connect(cfg.NATS.URL)
+	if err != nil {
+		slog.Error("Failed to connect to NATS", "error", err)
+		os.Exit(1)
+	}
+	defer natsConn.Close()
+	js, err := natsConn.JetStream()
+	if err != nil {
+		slog.Error("Failed to create JetStream context", "error", err)
+		os.Exit(1)
+	}
+
+	if err := run(js, args, *limit); err != nil {
+		slog.Error("Command failed", "command", args[0], "error", err)
+		os.Exit(1)
+	}
+}
+
+func run(js nats.JetStreamContext, args []string, limit int) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	switch cmd, params := args[0], args[1:]; {
+	case cmd == "list" && len(params) == 0:
+		entries, err := dlq.List(js, limit)
+		if err != nil {
+			return err
+		}
+		printList(entries)
+		return nil
+
+	case cmd == "show" && len(params) == 1:
+		seqs, err := parseSequences(params)
+		if err != nil {
+			return err
+		}
+		entry, err := dlq.Get(js, seqs[0])
+		if err != nil {
+			return err
+		}
+		printEntry(entry)
+		return nil
+
+	case cmd == "replay" && len(params) == 1 && params[0] == "all":
+		entries, err := dlq.List(js, 0)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := dlq.Replay(ctx, js, entry.Sequence); err != nil {
+				return err
+			}
+		}
+		fmt.Printf("Replayed %d events\n", len(entries))
+		return nil
+
+	case cmd == "replay" && len(params) > 0:
+		seqs, err := parseSequences(params)
+		if err != nil {
+			return err
+		}
+		for _, seq := range seqs {
+			if err := dlq.Replay(ctx, js, seq); err != nil {
+				return err
+			}
+			fmt.Printf("Replayed %d\n", seq)
+		}
+		return nil
+
+	case cmd == "delete" && len(params) > 0:
+		seqs, err := parseSequences(params)
+		if err != nil {
+			return err
+		}
+		for _, seq := range seqs {
+			if err := dlq.Delete(js, seq); err != nil {
+				return err
+			}
+			fmt.Printf("Deleted %d\n", seq)
+		}
+		return nil
+
+	case cmd == "purge" && len(params) == 0:
+		if err := dlq.Purge(js); err != nil {
+			return err
+		}
+		fmt.Println("Purged")
+		return nil
+	}
+
+	flag.Usage()
+	return fmt.Errorf("invalid command %v", args)
+}
+
+func parseSequences(params []string) ([]uint64, error) {
+	seqs := make([]uint64, len(params))
+	for i, param := range params {
+		seq, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sequence %q", param)
+		}
+		seqs[i] = seq
+	}
+	return seqs, nil
+}
+
+func printList(entries []dlq.Entry) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SEQ\tSUBJECT\tREASON\tDELIVERIES\tFAILED AT\tCONSUMER\tERROR")
+	for _, e := range entries {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n", e.Sequence, e.Subject, e.Reason, e.Deliveries,
+			e.FailedAt.Local().Format(time.DateTime), e.Consumer, e.Error)
+	}
+	w.Flush()
+}
+
+func printEntry(e *dlq.Entry) {
+	fmt.Printf("Sequence: %d\n", e.Sequence)
+	fmt.Printf("Subject: %s\n", e.Subject)
+	fmt.Printf("Reason: %s\n", e.Reason)
+	fmt.Printf("Error: %s\n", e.Error)
+	fmt.Printf("Consumer: %s\n", e.Consumer)
+	fmt.Printf("Stream sequence: %d\n", e.StreamSequence)
+	fmt.Printf("Deliveries: %d\n", e.Deliveries)
+	fmt.Printf("Failed at: %s\n", e.FailedAt.Format(time.RFC3339))
+	fmt.Println("Headers:")
+	for k, v := range e.Header {
+		fmt.Printf("  %s: %v\n", k, v)
+	}
+
+	fmt.Println("Data:")
+	var data bytes.Buffer
+	if err := json.Indent(&data, e.Data, "", "  "); err != nil {
+		// Not JSON, e.g. dead-lettered because it could not be decoded
+		fmt.Printf("%q\n", e.Data)
+		return
+	}
+	fmt.Println(data.String())
+}
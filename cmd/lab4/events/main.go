@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"log/slog"
+	"maps"
+	"os"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"ris/internal/config"
+	"ris/internal/history"
+
+	"github.com/nats-io/nats.go"
+)
+
+const usage = `Usage: events [flags] <command>
+
+Commands:
+  info   print the state of the events stream, events per subject and consumers
+  dump   print stored events matching the flags, with -follow keep printing new ones
+
+Times are RFC3339 ("2026-10-19T08:00:00Z"), dates ("2026-10-19") or durations before now ("90m").
+`
+
+// filters collects repeated -where flags
+type filters []history.Filter
+
+func (f *filters) String() string {
+	parts := make([]string, len(*f))
+	for i, filter := range *f {
+		parts[i] = filter.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+func (f *filters) Set(s string) error {
+	filter, err := history.ParseFilter(s)
+	if err != nil {
+		return err
+	}
+	*f = append(*f, filter)
+	return nil
+}
+
+func main() {
+	var where filters
+	subject := flag.String("subject", "", "Subject of events, may contain wildcards (all by default)")
+	fromSeq := flag.Uint64("from", 0, "First stream sequence")
+	toSeq := flag.Uint64("to", 0, "Last stream sequence")
+	since := flag.String("since", "", "Events stored at or after the time")
+	until := flag.String("until", "", "Events stored at or before the time")
+	flag.Var(&where, "where", "Payload field condition: field=value, field!=value or field~substring, repeatable (e.g. current.category=physics)")
+	limit := flag.Int("limit", 0, "Maximum number of events printed (0 for all)")
+	format := flag.String("format", "table", "Output format: table, json or ndjson")
+	follow := flag.Bool("follow", false, "Keep printing new events until interrupted")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage, "\nFlags:\n")
+		flag.PrintDefaults()
+	}
+
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Log.AddSource = false
+
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
+
+	q := history.Query{
+		Subject: *subject,
+		FromSeq: *fromSeq,
+		ToSeq:   *toSeq,
+		Where:   where,
+		Limit:   *limit,
+		Follow:  *follow,
+	}
+	now := time.Now()
+	if q.Since, err = parseTime(*since, now); err != nil {
+		log.Fatalf("Invalid -since: %v", err)
+	}
+	if q.Until, err = parseTime(*until, now); err != nil {
+		log.Fatalf("Invalid -until: %v", err)
+	}
+	out, err := newOutput(*format, os.Stdout)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	natsConn, err := nats.Connect(cfg.NATS.URL)
+	if err != nil {
+		slog.Error("Failed to connect to NATS", "error", err)
+		os.Exit(1)
+	}
+	defer natsConn.Close()
+	js, err := natsConn.JetStream()
+	if err != nil {
+		slog.Error("Failed to create JetStream context", "error", err)
+		os.Exit(1)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	switch args[0] {
+	case "info":
+		err = info(ctx, js, *format)
+	case "dump":
+		err = dump(ctx, js, q, out)
+	default:
+		flag.Usage()
+		err = fmt.Errorf("invalid command %v", args)
+	}
+	if err != nil {
+		slog.Error("Command failed", "command", args[0], "error", err)
+		os.Exit(1)
+	}
+}
+
+// parseTime parses a time, a date or a duration before now; an empty string is the zero time
+func parseTime(s string, now time.Time) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation(time.DateOnly, s, time.Local); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("%q is not a time, a date or a duration", s)
+}
+
+func dump(ctx context.Context, js nats.JetStreamContext, q history.Query, out output) error {
+	if err := out.Begin(); err != nil {
+		return err
+	}
+	err := history.Read(ctx, js, q, out.Write)
+	if endErr := out.End(); err == nil {
+		err = endErr
+	}
+	return err
+}
+
+func info(ctx context.Context, js nats.JetStreamContext, format string) error {
+	info, err := history.Stat(ctx, js)
+	if err != nil {
+		return err
+	}
+	if format != "table" {
+		enc := json.NewEncoder(os.Stdout)
+		if format == "json" {
+			enc.SetIndent("", "  ")
+		}
+		return enc.Encode(info)
+	}
+
+	si := info.Stream
+	fmt.Printf("Stream: %s\n", si.Config.Name)
+	fmt.Printf("Subjects: %s\n", strings.Join(si.Config.Subjects, ", "))
+	fmt.Printf("Storage: %s, replicas: %d, max age: %s\n", si.Config.Storage, si.Config.Replicas, si.Config.MaxAge)
+	fmt.Printf("Events: %d (%d bytes)\n", si.State.Msgs, si.State.Bytes)
+	if si.State.Msgs > 0 {
+		fmt.Printf("First: %d at %s\n", si.State.FirstSeq, si.State.FirstTime.Local().Format(time.DateTime))
+		fmt.Printf("Last: %d at %s\n", si.State.LastSeq, si.State.LastTime.Local().Format(time.DateTime))
+	}
+	if info.DeadLetters >= 0 {
+		fmt.Printf("Dead letters: %d\n", info.DeadLetters)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\nSUBJECT\tEVENTS")
+	for _, subject := range slices.Sorted(maps.Keys(si.State.Subjects)) {
+		fmt.Fprintf(w, "%s\t%d\n", subject, si.State.Subjects[subject])
+	}
+	fmt.Fprintln(w, "\nCONSUMER\tFILTER\tDELIVERED\tACKED\tPENDING\tUNACKED\tREDELIVERED")
+	for _, ci := range info.Consumers {
+		name := ci.Name
+		if ci.Config.Durable == "" {
+			name += " (ephemeral)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\n", name, ci.Config.FilterSubject, ci.Delivered.Stream,
+			ci.AckFloor.Stream, ci.NumPending, ci.NumAckPending, ci.NumRedelivered)
+	}
+	return w.Flush()
+}
+
+// output prints records in a format
+type output interface {
+	Begin() error
+	Write(history.Record) error
+	End() error
+}
+
+func newOutput(format string, w io.Writer) (output, error) {
+	switch format {
+	case "table":
+		return &tableOutput{w: w}, nil
+	case "json":
+		return &jsonOutput{w: w}, nil
+	case "ndjson":
+		return &ndjsonOutput{enc: json.NewEncoder(w)}, nil
+	}
+	return nil, fmt.Errorf("invalid format %q, expected table, json or ndjson", format)
+}
+
+// payloadWidth is the maximum width of the payload column of the table
+const payloadWidth = 100
+
+// tableOutput prints a row per event. Columns have fixed widths so rows can be printed as they arrive.
+type tableOutput struct {
+	w io.Writer
+}
+
+func (o *tableOutput) Begin() error {
+	_, err := fmt.Fprintf(o.w, "%-8s %-19s %-26s %-8s %s\n", "SEQ", "TIME", "SUBJECT", "ENTITY", "PAYLOAD")
+	return err
+}
+
+func (o *tableOutput) Write(r history.Record) error {
+	payload := string(r.Payload)
+	if r.Error != "" {
+		payload = "invalid: " + r.Error
+	}
+	if runes := []rune(payload); len(runes) > payloadWidth {
+		payload = string(runes[:payloadWidth-3]) + "..."
+	}
+	_, err := fmt.Fprintf(o.w, "%-8d %-19s %-26s %-8s %s\n", r.Sequence, r.Time.Local().Format(time.DateTime),
+		r.Subject, r.Metadata.EntityID, payload)
+	return err
+}
+
+func (o *tableOutput) End() error {
+	return nil
+}
+
+// jsonOutput prints an array of events, elements are printed as they arrive
+type jsonOutput struct {
+	w     io.Writer
+	count int
+}
+
+func (o *jsonOutput) Begin() error {
+	_, err := io.WriteString(o.w, "[")
+	return err
+}
+
+func (o *jsonOutput) Write(r history.Record) error {
+	data, err := json.MarshalIndent(r, "  ", "  ")
+	if err != nil {
+		return fmt.Errorf("could not marshal event %d: %w", r.Sequence, err)
+	}
+	sep := ",\n  "
+	if o.count == 0 {
+		sep = "\n  "
+	}
+	o.count++
+	_, err = fmt.Fprint(o.w, sep, string(data))
+	return err
+}
+
+func (o *jsonOutput) End() error {
+	end := "]\n"
+	if o.count > 0 {
+		end = "\n]\n"
+	}
+	_, err := io.WriteString(o.w, end)
+	return err
+}
+
+// ndjsonOutput prints an event per line
+type ndjsonOutput struct {
+	enc *json.Encoder
+}
+
+func (o *ndjsonOutput) Begin() error {
+	return nil
+}
+
+func (o *ndjsonOutput) Write(r history.Record) error {
+	return o.enc.Encode(r)
+}
+
+func (o *ndjsonOutput) End() error {
+	return nil
+}
@@ -3,8 +3,10 @@ package main
 import (
 	"flag"
 	"fmt"
+	"log"
 	"log/slog"
 	"os"
+	"ris/internal/config"
 	"ris/internal/subscriber"
 
 	"github.com/nats-io/nats.go"
@@ -12,16 +14,39 @@ import (
 
 func main() {
 	msgType := flag.String("type", "prize", "Message type: 'prize' or 'laureate'")
-	flag.Parse()
 
-	natsConn, err := nats.Connect(nats.DefaultURL)
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Log.AddSource = false
+
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) > 0 {
+		log.Fatalf("Unknown command: %v", args)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
+
+	natsConn, err := nats.Connect(cfg.NATS.URL)
 	if err != nil {
 		slog.Error("Failed to connect to NATS", "error", err)
 		os.Exit(1)
 	}
 	defer natsConn.Close()
 
-	subs := subscriber.New(natsConn)
+	subs := subscriber.New(natsConn, subscriber.Config{})
 	defer subs.Close()
 
 	switch *msgType {
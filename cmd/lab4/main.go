@@ -1,46 +1,127 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"flag"
+	"log"
 	"log/slog"
+	"net/http"
 	"os"
 	"os/signal"
+	"ris/internal/config"
 	"ris/internal/domain"
+	"ris/internal/events"
+	"ris/internal/stream"
 	"ris/internal/subscriber"
+	"ris/internal/tracing"
 	"syscall"
+	"time"
 
 	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 func main() {
-	natsConn, err := nats.Connect(nats.DefaultURL)
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Metrics.Addr = ":2112"
+
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) > 0 {
+		log.Fatalf("Unknown command: %v", args)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, "nobel-events-listener")
+	if err != nil {
+		log.Fatalf("Failed to setup tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
+	natsConn, err := nats.Connect(cfg.NATS.URL)
 	if err != nil {
 		panic(err)
 	}
 	defer natsConn.Close()
-	subs := subscriber.New(natsConn)
-	defer subs.Close()
 
-	err = subs.SubscribePrizeCreated(func(prize domain.Prize) error {
-		prizeJSON, _ := json.MarshalIndent(prize, "", "  ")
-		slog.Info("Received prize created event", "prize", string(prizeJSON))
-		return nil
+	js, err := natsConn.JetStream()
+	if err != nil {
+		slog.Error("Failed to create JetStream context", "error", err)
+		return
+	}
+	_, err = stream.Provision(js, stream.Config{
+		MaxAge:     cfg.NATS.StreamMaxAge,
+		DLQMaxAge:  cfg.NATS.DLQMaxAge,
+		Replicas:   cfg.NATS.StreamReplicas,
+		Storage:    cfg.NATS.StreamStorage,
+		Duplicates: cfg.NATS.StreamDuplicates,
 	})
 	if err != nil {
-		slog.Error("Failed to subscribe to prize created events", "error", err)
+		slog.Error("Failed to provision events stream", "error", err)
 		return
 	}
 
-	err = subs.SubscribeLaureateCreated(func(laureate domain.Laureate) error {
-		laureateJSON, _ := json.MarshalIndent(laureate, "", "  ")
-		slog.Info("Received laureate created event", "laureate", string(laureateJSON))
-		return nil
-	})
+	subs := subscriber.New(natsConn, consumerConfig(cfg.Consumer))
+	defer subs.Close()
+
+	workers := subscriber.WithWorkers(cfg.Consumer.Workers)
+	err = errors.Join(
+		subscriber.Subscribe(subs, events.TypePrizeCreated, logEvent[domain.Prize]("prize created"), workers),
+		subscriber.Subscribe(subs, events.TypePrizeUpdated, logEvent[domain.PrizeUpdated]("prize updated"), workers),
+		subscriber.Subscribe(subs, events.TypePrizeDeleted, logEvent[domain.PrizeDeleted]("prize deleted"), workers),
+		subscriber.Subscribe(subs, events.TypePrizeLaureateLinked, logEvent[domain.PrizeLaureateLink]("prize laureate linked"), workers),
+		subscriber.Subscribe(subs, events.TypePrizeLaureateUnlinked, logEvent[domain.PrizeLaureateLink]("prize laureate unlinked"), workers),
+		subscriber.Subscribe(subs, events.TypeLaureateCreated, logEvent[domain.Laureate]("laureate created"), workers),
+		subscriber.Subscribe(subs, events.TypeLaureateUpdated, logEvent[domain.LaureateUpdated]("laureate updated"), workers),
+		subscriber.Subscribe(subs, events.TypeLaureateDeleted, logEvent[domain.LaureateDeleted]("laureate deleted"), workers),
+		subscriber.Subscribe(subs, events.TypeCategoryCreated, logEvent[domain.Category]("category created"), workers),
+		subscriber.Subscribe(subs, events.TypeCategoryUpdated, logEvent[domain.CategoryUpdated]("category updated"), workers),
+		subscriber.Subscribe(subs, events.TypeCategoryDeleted, logEvent[domain.CategoryDeleted]("category deleted"), workers),
+	)
 	if err != nil {
-		slog.Error("Failed to subscribe to laureate created events", "error", err)
+		slog.Error("Failed to subscribe to events", "error", err)
 		return
 	}
 
+	if cfg.Metrics.Addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		metricsServer := &http.Server{Addr: cfg.Metrics.Addr, Handler: mux}
+		go func() {
+			slog.Info("Serving metrics", "addr", cfg.Metrics.Addr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Metrics server error", "error", err)
+			}
+		}()
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = metricsServer.Shutdown(ctx)
+		}()
+	}
+
 	slog.Info("Listening for events from stream...")
 
 	// Graceful shutdown
@@ -51,3 +132,37 @@ func main() {
 
 	slog.Info("Server stopped")
 }
+
+// consumerConfig converts the validated configuration of the consumers
+func consumerConfig(cfg config.ConsumerConfig) subscriber.Config {
+	sc := subscriber.Config{
+		Durable:       cfg.Durable,
+		MaxDeliver:    cfg.MaxDeliver,
+		AckWait:       cfg.AckWait,
+		MaxAckPending: cfg.MaxAckPending,
+		Reset:         cfg.Reset,
+		DrainTimeout:  cfg.DrainTimeout,
+	}
+	switch cfg.Deliver {
+	case "all":
+		sc.Deliver = nats.DeliverAllPolicy
+	case "new":
+		sc.Deliver = nats.DeliverNewPolicy
+	case "sequence":
+		sc.Deliver = nats.DeliverByStartSequencePolicy
+		sc.StartSequence = uint64(cfg.StartSequence)
+	case "time":
+		sc.Deliver = nats.DeliverByStartTimePolicy
+		sc.StartTime, _ = time.Parse(time.RFC3339, cfg.StartTime)
+	}
+	return sc
+}
+
+// logEvent returns a handler which prints the event as indented JSON
+func logEvent[T any](name string) subscriber.Handler[T] {
+	return func(ctx context.Context, payload T, meta events.Metadata) error {
+		eventJSON, _ := json.MarshalIndent(events.Event[T]{Metadata: meta, Payload: payload}, "", "  ")
+		slog.Info("Received "+name+" event", "event", string(eventJSON), "trace_id", tracing.TraceID(ctx))
+		return nil
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"ris/internal/config"
+	"ris/internal/events"
+	"ris/internal/metrics"
+	"ris/internal/projection"
+	"ris/internal/stream"
+	"ris/internal/subscriber"
+	"ris/internal/tracing"
+	"ris/pkg/postgres"
+
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func main() {
+	rebuild := flag.Bool("rebuild", false, "Remove the read model and apply all events from the beginning of the stream")
+
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Metrics.Addr = ":2113"
+	defaults.Consumer.Durable = "nobel-projector"
+
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) > 0 {
+		log.Fatalf("Unknown command: %v", args)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, "nobel-projector")
+	if err != nil {
+		log.Fatalf("Failed to setup tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DB.ConnectTimeout)
+	defer cancel()
+	pool, err := postgres.NewPool(ctx, cfg.DB.URL)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		return
+	}
+	defer pool.Close()
+	if err := postgres.CheckSchemaVersion(ctx, pool); err != nil {
+		slog.Error("Database schema is not up to date", "error", err)
+		return
+	}
+	prometheus.MustRegister(metrics.NewPoolCollector(pool))
+
+	natsConn, err := nats.Connect(cfg.NATS.URL)
+	if err != nil {
+		slog.Error("Failed to connect to NATS", "error", err)
+		return
+	}
+	defer natsConn.Close()
+	js, err := natsConn.JetStream()
+	if err != nil {
+		slog.Error("Failed to create JetStream context", "error", err)
+		return
+	}
+	info, err := stream.Provision(js, stream.Config{
+		MaxAge:     cfg.NATS.StreamMaxAge,
+		DLQMaxAge:  cfg.NATS.DLQMaxAge,
+		Replicas:   cfg.NATS.StreamReplicas,
+		Storage:    cfg.NATS.StreamStorage,
+		Duplicates: cfg.NATS.StreamDuplicates,
+	})
+	if err != nil {
+		slog.Error("Failed to provision events stream", "error", err)
+		return
+	}
+
+	projector := projection.New(pool)
+	if *rebuild {
+		if err := projector.Reset(ctx); err != nil {
+			slog.Error("Failed to reset read model", "error", err)
+			return
+		}
+		slog.Info("Read model removed, rebuilding from the beginning of the stream")
+	}
+	checkpoint, err := projector.Checkpoint(ctx)
+	if err != nil {
+		slog.Error("Failed to get checkpoint", "error", err)
+		return
+	}
+	metrics.ProjectionSequence.Set(float64(checkpoint))
+	switch {
+	case checkpoint > info.State.LastSeq:
+		slog.Warn("Checkpoint is ahead of the stream, it was probably recreated; run with -rebuild",
+			"checkpoint", checkpoint, "last_sequence", info.State.LastSeq)
+	case info.State.Msgs > 0 && info.State.FirstSeq > checkpoint+1:
+		slog.Warn("Events after the checkpoint were removed from the stream, the read model is incomplete",
+			"checkpoint", checkpoint, "first_sequence", info.State.FirstSeq)
+	}
+
+	// The position is kept with the read model, the consumer is recreated from it on every start
+	sc := subscriber.Config{
+		Durable:      cfg.Consumer.Durable,
+		MaxDeliver:   cfg.Consumer.MaxDeliver,
+		AckWait:      cfg.Consumer.AckWait,
+		Deliver:      nats.DeliverAllPolicy,
+		Reset:        true,
+		DrainTimeout: cfg.Consumer.DrainTimeout,
+		// The next event is not delivered until the current one is acknowledged or dead lettered,
+		// a failed event is never overtaken by later ones, which would move the checkpoint past it
+		MaxAckPending: 1,
+	}
+	if checkpoint > 0 {
+		sc.Deliver = nats.DeliverByStartSequencePolicy
+		sc.StartSequence = checkpoint + 1
+	}
+	subs := subscriber.New(natsConn, sc)
+	defer subs.Close()
+
+	// A single worker on all subjects applies events in stream order
+	err = subscriber.Subscribe(subs, ">", apply(projector),
+		subscriber.WithDurable(cfg.Consumer.Durable), subscriber.WithWorkers(1))
+	if err != nil {
+		slog.Error("Failed to subscribe to events", "error", err)
+		return
+	}
+
+	if cfg.Metrics.Addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		metricsServer := &http.Server{Addr: cfg.Metrics.Addr, Handler: mux}
+		go func() {
+			slog.Info("Serving metrics", "addr", cfg.Metrics.Addr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Metrics server error", "error", err)
+			}
+		}()
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = metricsServer.Shutdown(ctx)
+		}()
+	}
+
+	slog.Info("Projecting events", "checkpoint", checkpoint, "last_sequence", info.State.LastSeq)
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	slog.Info("Projector stopped")
+}
+
+// apply returns a handler which applies events to the read model
+func apply(projector *projection.Projector) subscriber.Handler[json.RawMessage] {
+	return func(ctx context.Context, payload json.RawMessage, meta events.Metadata) error {
+		err := projector.Apply(ctx, subscriber.MessageFromContext(ctx).Sequence, meta, payload)
+		if errors.Is(err, projection.ErrInvalidPayload) {
+			// Redelivery would not help
+			return &subscriber.DecodeError{Err: err}
+		}
+		return err
+	}
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"ris/internal/config"
+	"ris/internal/metrics"
+	"ris/internal/stream"
+	"ris/internal/subscriber"
+	"ris/internal/tracing"
+	"ris/internal/webhook"
+	"ris/pkg/postgres"
+
+	"github.com/nats-io/nats.go"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+func main() {
+	defaults := config.Default()
+	defaults.Log.Format = "text"
+	defaults.Metrics.Addr = ":2114"
+	defaults.Consumer.Durable = "nobel-webhooks"
+	// Webhooks receive changes made after they were created, not the history
+	defaults.Consumer.Deliver = "new"
+
+	cfg, args, err := config.Load(flag.CommandLine, os.Args[1:], defaults)
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+	if config.IsPrintCommand(args) {
+		if err := config.Print(os.Stdout, cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+	if len(args) > 0 {
+		log.Fatalf("Unknown command: %v", args)
+	}
+
+	closeLog, err := config.SetupLogger(cfg.Log)
+	if err != nil {
+		log.Fatalf("Failed to setup logging: %v", err)
+	}
+	defer closeLog()
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing, "nobel-webhooks")
+	if err != nil {
+		log.Fatalf("Failed to setup tracing: %v", err)
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			slog.Error("Failed to flush traces", "error", err)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.DB.ConnectTimeout)
+	defer cancel()
+	pool, err := postgres.NewPool(ctx, cfg.DB.URL)
+	if err != nil {
+		slog.Error("Failed to connect to database", "error", err)
+		return
+	}
+	defer pool.Close()
+	if err := postgres.CheckSchemaVersion(ctx, pool); err != nil {
+		slog.Error("Database schema is not up to date", "error", err)
+		return
+	}
+	prometheus.MustRegister(metrics.NewPoolCollector(pool))
+
+	natsConn, err := nats.Connect(cfg.NATS.URL)
+	if err != nil {
+		slog.Error("Failed to connect to NATS", "error", err)
+		return
+	}
+	defer natsConn.Close()
+	js, err := natsConn.JetStream()
+	if err != nil {
+		slog.Error("Failed to create JetStream context", "error", err)
+		return
+	}
+	if _, err := stream.Provision(js, stream.Config{
+		MaxAge:     cfg.NATS.StreamMaxAge,
+		DLQMaxAge:  cfg.NATS.DLQMaxAge,
+		Replicas:   cfg.NATS.StreamReplicas,
+		Storage:    cfg.NATS.StreamStorage,
+		Duplicates: cfg.NATS.StreamDuplicates,
+	}); err != nil {
+		slog.Error("Failed to provision events stream", "error", err)
+		return
+	}
+
+	dispatcher := webhook.New(pool, webhook.Config{
+		Timeout:      cfg.Webhooks.Timeout,
+		MaxAttempts:  cfg.Webhooks.MaxAttempts,
+		MinBackoff:   cfg.Webhooks.MinBackoff,
+		MaxBackoff:   cfg.Webhooks.MaxBackoff,
+		DisableAfter: cfg.Webhooks.DisableAfter,
+		PollInterval: cfg.Webhooks.PollInterval,
+		BatchSize:    cfg.Webhooks.BatchSize,
+		Concurrency:  cfg.Webhooks.Concurrency,
+		Retention:    cfg.Webhooks.Retention,
+
+		AllowPrivateNetworks: cfg.Webhooks.AllowPrivate,
+	})
+	runCtx, stop := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	wg.Go(func() { dispatcher.Run(runCtx) })
+	// Stops sending after the subscription is drained: deliveries of handled events are stored
+	defer func() {
+		stop()
+		wg.Wait()
+	}()
+
+	subs := subscriber.New(natsConn, consumerConfig(cfg.Consumer))
+	defer subs.Close()
+
+	// Deliveries are stored for every event, a single consumer is enough
+	err = subscriber.Subscribe(subs, ">", dispatcher.Handle,
+		subscriber.WithDurable(cfg.Consumer.Durable), subscriber.WithWorkers(cfg.Consumer.Workers))
+	if err != nil {
+		slog.Error("Failed to subscribe to events", "error", err)
+		return
+	}
+
+	if cfg.Metrics.Addr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.Handler())
+		metricsServer := &http.Server{Addr: cfg.Metrics.Addr, Handler: mux}
+		go func() {
+			slog.Info("Serving metrics", "addr", cfg.Metrics.Addr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				slog.Error("Metrics server error", "error", err)
+			}
+		}()
+		defer func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_ = metricsServer.Shutdown(ctx)
+		}()
+	}
+
+	slog.Info("Dispatching webhooks")
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
+	<-quit
+
+	slog.Info("Webhook dispatcher stopped")
+}
+
+// consumerConfig converts the validated configuration of the consumer
+func consumerConfig(cfg config.ConsumerConfig) subscriber.Config {
+	sc := subscriber.Config{
+		Durable:       cfg.Durable,
+		MaxDeliver:    cfg.MaxDeliver,
+		AckWait:       cfg.AckWait,
+		MaxAckPending: cfg.MaxAckPending,
+		Reset:         cfg.Reset,
+		DrainTimeout:  cfg.DrainTimeout,
+	}
+	switch cfg.Deliver {
+	case "all":
+		sc.Deliver = nats.DeliverAllPolicy
+	case "new":
+		sc.Deliver = nats.DeliverNewPolicy
+	case "sequence":
+		sc.Deliver = nats.DeliverByStartSequencePolicy
+		sc.StartSequence = uint64(cfg.StartSequence)
+	case "time":
+		sc.Deliver = nats.DeliverByStartTimePolicy
+		sc.StartTime, _ = time.Parse(time.RFC3339, cfg.StartTime)
+	}
+	return sc
+}
@@ -0,0 +1,59 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+	"strings"
+
+	"connectrpc.com/connect"
+)
+
+var errUnauthenticated = connect.NewError(connect.CodeUnauthenticated,
+	errors.New("invalid or missing API token, provide authorization: Bearer <token> or x-api-key metadata"))
+
+// authInterceptor checks the API token like the AuthMiddleware of the REST API
+type authInterceptor struct {
+	token string
+}
+
+// NewAuthInterceptor creates an interceptor which rejects requests without the API token
+// in the "authorization: Bearer <token>" or "x-api-key: <token>" metadata
+func NewAuthInterceptor(token string) connect.Interceptor {
+	return &authInterceptor{token: token}
+}
+
+func (i *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		if !i.valid(req.Header()) {
+			return nil, errUnauthenticated
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *authInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *authInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if !i.valid(conn.RequestHeader()) {
+			return errUnauthenticated
+		}
+		return next(ctx, conn)
+	}
+}
+
+// valid reports whether the request metadata carries the token
+func (i *authInterceptor) valid(h http.Header) bool {
+	token := h.Get("X-Api-Key")
+	if scheme, value, ok := strings.Cut(h.Get("Authorization"), " "); ok && strings.EqualFold(scheme, "bearer") {
+		token = value
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(i.token)) == 1
+}
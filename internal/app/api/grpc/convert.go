@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	v1 "ris/internal/app/api/v1"
+	nobelv1 "ris/pkg/api/nobel/v1"
+)
+
+// validate checks a request of the service against the validation rules of the REST API
+func (s *server) validate(req any) error {
+	if err := s.validator.Struct(req); err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
+
+// serviceError converts an error of the service into a connect error.
+// Unexpected errors are logged and hidden from clients.
+func serviceError(ctx context.Context, what string, err error) error {
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return connect.NewError(connect.CodeNotFound, errors.New(what+" not found"))
+	case errors.Is(err, v1.ErrCategoryNotFound):
+		return connect.NewError(connect.CodeNotFound, err)
+	case errors.Is(err, v1.ErrUnknownCategory), errors.Is(err, v1.ErrCategoryNotEstablished):
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	case errors.As(err, &pgErr) && pgErr.Code == "23505":
+		return connect.NewError(connect.CodeAlreadyExists, errors.New(what+" already exists"))
+	case errors.As(err, &pgErr) && pgErr.Code == "23503":
+		return connect.NewError(connect.CodeFailedPrecondition, errors.New("referenced prize or laureate does not exist"))
+	case errors.Is(err, context.Canceled):
+		return connect.NewError(connect.CodeCanceled, err)
+	case errors.Is(err, context.DeadlineExceeded):
+		return connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	slog.ErrorContext(ctx, "gRPC request failed", "error", err)
+	return connect.NewError(connect.CodeInternal, errors.New("internal error"))
+}
+
+func laureateToProto(l v1.LaureateResponse) *nobelv1.Laureate {
+	return &nobelv1.Laureate{
+		Id:         l.ID,
+		Firstname:  l.Firstname,
+		Surname:    l.Surname,
+		Motivation: l.Motivation,
+		Share:      l.Share,
+		UpdatedAt:  timestampToProto(l.UpdatedAt),
+	}
+}
+
+func prizeToProto(p v1.PrizeResponse) *nobelv1.Prize {
+	laureates := make([]*nobelv1.Laureate, len(p.Laureates))
+	for i, l := range p.Laureates {
+		laureates[i] = laureateToProto(l)
+	}
+	return &nobelv1.Prize{
+		Id:        p.ID,
+		Year:      p.Year,
+		Category:  p.Category,
+		Laureates: laureates,
+		UpdatedAt: timestampToProto(p.UpdatedAt),
+	}
+}
+
+// timestampToProto converts RFC 3339 times of the service responses
+func timestampToProto(s *string) *timestamppb.Timestamp {
+	if s == nil {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, *s)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+
+	v1 "ris/internal/app/api/v1"
+	nobelv1 "ris/pkg/api/nobel/v1"
+)
+
+func (s *server) ListLaureates(ctx context.Context, _ *connect.Request[nobelv1.ListLaureatesRequest], stream *connect.ServerStream[nobelv1.Laureate]) error {
+	for page := 1; ; page++ {
+		list, err := s.service.ListLaureates(ctx, page, listPageSize, v1.LaureateFilter{})
+		if err != nil {
+			return serviceError(ctx, "laureates", err)
+		}
+		for _, l := range list.Data {
+			if err := stream.Send(laureateToProto(l)); err != nil {
+				return err
+			}
+		}
+		if page >= list.TotalPages {
+			return nil
+		}
+	}
+}
+
+func (s *server) GetLaureate(ctx context.Context, req *connect.Request[nobelv1.GetLaureateRequest]) (*connect.Response[nobelv1.Laureate], error) {
+	laureate, err := s.service.GetLaureate(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, serviceError(ctx, "laureate", err)
+	}
+	return connect.NewResponse(laureateToProto(*laureate)), nil
+}
+
+func (s *server) CreateLaureate(ctx context.Context, req *connect.Request[nobelv1.CreateLaureateRequest]) (*connect.Response[nobelv1.Laureate], error) {
+	create := &v1.CreateLaureateRequest{
+		ID:         req.Msg.Id,
+		Firstname:  req.Msg.Firstname,
+		Surname:    req.Msg.Surname,
+		Motivation: req.Msg.Motivation,
+		Share:      req.Msg.Share,
+	}
+	if err := s.validate(create); err != nil {
+		return nil, err
+	}
+	laureate, err := s.service.CreateLaureate(ctx, create)
+	if err != nil {
+		return nil, serviceError(ctx, "laureate", err)
+	}
+	return connect.NewResponse(laureateToProto(*laureate)), nil
+}
+
+func (s *server) UpdateLaureate(ctx context.Context, req *connect.Request[nobelv1.UpdateLaureateRequest]) (*connect.Response[nobelv1.Laureate], error) {
+	update := &v1.UpdateLaureateRequest{
+		Firstname:  req.Msg.Firstname,
+		Surname:    req.Msg.Surname,
+		Motivation: req.Msg.Motivation,
+		Share:      req.Msg.Share,
+	}
+	if err := s.validate(update); err != nil {
+		return nil, err
+	}
+	laureate, err := s.service.UpdateLaureate(ctx, req.Msg.Id, update)
+	if err != nil {
+		return nil, serviceError(ctx, "laureate", err)
+	}
+	return connect.NewResponse(laureateToProto(*laureate)), nil
+}
+
+func (s *server) DeleteLaureate(ctx context.Context, req *connect.Request[nobelv1.DeleteLaureateRequest]) (*connect.Response[nobelv1.DeleteLaureateResponse], error) {
+	if err := s.service.DeleteLaureate(ctx, req.Msg.Id); err != nil {
+		return nil, serviceError(ctx, "laureate", err)
+	}
+	return connect.NewResponse(&nobelv1.DeleteLaureateResponse{}), nil
+}
@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	v1 "ris/internal/app/api/v1"
+	nobelv1 "ris/pkg/api/nobel/v1"
+)
+
+func (s *server) ListPrizes(ctx context.Context, _ *connect.Request[nobelv1.ListPrizesRequest], stream *connect.ServerStream[nobelv1.Prize]) error {
+	for page := 1; ; page++ {
+		list, err := s.service.ListPrizes(ctx, page, listPageSize)
+		if err != nil {
+			return serviceError(ctx, "prizes", err)
+		}
+		if err := sendPrizes(stream, list.Data); err != nil {
+			return err
+		}
+		if page >= list.TotalPages {
+			return nil
+		}
+	}
+}
+
+func (s *server) ListPrizesByCategory(ctx context.Context, req *connect.Request[nobelv1.ListPrizesByCategoryRequest], stream *connect.ServerStream[nobelv1.Prize]) error {
+	if req.Msg.Category == "" {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("category is required"))
+	}
+	prizes, err := s.service.GetPrizesByCategory(ctx, req.Msg.Category)
+	if err != nil {
+		return serviceError(ctx, "prizes", err)
+	}
+	return sendPrizes(stream, prizes)
+}
+
+func (s *server) ListPrizesByYear(ctx context.Context, req *connect.Request[nobelv1.ListPrizesByYearRequest], stream *connect.ServerStream[nobelv1.Prize]) error {
+	if req.Msg.Year < 1901 {
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("year must be 1901 or later"))
+	}
+	prizes, err := s.service.GetPrizesByYear(ctx, req.Msg.Year)
+	if err != nil {
+		return serviceError(ctx, "prizes", err)
+	}
+	return sendPrizes(stream, prizes)
+}
+
+func (s *server) GetPrize(ctx context.Context, req *connect.Request[nobelv1.GetPrizeRequest]) (*connect.Response[nobelv1.Prize], error) {
+	prize, err := s.service.GetPrize(ctx, req.Msg.Id)
+	if err != nil {
+		return nil, serviceError(ctx, "prize", err)
+	}
+	return connect.NewResponse(prizeToProto(*prize)), nil
+}
+
+func (s *server) CreatePrize(ctx context.Context, req *connect.Request[nobelv1.CreatePrizeRequest]) (*connect.Response[nobelv1.Prize], error) {
+	create := &v1.CreatePrizeRequest{
+		Year:        req.Msg.Year,
+		Category:    req.Msg.Category,
+		LaureateIDs: req.Msg.LaureateIds,
+	}
+	if err := s.validate(create); err != nil {
+		return nil, err
+	}
+	prize, err := s.service.CreatePrize(ctx, create)
+	if err != nil {
+		return nil, serviceError(ctx, "prize", err)
+	}
+	return connect.NewResponse(prizeToProto(*prize)), nil
+}
+
+func (s *server) UpdatePrize(ctx context.Context, req *connect.Request[nobelv1.UpdatePrizeRequest]) (*connect.Response[nobelv1.Prize], error) {
+	update := &v1.UpdatePrizeRequest{Year: req.Msg.Year, Category: req.Msg.Category}
+	if err := s.validate(update); err != nil {
+		return nil, err
+	}
+	prize, err := s.service.UpdatePrize(ctx, req.Msg.Id, update)
+	if err != nil {
+		return nil, serviceError(ctx, "prize", err)
+	}
+	return connect.NewResponse(prizeToProto(*prize)), nil
+}
+
+func (s *server) DeletePrize(ctx context.Context, req *connect.Request[nobelv1.DeletePrizeRequest]) (*connect.Response[nobelv1.DeletePrizeResponse], error) {
+	if err := s.service.DeletePrize(ctx, req.Msg.Id); err != nil {
+		return nil, serviceError(ctx, "prize", err)
+	}
+	return connect.NewResponse(&nobelv1.DeletePrizeResponse{}), nil
+}
+
+func (s *server) LinkLaureate(ctx context.Context, req *connect.Request[nobelv1.LinkLaureateRequest]) (*connect.Response[nobelv1.Prize], error) {
+	prize, err := s.service.LinkLaureate(ctx, req.Msg.PrizeId, req.Msg.LaureateId)
+	if err != nil {
+		return nil, serviceError(ctx, "prize", err)
+	}
+	return connect.NewResponse(prizeToProto(*prize)), nil
+}
+
+func (s *server) UnlinkLaureate(ctx context.Context, req *connect.Request[nobelv1.UnlinkLaureateRequest]) (*connect.Response[nobelv1.Prize], error) {
+	prize, err := s.service.UnlinkLaureate(ctx, req.Msg.PrizeId, req.Msg.LaureateId)
+	if err != nil {
+		return nil, serviceError(ctx, "prize", err)
+	}
+	return connect.NewResponse(prizeToProto(*prize)), nil
+}
+
+func (s *server) GetCategories(ctx context.Context, _ *connect.Request[nobelv1.GetCategoriesRequest]) (*connect.Response[nobelv1.GetCategoriesResponse], error) {
+	categories, err := s.service.GetCategories(ctx)
+	if err != nil {
+		return nil, serviceError(ctx, "categories", err)
+	}
+	slugs := make([]string, len(categories.Data))
+	for i, c := range categories.Data {
+		slugs[i] = c.Slug
+	}
+	return connect.NewResponse(&nobelv1.GetCategoriesResponse{Categories: slugs}), nil
+}
+
+// sendPrizes sends prizes to a stream
+func sendPrizes(stream *connect.ServerStream[nobelv1.Prize], prizes []v1.PrizeResponse) error {
+	for _, p := range prizes {
+		if err := stream.Send(prizeToProto(p)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
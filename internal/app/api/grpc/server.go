@@ -0,0 +1,59 @@
+// Package grpc serves the API over gRPC, gRPC-Web and the Connect protocol (HTTP/JSON),
+// see proto/nobel/v1. Handlers share the service of the REST API, so they read the same
+// cache and publish the same events.
+//
+// The handler must be served over HTTP/2 for gRPC clients, e.g. by an http.Server with
+// unencrypted HTTP/2 enabled. Connect and gRPC-Web clients also work over HTTP/1.1.
+package grpc
+
+import (
+	"net/http"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpcreflect"
+	"connectrpc.com/otelconnect"
+	"github.com/go-playground/validator/v10"
+
+	v1 "ris/internal/app/api/v1"
+	"ris/pkg/api/nobel/v1/nobelv1connect"
+)
+
+// Config configures the handler
+type Config struct {
+	APIToken   string // token required by all services except reflection
+	Reflection bool   // serve the gRPC reflection services
+}
+
+// NewHandler creates the handler of the LaureateService, PrizeService and StatsService
+func NewHandler(service v1.Service, cfg Config) (http.Handler, error) {
+	tracing, err := otelconnect.NewInterceptor(otelconnect.WithTrustRemote(), otelconnect.WithoutMetrics())
+	if err != nil {
+		return nil, err
+	}
+	opts := connect.WithInterceptors(tracing, NewAuthInterceptor(cfg.APIToken))
+	s := &server{service: service, validator: validator.New(validator.WithRequiredStructEnabled())}
+
+	mux := http.NewServeMux()
+	mux.Handle(nobelv1connect.NewLaureateServiceHandler(s, opts))
+	mux.Handle(nobelv1connect.NewPrizeServiceHandler(s, opts))
+	mux.Handle(nobelv1connect.NewStatsServiceHandler(s, opts))
+	if cfg.Reflection {
+		reflector := grpcreflect.NewStaticReflector(
+			nobelv1connect.LaureateServiceName,
+			nobelv1connect.PrizeServiceName,
+			nobelv1connect.StatsServiceName,
+		)
+		mux.Handle(grpcreflect.NewHandlerV1(reflector))
+		mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+	}
+	return mux, nil
+}
+
+// server implements the handlers of all services
+type server struct {
+	service   v1.Service
+	validator *validator.Validate
+}
+
+// listPageSize is the page size used to stream paginated lists of the service
+const listPageSize = 100
@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	nobelv1 "ris/pkg/api/nobel/v1"
+)
+
+func (s *server) GetStats(ctx context.Context, _ *connect.Request[nobelv1.GetStatsRequest]) (*connect.Response[nobelv1.Stats], error) {
+	stats, err := s.service.GetStats(ctx)
+	if err != nil {
+		return nil, serviceError(ctx, "stats", err)
+	}
+	return connect.NewResponse(&nobelv1.Stats{
+		LaureatesCount:  stats.LaureatesCount,
+		PrizesCount:     stats.PrizesCount,
+		CategoriesCount: stats.CategoriesCount,
+	}), nil
+}
+
+func (s *server) GetLastUpdate(ctx context.Context, _ *connect.Request[nobelv1.GetLastUpdateRequest]) (*connect.Response[nobelv1.GetLastUpdateResponse], error) {
+	lastUpdate, err := s.service.GetLastUpdate(ctx)
+	if err != nil {
+		return nil, serviceError(ctx, "last update", err)
+	}
+	return connect.NewResponse(&nobelv1.GetLastUpdateResponse{LastUpdate: timestamppb.New(lastUpdate.LastUpdate)}), nil
+}
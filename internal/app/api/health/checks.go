@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+
+	"ris/pkg/postgres"
+)
+
+// PostgresCheck pings the connection pool
+func PostgresCheck(pool *pgxpool.Pool) Check {
+	return pool.Ping
+}
+
+// SchemaCheck verifies that the database schema version matches the code
+func SchemaCheck(pool *pgxpool.Pool) Check {
+	return func(ctx context.Context) error {
+		return postgres.CheckSchemaVersion(ctx, pool)
+	}
+}
+
+// NATSCheck verifies that the connection is established and the server responds
+func NATSCheck(conn *nats.Conn) Check {
+	return func(ctx context.Context) error {
+		if status := conn.Status(); status != nats.CONNECTED {
+			return fmt.Errorf("connection is %s", status)
+		}
+		return conn.FlushWithContext(ctx)
+	}
+}
@@ -0,0 +1,161 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Check verifies a single dependency. It must respect ctx cancellation.
+type Check func(ctx context.Context) error
+
+// CheckResult is the status of a single dependency
+//
+//	@Description	Dependency check result
+type CheckResult struct {
+	Status    string  `json:"status"`
+	LatencyMs float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+// Response is the body of health endpoints
+//
+//	@Description	Service health status
+type Response struct {
+	Status  string                 `json:"status"`
+	Service string                 `json:"service"`
+	Version string                 `json:"version"`
+	Checks  map[string]CheckResult `json:"checks,omitempty"`
+}
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+	StatusShutdown    = "shutting_down"
+)
+
+type namedCheck struct {
+	name  string
+	check Check
+}
+
+// Checker runs dependency checks for liveness and readiness endpoints
+type Checker struct {
+	service string
+	version string
+	timeout time.Duration
+
+	checks       []namedCheck
+	shuttingDown atomic.Bool
+}
+
+// NewChecker creates a new Checker. Every check is limited by timeout.
+func NewChecker(service, version string, timeout time.Duration) *Checker {
+	return &Checker{
+		service: service,
+		version: version,
+		timeout: timeout,
+	}
+}
+
+// Add registers a readiness check for the named dependency
+func (h *Checker) Add(name string, check Check) {
+	h.checks = append(h.checks, namedCheck{name: name, check: check})
+}
+
+// SetShuttingDown makes readiness fail, so load balancers stop sending
+// new requests while in-flight ones are still being served
+func (h *Checker) SetShuttingDown() {
+	h.shuttingDown.Store(true)
+}
+
+// RegisterRoutes registers /livez, /readyz and /health (alias of /readyz)
+func (h *Checker) RegisterRoutes(app *fiber.App) {
+	app.Get("/livez", h.Livez)
+	app.Get("/readyz", h.Readyz)
+	app.Get("/health", h.Readyz)
+}
+
+// Livez godoc
+//
+//	@Summary		Liveness probe
+//	@Description	Returns 200 while the process is able to serve requests. Dependencies are not checked.
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	Response
+//	@Router			/livez [get]
+func (h *Checker) Livez(c *fiber.Ctx) error {
+	return c.JSON(Response{
+		Status:  StatusOK,
+		Service: h.service,
+		Version: h.version,
+	})
+}
+
+// Readyz godoc
+//
+//	@Summary		Readiness probe
+//	@Description	Checks PostgreSQL, NATS and the database schema version. Fails during graceful shutdown.
+//	@Tags			Health
+//	@Produce		json
+//	@Success		200	{object}	Response
+//	@Failure		503	{object}	Response
+//	@Router			/readyz [get]
+func (h *Checker) Readyz(c *fiber.Ctx) error {
+	resp := Response{
+		Status:  StatusOK,
+		Service: h.service,
+		Version: h.version,
+	}
+	if h.shuttingDown.Load() {
+		resp.Status = StatusShutdown
+		return c.Status(fiber.StatusServiceUnavailable).JSON(resp)
+	}
+
+	resp.Checks = h.run(c.UserContext())
+	for _, result := range resp.Checks {
+		if result.Status != StatusOK {
+			resp.Status = StatusUnavailable
+		}
+	}
+
+	if resp.Status != StatusOK {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(resp)
+	}
+	return c.JSON(resp)
+}
+
+// run executes all checks concurrently
+func (h *Checker) run(ctx context.Context) map[string]CheckResult {
+	results := make(map[string]CheckResult, len(h.checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, nc := range h.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, h.timeout)
+			defer cancel()
+
+			start := time.Now()
+			err := nc.check(ctx)
+			result := CheckResult{
+				Status:    StatusOK,
+				LatencyMs: float64(time.Since(start).Microseconds()) / 1000,
+			}
+			if err != nil {
+				result.Status = StatusUnavailable
+				result.Error = err.Error()
+			}
+
+			mu.Lock()
+			results[nc.name] = result
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return results
+}
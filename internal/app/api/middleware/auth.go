@@ -1,42 +1,52 @@
 package middleware
 
 import (
-	"os"
 	"strings"
 
 	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
 )
 
 // AuthMiddleware creates a middleware that checks for Bearer token or API key
 //
 //	@description	Authentication via Bearer token in Authorization header or api_key query parameter
-func AuthMiddleware() fiber.Handler {
-	apiToken := os.Getenv("API_TOKEN")
-	if apiToken == "" {
-		apiToken = "secret-api-token"
-	}
-
+func AuthMiddleware(apiToken string) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Check Authorization header for Bearer token
-		authHeader := c.Get("Authorization")
-		if authHeader != "" {
-			parts := strings.Split(authHeader, " ")
-			if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
-				if parts[1] == apiToken {
-					return c.Next()
-				}
-			}
+		if bearerToken(c) == apiToken {
+			return c.Next()
 		}
 
 		// Check api_key query parameter
-		apiKey := c.Query("api_key")
-		if apiKey == apiToken {
+		if c.Query("api_key") == apiToken {
 			return c.Next()
 		}
 
-		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
-			"error":   "Unauthorized",
-			"message": "Invalid or missing API token. Provide Authorization: Bearer <token> header or api_key query parameter",
+		return render.Send(c.Status(fiber.StatusUnauthorized), render.ErrorResponse{
+			Error:   "Unauthorized",
+			Message: "Invalid or missing API token. Provide Authorization: Bearer <token> header or api_key query parameter",
 		})
 	}
 }
+
+// bearerToken extracts the token from the Authorization: Bearer <token> header
+func bearerToken(c *fiber.Ctx) string {
+	authHeader := c.Get("Authorization")
+	if authHeader != "" {
+		parts := strings.Split(authHeader, " ")
+		if len(parts) == 2 && strings.ToLower(parts[0]) == "bearer" {
+			return parts[1]
+		}
+	}
+	return ""
+}
+
+// apiKeyFromRequest returns the token passed either as Bearer token
+// or as api_key query parameter. The header takes precedence.
+func apiKeyFromRequest(c *fiber.Ctx) string {
+	if token := bearerToken(c); token != "" {
+		return token
+	}
+	return c.Query("api_key")
+}
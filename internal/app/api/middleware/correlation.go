@@ -0,0 +1,20 @@
+package middleware
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/requestid"
+
+	"ris/internal/events"
+)
+
+// CorrelationID stores the request id in the user context, so events
+// caused by the request carry it as the correlation id.
+// It must be registered after the requestid middleware.
+func CorrelationID() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if id, ok := c.Locals(requestid.ConfigDefault.ContextKey).(string); ok && id != "" {
+			c.SetUserContext(events.WithCorrelationID(c.UserContext(), id))
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+)
+
+// Limit describes a token bucket: the bucket holds up to Burst tokens
+// and is refilled with Rate tokens per second.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimitResult is the outcome of taking a token from a bucket
+type RateLimitResult struct {
+	Allowed   bool
+	Remaining int
+	// RetryAfter is the time until the next token is available (only set when not allowed)
+	RetryAfter time.Duration
+	// Reset is the time until the bucket is completely refilled
+	Reset time.Duration
+}
+
+// RateLimitStore keeps token buckets. Implementations must be safe for concurrent use.
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, limit Limit) (RateLimitResult, error)
+}
+
+// RateLimitConfig configures RateLimitMiddleware
+type RateLimitConfig struct {
+	Store RateLimitStore
+	// Read is the budget for safe methods (GET, HEAD, OPTIONS)
+	Read Limit
+	// Write is the budget for all other methods
+	Write Limit
+	// IsWrite overrides which requests take from the Write budget, e.g. only GraphQL mutations
+	// instead of every POST request
+	IsWrite func(c *fiber.Ctx) bool
+}
+
+// RateLimitMiddleware limits requests per API key or client IP using token buckets.
+// It runs before authentication, so requests with wrong tokens are limited too.
+// Every response carries RateLimit-Limit, RateLimit-Remaining and RateLimit-Reset headers,
+// rejected requests get 429 with Retry-After.
+func RateLimitMiddleware(cfg RateLimitConfig) fiber.Handler {
+	isWrite := cfg.IsWrite
+	if isWrite == nil {
+		isWrite = unsafeMethod
+	}
+	return func(c *fiber.Ctx) error {
+		budget, limit := "write", cfg.Write
+		if !isWrite(c) {
+			budget, limit = "read", cfg.Read
+		}
+
+		res, err := cfg.Store.Take(c.UserContext(), budget+":"+clientKey(c), limit)
+		if err != nil {
+			// Prefer availability over strict limiting when the store is unavailable
+			slog.Warn("rate limit store error, request is not limited", "error", err)
+			return c.Next()
+		}
+
+		c.Set("RateLimit-Limit", strconv.Itoa(limit.Burst))
+		c.Set("RateLimit-Remaining", strconv.Itoa(res.Remaining))
+		c.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.Reset)))
+
+		if !res.Allowed {
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(ceilSeconds(res.RetryAfter)))
+			return render.Send(c.Status(fiber.StatusTooManyRequests), render.ErrorResponse{
+				Error:   "Too Many Requests",
+				Message: "Rate limit exceeded for " + budget + " requests, retry later",
+			})
+		}
+		return c.Next()
+	}
+}
+
+// unsafeMethod reports whether the request method may change data
+func unsafeMethod(c *fiber.Ctx) bool {
+	switch c.Method() {
+	case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// clientKey identifies the client by the presented API key or bearer token, falling back to the IP.
+// The key is hashed, so tokens are not kept in the store. The IP is taken from the proxy header
+// only for requests of trusted proxies, see the Fiber TrustedProxies option.
+func clientKey(c *fiber.Ctx) string {
+	if key := apiKeyFromRequest(c); key != "" {
+		sum := sha256.Sum256([]byte(key))
+		return "key:" + hex.EncodeToString(sum[:16])
+	}
+	return "ip:" + c.IP()
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+// takeToken refills the bucket for the time elapsed since last update and
+// tries to take one token. It returns the new amount of tokens in the bucket.
+func takeToken(tokens float64, updatedAt, now time.Time, limit Limit) (float64, RateLimitResult) {
+	burst := float64(limit.Burst)
+	if elapsed := now.Sub(updatedAt).Seconds(); elapsed > 0 {
+		tokens = math.Min(burst, tokens+elapsed*limit.Rate)
+	}
+
+	res := RateLimitResult{Allowed: tokens >= 1}
+	if res.Allowed {
+		tokens--
+	} else {
+		res.RetryAfter = secondsToDuration((1 - tokens) / limit.Rate)
+	}
+	res.Remaining = int(math.Floor(tokens))
+	res.Reset = secondsToDuration((burst - tokens) / limit.Rate)
+	return tokens, res
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
+// MemoryRateLimitStore keeps buckets in process memory.
+// Limits are enforced per instance only.
+type MemoryRateLimitStore struct {
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+	takes   int
+}
+
+type memoryBucket struct {
+	tokens    float64
+	updatedAt time.Time
+}
+
+// memorySweepEvery defines how often (in takes) idle buckets are removed
+const memorySweepEvery = 1000
+
+// NewMemoryRateLimitStore creates a new in-memory store
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{buckets: make(map[string]*memoryBucket)}
+}
+
+// Take takes a token from the bucket identified by key
+func (s *MemoryRateLimitStore) Take(_ context.Context, key string, limit Limit) (RateLimitResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.takes++
+	if s.takes%memorySweepEvery == 0 {
+		s.sweep(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &memoryBucket{tokens: float64(limit.Burst), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	var res RateLimitResult
+	b.tokens, res = takeToken(b.tokens, b.updatedAt, now, limit)
+	b.updatedAt = now
+	return res, nil
+}
+
+// sweep removes buckets which have not been used for an hour.
+// With any reasonable rate such buckets are refilled, so dropping them does not change limiting.
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	for key, b := range s.buckets {
+		if now.Sub(b.updatedAt) > time.Hour {
+			delete(s.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"ris/pkg/postgres/queries"
+)
+
+// postgresSweepEvery defines how often (in takes) idle buckets are removed
+const postgresSweepEvery = 1000
+
+// PostgresRateLimitStore keeps buckets in the rate_limit_buckets table,
+// so the limits are shared between all API instances using the same database.
+type PostgresRateLimitStore struct {
+	queries *queries.Queries
+	takes   atomic.Int64
+}
+
+// NewPostgresRateLimitStore creates a new Postgres-backed store
+func NewPostgresRateLimitStore(pool *pgxpool.Pool) *PostgresRateLimitStore {
+	return &PostgresRateLimitStore{queries: queries.New(pool)}
+}
+
+// Take takes a token from the bucket identified by key in a single statement.
+// The database clock is used so that instances with skewed clocks still share the same budget.
+func (s *PostgresRateLimitStore) Take(ctx context.Context, key string, limit Limit) (RateLimitResult, error) {
+	if s.takes.Add(1)%postgresSweepEvery == 0 {
+		go s.sweep()
+	}
+
+	bucket, err := s.queries.TakeRateLimitToken(ctx, queries.TakeRateLimitTokenParams{
+		Key:   key,
+		Burst: float64(limit.Burst),
+		Rate:  limit.Rate,
+	})
+	if err != nil {
+		return RateLimitResult{}, fmt.Errorf("could not take rate limit token: %w", err)
+	}
+
+	// A taken token moves updated_at to now, a bucket without tokens is left as is
+	if !bucket.UpdatedAt.Time.Equal(bucket.Now.Time) {
+		_, res := takeToken(bucket.Tokens, bucket.UpdatedAt.Time, bucket.Now.Time, limit)
+		return res, nil
+	}
+	return RateLimitResult{
+		Allowed:   true,
+		Remaining: int(math.Floor(bucket.Tokens)),
+		Reset:     secondsToDuration((float64(limit.Burst) - bucket.Tokens) / limit.Rate),
+	}, nil
+}
+
+// sweep removes buckets which have not been used for an hour
+func (s *PostgresRateLimitStore) sweep() {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	err := s.queries.DeleteStaleRateLimitBuckets(ctx, pgtype.Timestamptz{
+		Time:  time.Now().Add(-time.Hour),
+		Valid: true,
+	})
+	if err != nil {
+		slog.Warn("failed to remove stale rate limit buckets", "error", err)
+	}
+}
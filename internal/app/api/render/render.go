@@ -0,0 +1,152 @@
+// Package render writes API responses in the format negotiated from the Accept header:
+// JSON (the default), XML, YAML and, for lists, CSV.
+//
+// XML elements are named by the xml tags of the responses, which follow their JSON names.
+// YAML is converted from the JSON encoding, so it has the same keys in the same order.
+package render
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go.yaml.in/yaml/v3"
+)
+
+// Content types of the formats
+const (
+	MIMEJSON = "application/json"
+	MIMEXML  = "application/xml"
+	MIMEYAML = "application/yaml"
+	MIMECSV  = "text/csv"
+)
+
+// Alternative content types, responses have the content type the client asked for
+var (
+	xmlTypes  = []string{MIMEXML, "text/xml"}
+	yamlTypes = []string{MIMEYAML, "application/x-yaml", "text/yaml"}
+)
+
+// Table is implemented by list responses, which can also be sent as CSV
+type Table interface {
+	CSVHeader() []string
+	CSVRows() [][]string
+}
+
+// Paged is implemented by paginated lists. CSV has no place for the pagination,
+// so it is sent in the X-Total-Count, X-Page, X-Per-Page and X-Total-Pages headers.
+type Paged interface {
+	Pagination() (total int64, page, perPage, totalPages int)
+}
+
+// PaginationHeaders are the headers of the pagination of CSV responses
+var PaginationHeaders = []string{"X-Total-Count", "X-Page", "X-Per-Page", "X-Total-Pages"}
+
+// ErrorResponse is the error body of responses without a handler of their own, e.g. of middlewares.
+// It has the same shape as the error responses of the API.
+type ErrorResponse struct {
+	XMLName xml.Name `json:"-" xml:"error"`
+	Error   string   `json:"error" xml:"error"`
+	Message string   `json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// Send writes v in the format accepted by the client.
+// Lists implementing Table can be sent as CSV, other responses are JSON, XML or YAML.
+// If no format is acceptable, the response is 406 Not Acceptable, unless it already is an error:
+// errors are sent as JSON rather than replaced.
+func Send(c *fiber.Ctx, v any) error {
+	c.Vary(fiber.HeaderAccept)
+	offers := make([]string, 0, 7)
+	offers = append(offers, MIMEJSON)
+	offers = append(offers, xmlTypes...)
+	offers = append(offers, yamlTypes...)
+	table, isTable := v.(Table)
+	if isTable {
+		offers = append(offers, MIMECSV)
+	}
+
+	switch accepted := c.Accepts(offers...); accepted {
+	case MIMEJSON:
+		return c.JSON(v)
+	case xmlTypes[0], xmlTypes[1]:
+		return sendXML(c, accepted, v)
+	case yamlTypes[0], yamlTypes[1], yamlTypes[2]:
+		return sendYAML(c, accepted, v)
+	case MIMECSV:
+		return sendCSV(c, table)
+	}
+	if c.Response().StatusCode() >= fiber.StatusBadRequest {
+		return c.JSON(v)
+	}
+	message := "Acceptable types are application/json, application/xml, application/yaml"
+	if isTable {
+		message += ", text/csv"
+	}
+	return c.Status(fiber.StatusNotAcceptable).JSON(ErrorResponse{
+		Error:   "Not Acceptable",
+		Message: message,
+	})
+}
+
+func sendXML(c *fiber.Ctx, contentType string, v any) error {
+	body, err := xml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, contentType+"; charset=utf-8")
+	return c.Send(append([]byte(xml.Header), body...))
+}
+
+func sendYAML(c *fiber.Ctx, contentType string, v any) error {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// JSON is YAML in flow style, the node keeps the order of the keys
+	var node yaml.Node
+	if err := yaml.Unmarshal(body, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	c.Set(fiber.HeaderContentType, contentType+"; charset=utf-8")
+	return c.Send(buf.Bytes())
+}
+
+// blockStyle drops the flow style and the quotes of JSON, the encoder quotes strings where needed
+func blockStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
+
+func sendCSV(c *fiber.Ctx, table Table) error {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(table.CSVHeader())
+	_ = w.WriteAll(table.CSVRows()) // flushes and reports write errors
+	if err := w.Error(); err != nil {
+		return err
+	}
+	if paged, ok := table.(Paged); ok {
+		total, page, perPage, totalPages := paged.Pagination()
+		c.Set(PaginationHeaders[0], strconv.FormatInt(total, 10))
+		c.Set(PaginationHeaders[1], strconv.Itoa(page))
+		c.Set(PaginationHeaders[2], strconv.Itoa(perPage))
+		c.Set(PaginationHeaders[3], strconv.Itoa(totalPages))
+	}
+	c.Set(fiber.HeaderContentType, MIMECSV+"; charset=utf-8")
+	return c.Send(buf.Bytes())
+}
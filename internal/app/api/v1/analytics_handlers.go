@@ -0,0 +1,213 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+)
+
+// GetPrizeTrends godoc
+//
+//	@Summary		Prize trends
+//	@Description	Returns the numbers of awarded, not awarded, solo and shared prizes and of their laureates per year, decade or category.
+//	@Description	Analytics are refreshed on every import, changes made through the API are seen after the next import.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			group_by			query		string	false	"Grouping"	Enums(year, decade, category)	default(year)
+//	@Param			category			query		string	false	"Prize category"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	PrizeTrendListResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		400					{object}	ErrorResponse
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/analytics/prizes [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetPrizeTrends(c *fiber.Ctx) error {
+	trends, err := h.analytics.GetPrizeTrends(c.UserContext(), c.Query("group_by", "year"), c.Query("category"))
+	if errors.Is(err, ErrUnknownGroup) {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return render.Send(c, trends)
+}
+
+// GetLaureateBreakdown godoc
+//
+//	@Summary		Laureate breakdown
+//	@Description	Returns the numbers of awarded persons by gender and of awarded organizations per year, decade or category.
+//	@Description	A laureate is counted once per prize, persons without a known gender are counted as unknown.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			group_by			query		string	false	"Grouping"	Enums(year, decade, category)	default(year)
+//	@Param			category			query		string	false	"Prize category"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	LaureateBreakdownListResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		400					{object}	ErrorResponse
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/analytics/laureates [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetLaureateBreakdown(c *fiber.Ctx) error {
+	breakdown, err := h.analytics.GetLaureateBreakdown(c.UserContext(), c.Query("group_by", "year"), c.Query("category"))
+	if errors.Is(err, ErrUnknownGroup) {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return render.Send(c, breakdown)
+}
+
+// GetSharedPrizes godoc
+//
+//	@Summary		Shared and solo prizes
+//	@Description	Returns the numbers of solo, shared and not awarded prizes and the number of prizes per number of laureates
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	SharedPrizesResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/analytics/shared-prizes [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetSharedPrizes(c *fiber.Ctx) error {
+	shared, err := h.analytics.GetSharedPrizes(c.UserContext())
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return render.Send(c, shared)
+}
+
+// GetShareDistribution godoc
+//
+//	@Summary		Share distribution
+//	@Description	Returns the number of laureates per prize share, a laureate received 1/share of the prize
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	ShareDistributionResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/analytics/shares [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetShareDistribution(c *fiber.Ctx) error {
+	shares, err := h.analytics.GetShareDistribution(c.UserContext())
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return render.Send(c, shares)
+}
+
+// GetMultipleWinners godoc
+//
+//	@Summary		Multiple winners
+//	@Description	Returns the laureates with several prizes and the number of laureates per number of prizes
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	MultipleWinnersResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/analytics/multiple-winners [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetMultipleWinners(c *fiber.Ctx) error {
+	winners, err := h.analytics.GetMultipleWinners(c.UserContext())
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return render.Send(c, winners)
+}
+
+// GetNotAwardedYears godoc
+//
+//	@Summary		Years without an award
+//	@Description	Returns the years from the first prize of a category to the last year of the dataset in which the category was not awarded.
+//	@Description	Categories awarded every year are not listed.
+//	@Tags			Analytics
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			category			query		string	false	"Prize category"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	NotAwardedYearsListResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/analytics/not-awarded [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetNotAwardedYears(c *fiber.Ctx) error {
+	years, err := h.analytics.GetNotAwardedYears(c.UserContext(), c.Query("category"))
+	if err != nil {
+		return analyticsError(c, err)
+	}
+	return render.Send(c, years)
+}
+
+// analyticsError responds with the error of an analytics query
+func analyticsError(c *fiber.Ctx, err error) error {
+	return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+		Error:   "Internal Server Error",
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,249 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"ris/pkg/postgres/queries"
+)
+
+// ErrUnknownGroup is returned for prize trends and laureate breakdowns grouped by an unknown field
+var ErrUnknownGroup = errors.New("unknown group, expected year, decade or category")
+
+// AnalyticsService defines the interface for the analytics of the dataset
+type AnalyticsService interface {
+	GetPrizeTrends(ctx context.Context, groupBy, category string) (*PrizeTrendListResponse, error)
+	GetLaureateBreakdown(ctx context.Context, groupBy, category string) (*LaureateBreakdownListResponse, error)
+	GetSharedPrizes(ctx context.Context) (*SharedPrizesResponse, error)
+	GetShareDistribution(ctx context.Context) (*ShareDistributionResponse, error)
+	GetMultipleWinners(ctx context.Context) (*MultipleWinnersResponse, error)
+	GetNotAwardedYears(ctx context.Context, category string) (*NotAwardedYearsListResponse, error)
+}
+
+// Analytics implements the AnalyticsService interface.
+// It reads materialized views, which are refreshed by the importer:
+// changes made through the API are seen after the next import.
+type Analytics struct {
+	queries *queries.Queries
+}
+
+// NewAnalytics creates a new Analytics instance
+func NewAnalytics(q *queries.Queries) *Analytics {
+	return &Analytics{queries: q}
+}
+
+// GetPrizeTrends returns prize counts grouped by year, decade or category,
+// optionally of a single category
+func (a *Analytics) GetPrizeTrends(ctx context.Context, groupBy, category string) (*PrizeTrendListResponse, error) {
+	filter := pgtype.Text{String: category, Valid: category != ""}
+	var data []PrizeTrendResponse
+	switch groupBy {
+	case "year":
+		rows, err := a.queries.AnalyticsPrizesByYear(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get prizes by year: %w", err)
+		}
+		data = make([]PrizeTrendResponse, len(rows))
+		for i, r := range rows {
+			data[i] = PrizeTrendResponse{
+				Year:            &r.Year,
+				AwardedCount:    r.AwardedCount,
+				NotAwardedCount: r.NotAwardedCount,
+				SoloCount:       r.SoloCount,
+				SharedCount:     r.SharedCount,
+				LaureatesCount:  r.LaureatesCount,
+			}
+		}
+	case "decade":
+		rows, err := a.queries.AnalyticsPrizesByDecade(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get prizes by decade: %w", err)
+		}
+		data = make([]PrizeTrendResponse, len(rows))
+		for i, r := range rows {
+			data[i] = PrizeTrendResponse{
+				Decade:          &r.Decade,
+				AwardedCount:    r.AwardedCount,
+				NotAwardedCount: r.NotAwardedCount,
+				SoloCount:       r.SoloCount,
+				SharedCount:     r.SharedCount,
+				LaureatesCount:  r.LaureatesCount,
+			}
+		}
+	case "category":
+		rows, err := a.queries.AnalyticsPrizesByCategory(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get prizes by category: %w", err)
+		}
+		data = make([]PrizeTrendResponse, 0, len(rows))
+		for _, r := range rows {
+			if category != "" && r.Category != category {
+				continue
+			}
+			data = append(data, PrizeTrendResponse{
+				Category:        r.Category,
+				AwardedCount:    r.AwardedCount,
+				NotAwardedCount: r.NotAwardedCount,
+				SoloCount:       r.SoloCount,
+				SharedCount:     r.SharedCount,
+				LaureatesCount:  r.LaureatesCount,
+			})
+		}
+	default:
+		return nil, ErrUnknownGroup
+	}
+	return &PrizeTrendListResponse{GroupBy: groupBy, Category: category, Data: data}, nil
+}
+
+// GetLaureateBreakdown returns the awarded persons by gender and organizations
+// grouped by year, decade or category, optionally of a single category
+func (a *Analytics) GetLaureateBreakdown(ctx context.Context, groupBy, category string) (*LaureateBreakdownListResponse, error) {
+	filter := pgtype.Text{String: category, Valid: category != ""}
+	var data []LaureateBreakdownResponse
+	switch groupBy {
+	case "year":
+		rows, err := a.queries.AnalyticsLaureatesByYear(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get laureates by year: %w", err)
+		}
+		data = make([]LaureateBreakdownResponse, len(rows))
+		for i, r := range rows {
+			data[i] = LaureateBreakdownResponse{
+				Year:               &r.Year,
+				PersonsCount:       r.PersonsCount,
+				OrganizationsCount: r.OrganizationsCount,
+				MaleCount:          r.MaleCount,
+				FemaleCount:        r.FemaleCount,
+				OtherGenderCount:   r.OtherGenderCount,
+				UnknownGenderCount: r.UnknownGenderCount,
+			}
+		}
+	case "decade":
+		rows, err := a.queries.AnalyticsLaureatesByDecade(ctx, filter)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get laureates by decade: %w", err)
+		}
+		data = make([]LaureateBreakdownResponse, len(rows))
+		for i, r := range rows {
+			data[i] = LaureateBreakdownResponse{
+				Decade:             &r.Decade,
+				PersonsCount:       r.PersonsCount,
+				OrganizationsCount: r.OrganizationsCount,
+				MaleCount:          r.MaleCount,
+				FemaleCount:        r.FemaleCount,
+				OtherGenderCount:   r.OtherGenderCount,
+				UnknownGenderCount: r.UnknownGenderCount,
+			}
+		}
+	case "category":
+		rows, err := a.queries.AnalyticsLaureatesByCategory(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get laureates by category: %w", err)
+		}
+		data = make([]LaureateBreakdownResponse, 0, len(rows))
+		for _, r := range rows {
+			if category != "" && r.Category != category {
+				continue
+			}
+			data = append(data, LaureateBreakdownResponse{
+				Category:           r.Category,
+				PersonsCount:       r.PersonsCount,
+				OrganizationsCount: r.OrganizationsCount,
+				MaleCount:          r.MaleCount,
+				FemaleCount:        r.FemaleCount,
+				OtherGenderCount:   r.OtherGenderCount,
+				UnknownGenderCount: r.UnknownGenderCount,
+			})
+		}
+	default:
+		return nil, ErrUnknownGroup
+	}
+	return &LaureateBreakdownListResponse{GroupBy: groupBy, Category: category, Data: data}, nil
+}
+
+// GetSharedPrizes returns the numbers of solo, shared and not awarded prizes
+// and the distribution of laureates per prize
+func (a *Analytics) GetSharedPrizes(ctx context.Context) (*SharedPrizesResponse, error) {
+	rows, err := a.queries.AnalyticsLaureatesPerPrize(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get laureates per prize: %w", err)
+	}
+	resp := &SharedPrizesResponse{Data: make([]LaureatesPerPrizeResponse, len(rows))}
+	for i, r := range rows {
+		switch {
+		case r.LaureatesCount == 0:
+			resp.NotAwardedCount += r.PrizesCount
+		case r.LaureatesCount == 1:
+			resp.SoloCount += r.PrizesCount
+		default:
+			resp.SharedCount += r.PrizesCount
+		}
+		resp.Data[i] = LaureatesPerPrizeResponse{LaureatesCount: r.LaureatesCount, PrizesCount: r.PrizesCount}
+	}
+	return resp, nil
+}
+
+// GetShareDistribution returns the number of laureates per prize share
+func (a *Analytics) GetShareDistribution(ctx context.Context) (*ShareDistributionResponse, error) {
+	rows, err := a.queries.AnalyticsShares(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share distribution: %w", err)
+	}
+	data := make([]ShareCountResponse, len(rows))
+	for i, r := range rows {
+		data[i] = ShareCountResponse{Share: r.Share, LaureatesCount: r.LaureatesCount}
+	}
+	return &ShareDistributionResponse{Data: data}, nil
+}
+
+// GetMultipleWinners returns the laureates with several prizes
+// and the distribution of prizes per laureate
+func (a *Analytics) GetMultipleWinners(ctx context.Context) (*MultipleWinnersResponse, error) {
+	distribution, err := a.queries.AnalyticsPrizesPerLaureate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get prizes per laureate: %w", err)
+	}
+	laureates, err := a.queries.AnalyticsMultipleWinners(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get multiple winners: %w", err)
+	}
+	resp := &MultipleWinnersResponse{
+		Distribution: make([]PrizesPerLaureateResponse, len(distribution)),
+		Data:         make([]MultipleWinnerResponse, len(laureates)),
+	}
+	for i, r := range distribution {
+		resp.Distribution[i] = PrizesPerLaureateResponse{PrizesCount: r.PrizesCount, LaureatesCount: r.LaureatesCount}
+	}
+	for i, l := range laureates {
+		resp.Data[i] = MultipleWinnerResponse{
+			ID:          l.LaureateID,
+			Firstname:   l.Firstname,
+			Surname:     l.Surname,
+			PrizesCount: l.PrizesCount,
+			FirstYear:   l.FirstYear,
+			LastYear:    l.LastYear,
+		}
+	}
+	return resp, nil
+}
+
+// GetNotAwardedYears returns the years without an awarded prize per category, optionally of a single category
+func (a *Analytics) GetNotAwardedYears(ctx context.Context, category string) (*NotAwardedYearsListResponse, error) {
+	rows, err := a.queries.AnalyticsNotAwardedYears(ctx, pgtype.Text{String: category, Valid: category != ""})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get not awarded years: %w", err)
+	}
+	// Rows are ordered by category
+	data := make([]NotAwardedYearsResponse, 0)
+	for _, r := range rows {
+		if len(data) == 0 || data[len(data)-1].Category != r.Category {
+			data = append(data, NotAwardedYearsResponse{Category: r.Category, Years: make([]int32, 0)})
+		}
+		last := &data[len(data)-1]
+		last.Years = append(last.Years, r.Year)
+	}
+	return &NotAwardedYearsListResponse{Data: data}, nil
+}
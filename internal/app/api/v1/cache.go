@@ -0,0 +1,232 @@
+package v1
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// CachedService is an in-process cache layer around Service.
+// It caches reads which change rarely (stats, last update, categories, prizes by year)
+// and drops the whole cache on every write made through it or on Invalidate call.
+type CachedService struct {
+	service Service
+	ttl     time.Duration
+
+	mu         sync.RWMutex
+	entries    map[string]cacheEntry
+	generation uint64
+	// lastChange is the time of the last invalidation. Deletions do not touch
+	// updated_at columns, so it is taken into account by GetLastUpdate.
+	lastChange time.Time
+
+	group singleflight.Group
+}
+
+type cacheEntry struct {
+	value   any
+	expires time.Time
+}
+
+// NewCachedService creates a new CachedService. Entries expire after ttl
+// even without invalidation, which covers writes made bypassing the service.
+func NewCachedService(service Service, ttl time.Duration) *CachedService {
+	return &CachedService{
+		service: service,
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry),
+	}
+}
+
+// Invalidate drops all cached entries
+func (s *CachedService) Invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = make(map[string]cacheEntry)
+	s.generation++
+	s.lastChange = time.Now()
+}
+
+// cacheLoadTimeout bounds loads, which do not end with the request that started them
+const cacheLoadTimeout = 10 * time.Second
+
+// cached returns the value stored under key or loads it.
+// Concurrent loads of the same key are collapsed into one call. The load does not use
+// the context of the request which started it: other requests may wait for the result.
+// A request stops waiting when its own context is done.
+func cached[T any](ctx context.Context, s *CachedService, key string, load func(ctx context.Context) (T, error)) (T, error) {
+	s.mu.RLock()
+	entry, ok := s.entries[key]
+	generation := s.generation
+	s.mu.RUnlock()
+	if ok && time.Now().Before(entry.expires) {
+		return entry.value.(T), nil
+	}
+
+	ch := s.group.DoChan(key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cacheLoadTimeout)
+		defer cancel()
+		value, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		s.mu.Lock()
+		// Do not store a value loaded before an invalidation, it may be stale already
+		if s.generation == generation {
+			s.entries[key] = cacheEntry{value: value, expires: time.Now().Add(s.ttl)}
+		}
+		s.mu.Unlock()
+		return value, nil
+	})
+
+	var zero T
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			return zero, res.Err
+		}
+		return res.Val.(T), nil
+	}
+}
+
+// GetStats returns cached statistics about the dataset
+func (s *CachedService) GetStats(ctx context.Context) (*StatsResponse, error) {
+	return cached(ctx, s, "stats", func(ctx context.Context) (*StatsResponse, error) {
+		return s.service.GetStats(ctx)
+	})
+}
+
+// GetLastUpdate returns the cached last update timestamp
+func (s *CachedService) GetLastUpdate(ctx context.Context) (*LastUpdateResponse, error) {
+	lastUpdate, err := cached(ctx, s, "last-update", func(ctx context.Context) (*LastUpdateResponse, error) {
+		return s.service.GetLastUpdate(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	lastChange := s.lastChange
+	s.mu.RUnlock()
+	if lastChange.After(lastUpdate.LastUpdate) {
+		return &LastUpdateResponse{LastUpdate: lastChange}, nil
+	}
+	return lastUpdate, nil
+}
+
+// ListLaureates returns a paginated list of laureates matching the filter
+func (s *CachedService) ListLaureates(ctx context.Context, page, perPage int, filter LaureateFilter) (*LaureateListResponse, error) {
+	return s.service.ListLaureates(ctx, page, perPage, filter)
+}
+
+// GetLaureate returns a single laureate by ID
+func (s *CachedService) GetLaureate(ctx context.Context, id int32) (*LaureateResponse, error) {
+	return s.service.GetLaureate(ctx, id)
+}
+
+// CreateLaureate creates a new laureate and invalidates the cache
+func (s *CachedService) CreateLaureate(ctx context.Context, req *CreateLaureateRequest) (*LaureateResponse, error) {
+	// Invalidate even on error: a failed write may still have changed the data
+	defer s.Invalidate()
+	return s.service.CreateLaureate(ctx, req)
+}
+
+// UpdateLaureate updates an existing laureate and invalidates the cache
+func (s *CachedService) UpdateLaureate(ctx context.Context, id int32, req *UpdateLaureateRequest) (*LaureateResponse, error) {
+	defer s.Invalidate()
+	return s.service.UpdateLaureate(ctx, id, req)
+}
+
+// DeleteLaureate deletes a laureate by ID and invalidates the cache
+func (s *CachedService) DeleteLaureate(ctx context.Context, id int32) error {
+	defer s.Invalidate()
+	return s.service.DeleteLaureate(ctx, id)
+}
+
+// ListPrizes returns a paginated list of prizes
+func (s *CachedService) ListPrizes(ctx context.Context, page, perPage int) (*PrizeListResponse, error) {
+	return s.service.ListPrizes(ctx, page, perPage)
+}
+
+// GetPrize returns a single prize with its laureates
+func (s *CachedService) GetPrize(ctx context.Context, id int32) (*PrizeResponse, error) {
+	return s.service.GetPrize(ctx, id)
+}
+
+// GetPrizesByCategory returns prizes filtered by category
+func (s *CachedService) GetPrizesByCategory(ctx context.Context, category string) ([]PrizeResponse, error) {
+	return s.service.GetPrizesByCategory(ctx, category)
+}
+
+// GetPrizesByYear returns cached prizes filtered by year
+func (s *CachedService) GetPrizesByYear(ctx context.Context, year int32) ([]PrizeResponse, error) {
+	return cached(ctx, s, "prizes-by-year:"+strconv.Itoa(int(year)), func(ctx context.Context) ([]PrizeResponse, error) {
+		return s.service.GetPrizesByYear(ctx, year)
+	})
+}
+
+// CreatePrize creates a new prize and invalidates the cache
+func (s *CachedService) CreatePrize(ctx context.Context, req *CreatePrizeRequest) (*PrizeResponse, error) {
+	defer s.Invalidate()
+	return s.service.CreatePrize(ctx, req)
+}
+
+// UpdatePrize updates an existing prize and invalidates the cache
+func (s *CachedService) UpdatePrize(ctx context.Context, id int32, req *UpdatePrizeRequest) (*PrizeResponse, error) {
+	defer s.Invalidate()
+	return s.service.UpdatePrize(ctx, id, req)
+}
+
+// DeletePrize deletes a prize by ID and invalidates the cache
+func (s *CachedService) DeletePrize(ctx context.Context, id int32) error {
+	defer s.Invalidate()
+	return s.service.DeletePrize(ctx, id)
+}
+
+// LinkLaureate links a laureate to a prize and invalidates the cache
+func (s *CachedService) LinkLaureate(ctx context.Context, prizeID, laureateID int32) (*PrizeResponse, error) {
+	defer s.Invalidate()
+	return s.service.LinkLaureate(ctx, prizeID, laureateID)
+}
+
+// UnlinkLaureate unlinks a laureate from a prize and invalidates the cache
+func (s *CachedService) UnlinkLaureate(ctx context.Context, prizeID, laureateID int32) (*PrizeResponse, error) {
+	defer s.Invalidate()
+	return s.service.UnlinkLaureate(ctx, prizeID, laureateID)
+}
+
+// GetCategories returns cached prize categories
+func (s *CachedService) GetCategories(ctx context.Context) (*CategoriesResponse, error) {
+	return cached(ctx, s, "categories", func(ctx context.Context) (*CategoriesResponse, error) {
+		return s.service.GetCategories(ctx)
+	})
+}
+
+// GetCategory returns a category by slug
+func (s *CachedService) GetCategory(ctx context.Context, slug string) (*CategoryResponse, error) {
+	return s.service.GetCategory(ctx, slug)
+}
+
+// CreateCategory creates a new category and invalidates the cache
+func (s *CachedService) CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*CategoryResponse, error) {
+	defer s.Invalidate()
+	return s.service.CreateCategory(ctx, req)
+}
+
+// UpdateCategory updates an existing category and invalidates the cache
+func (s *CachedService) UpdateCategory(ctx context.Context, slug string, req *UpdateCategoryRequest) (*CategoryResponse, error) {
+	defer s.Invalidate()
+	return s.service.UpdateCategory(ctx, slug, req)
+}
+
+// DeleteCategory deletes a category by slug and invalidates the cache
+func (s *CachedService) DeleteCategory(ctx context.Context, slug string) error {
+	defer s.Invalidate()
+	return s.service.DeleteCategory(ctx, slug)
+}
@@ -0,0 +1,185 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+)
+
+// GetCategories godoc
+//
+//	@Summary		Get all categories
+//	@Description	Returns all prize categories ordered by slug
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	CategoriesResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/categories [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetCategories(c *fiber.Ctx) error {
+	categories, err := h.service.GetCategories(c.UserContext())
+	if err != nil {
+		return categoryError(c, err)
+	}
+	return render.Send(c, categories)
+}
+
+// GetCategory godoc
+//
+//	@Summary		Get a category by slug
+//	@Description	Returns a prize category with its localized names
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			slug				path		string	true	"Category slug"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	CategoryResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		404					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
+//	@Router			/api/v1/categories/{slug} [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetCategory(c *fiber.Ctx) error {
+	category, err := h.service.GetCategory(c.UserContext(), c.Params("slug"))
+	if err != nil {
+		return categoryError(c, err)
+	}
+	return render.Send(c, category)
+}
+
+// CreateCategory godoc
+//
+//	@Summary		Create a category
+//	@Description	Creates a prize category. Prizes can be created in it from the established year.
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			category	body		CreateCategoryRequest	true	"Category data"
+//	@Success		201			{object}	CategoryResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		401			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		409			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/api/v1/categories [post]
+//	@security		ApiKeyAuth
+func (h *Handler) CreateCategory(c *fiber.Ctx) error {
+	req, ok := parseBody[CreateCategoryRequest](h, c)
+	if !ok {
+		return nil
+	}
+
+	category, err := h.service.CreateCategory(c.UserContext(), &req)
+	if err != nil {
+		return categoryError(c, err)
+	}
+	return render.Send(c.Status(fiber.StatusCreated), category)
+}
+
+// UpdateCategory godoc
+//
+//	@Summary		Update a category
+//	@Description	Updates the names and the established year of a category, the slug can not be changed.
+//	@Description	The established year can not be later than the first prize of the category.
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			slug		path		string					true	"Category slug"
+//	@Param			category	body		UpdateCategoryRequest	true	"Category data"
+//	@Success		200			{object}	CategoryResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		401			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/api/v1/categories/{slug} [put]
+//	@security		ApiKeyAuth
+func (h *Handler) UpdateCategory(c *fiber.Ctx) error {
+	req, ok := parseBody[UpdateCategoryRequest](h, c)
+	if !ok {
+		return nil
+	}
+
+	category, err := h.service.UpdateCategory(c.UserContext(), c.Params("slug"), &req)
+	if err != nil {
+		return categoryError(c, err)
+	}
+	return render.Send(c, category)
+}
+
+// DeleteCategory godoc
+//
+//	@Summary		Delete a category
+//	@Description	Deletes a category without prizes. Deleting a missing category is not an error.
+//	@Tags			Categories
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			slug	path		string	true	"Category slug"
+//	@Success		200		{object}	SuccessResponse
+//	@Failure		401		{object}	ErrorResponse
+//	@Failure		406		{object}	ErrorResponse
+//	@Failure		409		{object}	ErrorResponse
+//	@Failure		429		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Router			/api/v1/categories/{slug} [delete]
+//	@security		ApiKeyAuth
+func (h *Handler) DeleteCategory(c *fiber.Ctx) error {
+	if err := h.service.DeleteCategory(c.UserContext(), c.Params("slug")); err != nil {
+		return categoryError(c, err)
+	}
+	return render.Send(c, SuccessResponse{Message: "Category deleted successfully"})
+}
+
+// categoryError responds with the status of an error of categories or of the category of a prize
+func categoryError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, ErrCategoryNotFound):
+		return render.Send(c.Status(fiber.StatusNotFound), ErrorResponse{
+			Error:   "Not Found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, ErrCategoryExists), errors.Is(err, ErrCategoryInUse):
+		return render.Send(c.Status(fiber.StatusConflict), ErrorResponse{
+			Error:   "Conflict",
+			Message: err.Error(),
+		})
+	case errors.Is(err, ErrUnknownCategory), errors.Is(err, ErrCategoryNotEstablished):
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Validation Error",
+			Message: err.Error(),
+		})
+	}
+	return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+		Error:   "Internal Server Error",
+		Message: err.Error(),
+	})
+}
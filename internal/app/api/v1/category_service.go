@@ -0,0 +1,230 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"ris/internal/domain"
+	"ris/internal/events"
+	"ris/pkg/postgres/queries"
+)
+
+var (
+	// ErrCategoryNotFound is returned for unknown categories
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrCategoryExists is returned when a category with the slug already exists
+	ErrCategoryExists = errors.New("category already exists")
+	// ErrCategoryInUse is returned when a category with prizes is deleted
+	ErrCategoryInUse = errors.New("category has prizes")
+	// ErrUnknownCategory is returned for prizes of unknown categories
+	ErrUnknownCategory = errors.New("unknown category")
+	// ErrCategoryNotEstablished is returned for prizes awarded before their category was established
+	ErrCategoryNotEstablished = errors.New("category is not established in the year of the prize")
+)
+
+// GetCategories returns all prize categories
+func (s *NobelService) GetCategories(ctx context.Context) (*CategoriesResponse, error) {
+	categories, err := s.queries.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get categories: %w", err)
+	}
+	data := make([]CategoryResponse, len(categories))
+	for i, c := range categories {
+		data[i] = categoryToResponse(c)
+	}
+	return &CategoriesResponse{Data: data}, nil
+}
+
+// GetCategory returns a category by slug
+func (s *NobelService) GetCategory(ctx context.Context, slug string) (*CategoryResponse, error) {
+	category, err := s.queries.GetCategory(ctx, slug)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	resp := categoryToResponse(category)
+	return &resp, nil
+}
+
+// CreateCategory creates a new category
+func (s *NobelService) CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*CategoryResponse, error) {
+	names, err := json.Marshal(nonNilNames(req.LocalizedNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode localized names: %w", err)
+	}
+	var category queries.Category
+	err = s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		var err error
+		category, err = q.CreateCategory(ctx, queries.CreateCategoryParams{
+			Slug:            req.Slug,
+			Name:            req.Name,
+			LocalizedNames:  names,
+			EstablishedYear: req.EstablishedYear,
+		})
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return ErrCategoryExists
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create category: %w", err)
+		}
+		return s.outbox.Enqueue(ctx, q, events.TypeCategoryCreated, category.Slug, categoryToDomain(category))
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := categoryToResponse(category)
+	return &resp, nil
+}
+
+// UpdateCategory updates an existing category.
+// The established year can not be later than the first prize of the category.
+func (s *NobelService) UpdateCategory(ctx context.Context, slug string, req *UpdateCategoryRequest) (*CategoryResponse, error) {
+	names, err := json.Marshal(nonNilNames(req.LocalizedNames))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode localized names: %w", err)
+	}
+	var category queries.Category
+	err = s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		previous, err := q.GetCategoryForUpdate(ctx, slug)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrCategoryNotFound
+		}
+		if err != nil {
+			return fmt.Errorf("failed to update category: %w", err)
+		}
+
+		category, err = q.UpdateCategory(ctx, queries.UpdateCategoryParams{
+			Slug:            slug,
+			Name:            req.Name,
+			LocalizedNames:  names,
+			EstablishedYear: req.EstablishedYear,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update category: %w", err)
+		}
+
+		firstYear, err := q.GetFirstPrizeYear(ctx, slug)
+		if err != nil {
+			return fmt.Errorf("failed to get first prize year: %w", err)
+		}
+		if firstYear != 0 && firstYear < req.EstablishedYear {
+			return fmt.Errorf("%w: the category has a prize of %d", ErrCategoryNotEstablished, firstYear)
+		}
+
+		event := domain.CategoryUpdated{
+			Slug:     slug,
+			Current:  categoryToDomain(category),
+			Previous: categoryToDomain(previous),
+		}
+		event.Changed = changedCategoryFields(event.Previous, event.Current)
+		if len(event.Changed) == 0 {
+			return nil
+		}
+		return s.outbox.Enqueue(ctx, q, events.TypeCategoryUpdated, slug, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := categoryToResponse(category)
+	return &resp, nil
+}
+
+// DeleteCategory deletes a category without prizes. Deleting a missing category is not an error.
+func (s *NobelService) DeleteCategory(ctx context.Context, slug string) error {
+	return s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		previous, err := q.GetCategoryForUpdate(ctx, slug)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete category: %w", err)
+		}
+
+		_, err = q.DeleteCategory(ctx, slug)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23503" {
+			return ErrCategoryInUse
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete category: %w", err)
+		}
+
+		return s.outbox.Enqueue(ctx, q, events.TypeCategoryDeleted, slug, domain.CategoryDeleted{
+			Slug:     slug,
+			Previous: categoryToDomain(previous),
+		})
+	})
+}
+
+// prizeCategory returns the slug of the category of a prize.
+// Categories are matched case-insensitively, the category must be established by the year of the prize.
+func prizeCategory(ctx context.Context, q *queries.Queries, category string, year int32) (string, error) {
+	slug := strings.ToLower(strings.TrimSpace(category))
+	c, err := q.GetCategory(ctx, slug)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", fmt.Errorf("%w %q", ErrUnknownCategory, category)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get category: %w", err)
+	}
+	if year < c.EstablishedYear {
+		return "", fmt.Errorf("%w: %s is awarded since %d", ErrCategoryNotEstablished, c.Name, c.EstablishedYear)
+	}
+	return slug, nil
+}
+
+func categoryToResponse(c queries.Category) CategoryResponse {
+	names := LocalizedNames{}
+	// The column is a JSON object written by the service or the schema
+	_ = json.Unmarshal(c.LocalizedNames, &names)
+	return CategoryResponse{
+		Slug:            c.Slug,
+		Name:            c.Name,
+		LocalizedNames:  names,
+		EstablishedYear: c.EstablishedYear,
+		CreatedAt:       *formatTimestamp(c.CreatedAt),
+		UpdatedAt:       *formatTimestamp(c.UpdatedAt),
+	}
+}
+
+func categoryToDomain(c queries.Category) domain.Category {
+	names := map[string]string{}
+	_ = json.Unmarshal(c.LocalizedNames, &names)
+	return domain.Category{
+		Slug:            c.Slug,
+		Name:            c.Name,
+		LocalizedNames:  names,
+		EstablishedYear: c.EstablishedYear,
+	}
+}
+
+func changedCategoryFields(prev, cur domain.Category) []string {
+	var changed []string
+	if prev.Name != cur.Name {
+		changed = append(changed, "name")
+	}
+	if !maps.Equal(prev.LocalizedNames, cur.LocalizedNames) {
+		changed = append(changed, "localized_names")
+	}
+	if prev.EstablishedYear != cur.EstablishedYear {
+		changed = append(changed, "established_year")
+	}
+	return changed
+}
+
+func nonNilNames(names LocalizedNames) LocalizedNames {
+	if names == nil {
+		return LocalizedNames{}
+	}
+	return names
+}
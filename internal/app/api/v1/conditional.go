@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// conditionalGet sets ETag and Last-Modified headers on successful GET responses
+// and answers 304 Not Modified to If-None-Match / If-Modified-Since requests.
+//
+// Last-Modified is the dataset-wide last update time, so it can be checked before
+// the handler runs. ETag is a hash of the response body and is checked afterwards.
+func (h *Handler) conditionalGet(c *fiber.Ctx) error {
+	if c.Method() != fiber.MethodGet && c.Method() != fiber.MethodHead {
+		return c.Next()
+	}
+
+	var lastModified time.Time
+	if lastUpdate, err := h.service.GetLastUpdate(c.UserContext()); err == nil {
+		lastModified = lastUpdate.LastUpdate.UTC().Truncate(time.Second)
+	}
+
+	// If-None-Match takes precedence over If-Modified-Since (RFC 9110, section 13.2.2)
+	ifNoneMatch := c.Get(fiber.HeaderIfNoneMatch)
+	if ifNoneMatch == "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(c.Get(fiber.HeaderIfModifiedSince))
+		if err == nil && !lastModified.After(since) {
+			c.Set(fiber.HeaderLastModified, lastModified.Format(http.TimeFormat))
+			// The response would have been negotiated, see render.Send
+			c.Vary(fiber.HeaderAccept)
+			return c.SendStatus(fiber.StatusNotModified)
+		}
+	}
+
+	if err := c.Next(); err != nil {
+		return err
+	}
+	if c.Response().StatusCode() != fiber.StatusOK {
+		return nil
+	}
+
+	if !lastModified.IsZero() {
+		c.Set(fiber.HeaderLastModified, lastModified.Format(http.TimeFormat))
+	}
+	sum := sha256.Sum256(c.Response().Body())
+	etag := `"` + hex.EncodeToString(sum[:12]) + `"`
+	c.Set(fiber.HeaderETag, etag)
+
+	if ifNoneMatch != "" && etagMatches(ifNoneMatch, etag) {
+		c.Response().ResetBody()
+		return c.SendStatus(fiber.StatusNotModified)
+	}
+	return nil
+}
+
+// etagMatches checks If-None-Match header value against etag using weak comparison
+func etagMatches(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
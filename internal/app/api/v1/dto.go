@@ -1,124 +1,452 @@
 package v1
 
-import "time"
+import (
+	"encoding/xml"
+	"maps"
+	"slices"
+	"time"
+)
 
 // ErrorResponse represents an API error response
 //
 //	@Description	Error response with message
 type ErrorResponse struct {
-	Error   string `json:"error"`
-	Message string `json:"message,omitempty"`
+	XMLName xml.Name `json:"-" xml:"error"`
+	Error   string   `json:"error" xml:"error"`
+	Message string   `json:"message,omitempty" xml:"message,omitempty"`
 }
 
 // StatsResponse represents statistics about the dataset
 //
 //	@Description	Dataset statistics
 type StatsResponse struct {
-	LaureatesCount  int64 `json:"laureates_count"`
-	PrizesCount     int64 `json:"prizes_count"`
-	CategoriesCount int64 `json:"categories_count"`
+	XMLName         xml.Name `json:"-" xml:"stats"`
+	LaureatesCount  int64    `json:"laureates_count" xml:"laureates_count"`
+	PrizesCount     int64    `json:"prizes_count" xml:"prizes_count"`
+	CategoriesCount int64    `json:"categories_count" xml:"categories_count"`
 }
 
 // LastUpdateResponse represents the last update timestamp
 //
 //	@Description	Last dataset update information
 type LastUpdateResponse struct {
-	LastUpdate time.Time `json:"last_update"`
+	XMLName    xml.Name  `json:"-" xml:"last_update"`
+	LastUpdate time.Time `json:"last_update" xml:"last_update"`
 }
 
 // LaureateResponse represents a laureate in API responses
 //
-//	@Description	Nobel laureate information
+//	@Description	Nobel laureate information, a person or an organization.
+//	@Description	Dates are YYYY-MM-DD, the birth of an organization is its foundation.
 type LaureateResponse struct {
-	ID         int32   `json:"id"`
-	Firstname  string  `json:"firstname"`
-	Surname    string  `json:"surname,omitempty"`
-	Motivation string  `json:"motivation"`
-	Share      int32   `json:"share"`
-	UpdatedAt  *string `json:"updated_at,omitempty"`
+	XMLName          xml.Name              `json:"-" xml:"laureate"`
+	ID               int32                 `json:"id" xml:"id"`
+	Firstname        string                `json:"firstname" xml:"firstname"`
+	Surname          string                `json:"surname,omitempty" xml:"surname,omitempty"`
+	Motivation       string                `json:"motivation" xml:"motivation"`
+	Share            int32                 `json:"share" xml:"share"`
+	Type             string                `json:"type" xml:"type" enums:"person,organization"`
+	Gender           string                `json:"gender,omitempty" xml:"gender,omitempty" enums:"male,female,other"`
+	BirthDate        string                `json:"birth_date,omitempty" xml:"birth_date,omitempty" example:"1867-11-07"`
+	BirthCity        string                `json:"birth_city,omitempty" xml:"birth_city,omitempty"`
+	BirthCountry     string                `json:"birth_country,omitempty" xml:"birth_country,omitempty"`
+	BirthCountryCode string                `json:"birth_country_code,omitempty" xml:"birth_country_code,omitempty" example:"PL"`
+	DeathDate        string                `json:"death_date,omitempty" xml:"death_date,omitempty"`
+	DeathCity        string                `json:"death_city,omitempty" xml:"death_city,omitempty"`
+	DeathCountry     string                `json:"death_country,omitempty" xml:"death_country,omitempty"`
+	DeathCountryCode string                `json:"death_country_code,omitempty" xml:"death_country_code,omitempty"`
+	Affiliations     []AffiliationResponse `json:"affiliations,omitempty" xml:"affiliations>affiliation,omitempty"`
+	UpdatedAt        *string               `json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// AffiliationResponse represents an affiliation of a laureate for a prize
+//
+//	@Description	University or institute where the laureate did the awarded work.
+//	@Description	The prize is set when the laureate is not listed within a prize.
+type AffiliationResponse struct {
+	XMLName xml.Name `json:"-" xml:"affiliation"`
+	PrizeID int32    `json:"prize_id,omitempty" xml:"prize_id,omitempty"`
+	Name    string   `json:"name" xml:"name"`
+	City    string   `json:"city,omitempty" xml:"city,omitempty"`
+	Country string   `json:"country,omitempty" xml:"country,omitempty"`
 }
 
 // LaureateListResponse represents a list of laureates
 //
 //	@Description	List of laureates with pagination info
 type LaureateListResponse struct {
-	Data       []LaureateResponse `json:"data"`
-	Total      int64              `json:"total"`
-	Page       int                `json:"page"`
-	PerPage    int                `json:"per_page"`
-	TotalPages int                `json:"total_pages"`
+	XMLName    xml.Name           `json:"-" xml:"laureates"`
+	Data       []LaureateResponse `json:"data" xml:"data>laureate"`
+	Total      int64              `json:"total" xml:"total"`
+	Page       int                `json:"page" xml:"page"`
+	PerPage    int                `json:"per_page" xml:"per_page"`
+	TotalPages int                `json:"total_pages" xml:"total_pages"`
+}
+
+// LaureateFilter filters the list of laureates, empty fields do not filter
+type LaureateFilter struct {
+	Type     string `query:"type" validate:"omitempty,oneof=person organization"`
+	Gender   string `query:"gender" validate:"omitempty,oneof=male female other"`
+	Country  string `query:"country" validate:"omitempty,max=255"` // code or part of the name of the birth country
+	BornFrom int32  `query:"born_from" validate:"omitempty,min=1"`
+	BornTo   int32  `query:"born_to" validate:"omitempty,min=1"`
+}
+
+// LaureateBio is the bio data of laureate requests
+type LaureateBio struct {
+	Type             string `json:"type,omitempty" validate:"omitempty,oneof=person organization" enums:"person,organization"`
+	Gender           string `json:"gender,omitempty" validate:"omitempty,oneof=male female other,excluded_if=Type organization" enums:"male,female,other"`
+	BirthDate        string `json:"birth_date,omitempty" validate:"omitempty,datetime=2006-01-02" example:"1867-11-07"`
+	BirthCity        string `json:"birth_city,omitempty" validate:"max=255"`
+	BirthCountry     string `json:"birth_country,omitempty" validate:"max=255"`
+	BirthCountryCode string `json:"birth_country_code,omitempty" validate:"omitempty,iso3166_1_alpha2" example:"PL"`
+	DeathDate        string `json:"death_date,omitempty" validate:"omitempty,datetime=2006-01-02"`
+	DeathCity        string `json:"death_city,omitempty" validate:"max=255"`
+	DeathCountry     string `json:"death_country,omitempty" validate:"max=255"`
+	DeathCountryCode string `json:"death_country_code,omitempty" validate:"omitempty,iso3166_1_alpha2"`
 }
 
 // CreateLaureateRequest represents the request to create a laureate
 //
-//	@Description	Create laureate request body
+//	@Description	Create laureate request body, a laureate is a person unless the type is organization
 type CreateLaureateRequest struct {
 	ID         int32  `json:"id" validate:"required"`
 	Firstname  string `json:"firstname" validate:"required"`
 	Surname    string `json:"surname,omitempty"`
 	Motivation string `json:"motivation" validate:"required"`
 	Share      int32  `json:"share" validate:"required,min=1,max=4"`
+	LaureateBio
 }
 
 // UpdateLaureateRequest represents the request to update a laureate
 //
-//	@Description	Update laureate request body
+//	@Description	Update laureate request body. The bio data is replaced when any of it is given and kept otherwise, the type is kept when omitted.
 type UpdateLaureateRequest struct {
 	Firstname  string `json:"firstname" validate:"required"`
 	Surname    string `json:"surname,omitempty"`
 	Motivation string `json:"motivation" validate:"required"`
 	Share      int32  `json:"share" validate:"required,min=1,max=4"`
+	LaureateBio
 }
 
 // PrizeResponse represents a prize in API responses
 //
 //	@Description	Nobel prize information
 type PrizeResponse struct {
-	ID        int32              `json:"id"`
-	Year      int32              `json:"year"`
-	Category  string             `json:"category"`
-	Laureates []LaureateResponse `json:"laureates,omitempty"`
-	UpdatedAt *string            `json:"updated_at,omitempty"`
+	XMLName   xml.Name           `json:"-" xml:"prize"`
+	ID        int32              `json:"id" xml:"id"`
+	Year      int32              `json:"year" xml:"year"`
+	Category  string             `json:"category" xml:"category"`
+	Laureates []LaureateResponse `json:"laureates,omitempty" xml:"laureates>laureate,omitempty"`
+	UpdatedAt *string            `json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
 // PrizeListResponse represents a list of prizes
 //
 //	@Description	List of prizes with pagination info
 type PrizeListResponse struct {
-	Data       []PrizeResponse `json:"data"`
-	Total      int64           `json:"total"`
-	Page       int             `json:"page"`
-	PerPage    int             `json:"per_page"`
-	TotalPages int             `json:"total_pages"`
+	XMLName    xml.Name        `json:"-" xml:"prizes"`
+	Data       []PrizeResponse `json:"data" xml:"data>prize"`
+	Total      int64           `json:"total" xml:"total"`
+	Page       int             `json:"page" xml:"page"`
+	PerPage    int             `json:"per_page" xml:"per_page"`
+	TotalPages int             `json:"total_pages" xml:"total_pages"`
 }
 
 // CreatePrizeRequest represents the request to create a prize
 //
-//	@Description	Create prize request body
+//	@Description	Create prize request body, the category is the slug of an existing category
 type CreatePrizeRequest struct {
 	Year        int32   `json:"year" validate:"required,min=1901"`
-	Category    string  `json:"category" validate:"required"`
+	Category    string  `json:"category" validate:"required" example:"physics"`
 	LaureateIDs []int32 `json:"laureate_ids,omitempty"`
 }
 
 // UpdatePrizeRequest represents the request to update a prize
 //
-//	@Description	Update prize request body
+//	@Description	Update prize request body, the category is the slug of an existing category
 type UpdatePrizeRequest struct {
 	Year     int32  `json:"year" validate:"required,min=1901"`
-	Category string `json:"category" validate:"required"`
+	Category string `json:"category" validate:"required" example:"physics"`
+}
+
+// LocalizedNames maps language codes to names
+type LocalizedNames map[string]string
+
+// MarshalXML writes the names as name elements with a lang attribute, ordered by language
+func (n LocalizedNames) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	for _, lang := range slices.Sorted(maps.Keys(n)) {
+		name := struct {
+			XMLName xml.Name `xml:"name"`
+			Lang    string   `xml:"lang,attr"`
+			Name    string   `xml:",chardata"`
+		}{Lang: lang, Name: n[lang]}
+		if err := e.Encode(name); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// CategoryResponse represents a prize category
+//
+//	@Description	Prize category, prizes refer to it by slug
+type CategoryResponse struct {
+	XMLName         xml.Name       `json:"-" xml:"category"`
+	Slug            string         `json:"slug" xml:"slug"`
+	Name            string         `json:"name" xml:"name"`
+	LocalizedNames  LocalizedNames `json:"localized_names" xml:"localized_names" swaggertype:"object,string" example:"ru:Физика"`
+	EstablishedYear int32          `json:"established_year" xml:"established_year"`
+	CreatedAt       string         `json:"created_at" xml:"created_at"`
+	UpdatedAt       string         `json:"updated_at" xml:"updated_at"`
 }
 
 // CategoriesResponse represents a list of categories
 //
 //	@Description	List of prize categories
 type CategoriesResponse struct {
-	Categories []string `json:"categories"`
+	XMLName xml.Name           `json:"-" xml:"categories"`
+	Data    []CategoryResponse `json:"data" xml:"data>category"`
+}
+
+// CreateCategoryRequest represents the request to create a category
+//
+//	@Description	Create category request body. The slug is lower-case letters, digits and dashes, it can not be changed.
+type CreateCategoryRequest struct {
+	Slug            string         `json:"slug" validate:"required,max=100,category_slug" example:"mathematics"`
+	Name            string         `json:"name" validate:"required,max=100" example:"Mathematics"`
+	LocalizedNames  LocalizedNames `json:"localized_names,omitempty" validate:"dive,keys,bcp47_language_tag,endkeys,required,max=100" swaggertype:"object,string" example:"ru:Математика"`
+	EstablishedYear int32          `json:"established_year" validate:"required,min=1901" example:"2030"`
+}
+
+// UpdateCategoryRequest represents the request to update a category
+//
+//	@Description	Update category request body, localized names are replaced
+type UpdateCategoryRequest struct {
+	Name            string         `json:"name" validate:"required,max=100" example:"Mathematics"`
+	LocalizedNames  LocalizedNames `json:"localized_names,omitempty" validate:"dive,keys,bcp47_language_tag,endkeys,required,max=100" swaggertype:"object,string" example:"ru:Математика"`
+	EstablishedYear int32          `json:"established_year" validate:"required,min=1901" example:"2030"`
 }
 
 // SuccessResponse represents a generic success response
 //
 //	@Description	Generic success response
 type SuccessResponse struct {
-	Message string `json:"message"`
+	XMLName xml.Name `json:"-" xml:"success"`
+	Message string   `json:"message" xml:"message"`
+}
+
+// WebhookResponse represents a webhook subscription in API responses
+//
+//	@Description	Webhook subscription, the secret is returned only when it is created or rotated
+type WebhookResponse struct {
+	XMLName             xml.Name `json:"-" xml:"webhook"`
+	ID                  int64    `json:"id" xml:"id"`
+	URL                 string   `json:"url" xml:"url"`
+	EventTypes          []string `json:"event_types" xml:"event_types>event_type"`
+	Description         string   `json:"description,omitempty" xml:"description,omitempty"`
+	Secret              string   `json:"secret,omitempty" xml:"secret,omitempty"`
+	Active              bool     `json:"active" xml:"active"`
+	ConsecutiveFailures int32    `json:"consecutive_failures" xml:"consecutive_failures"`
+	DisabledAt          *string  `json:"disabled_at,omitempty" xml:"disabled_at,omitempty"`
+	DisabledReason      *string  `json:"disabled_reason,omitempty" xml:"disabled_reason,omitempty"`
+	CreatedAt           string   `json:"created_at" xml:"created_at"`
+	UpdatedAt           string   `json:"updated_at" xml:"updated_at"`
+}
+
+// WebhookListResponse represents a list of webhooks
+//
+//	@Description	List of webhook subscriptions
+type WebhookListResponse struct {
+	XMLName xml.Name          `json:"-" xml:"webhooks"`
+	Data    []WebhookResponse `json:"data" xml:"data>webhook"`
+}
+
+// CreateWebhookRequest represents the request to create a webhook
+//
+//	@Description	Create webhook request body, a secret is generated when it is not set
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required,http_url"`
+	EventTypes  []string `json:"event_types" validate:"required,min=1,dive,webhook_event" example:"prize.created,laureate.*"`
+	Secret      string   `json:"secret,omitempty" validate:"omitempty,min=16"`
+	Description string   `json:"description,omitempty"`
+}
+
+// UpdateWebhookRequest represents the request to update a webhook
+//
+//	@Description	Update webhook request body, the secret is kept unless it is set or rotated
+type UpdateWebhookRequest struct {
+	URL          string   `json:"url" validate:"required,http_url"`
+	EventTypes   []string `json:"event_types" validate:"required,min=1,dive,webhook_event" example:"prize.created,laureate.*"`
+	Description  string   `json:"description,omitempty"`
+	Active       *bool    `json:"active" validate:"required"`
+	Secret       string   `json:"secret,omitempty" validate:"omitempty,min=16"`
+	RotateSecret bool     `json:"rotate_secret,omitempty"`
+}
+
+// WebhookDeliveryResponse represents a delivery of an event to a webhook
+//
+//	@Description	Webhook delivery attempt history
+type WebhookDeliveryResponse struct {
+	XMLName        xml.Name `json:"-" xml:"delivery"`
+	ID             int64    `json:"id" xml:"id"`
+	EventID        string   `json:"event_id" xml:"event_id"`
+	EventType      string   `json:"event_type" xml:"event_type"`
+	Status         string   `json:"status" xml:"status" enums:"pending,delivered,failed"`
+	Attempts       int32    `json:"attempts" xml:"attempts"`
+	NextAttemptAt  *string  `json:"next_attempt_at,omitempty" xml:"next_attempt_at,omitempty"`
+	ResponseStatus *int32   `json:"response_status,omitempty" xml:"response_status,omitempty"`
+	LastError      *string  `json:"last_error,omitempty" xml:"last_error,omitempty"`
+	DurationMs     *int32   `json:"duration_ms,omitempty" xml:"duration_ms,omitempty"`
+	CreatedAt      string   `json:"created_at" xml:"created_at"`
+	CompletedAt    *string  `json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+}
+
+// WebhookDeliveryListResponse represents a list of webhook deliveries
+//
+//	@Description	Latest deliveries of a webhook
+type WebhookDeliveryListResponse struct {
+	XMLName xml.Name                  `json:"-" xml:"deliveries"`
+	Data    []WebhookDeliveryResponse `json:"data" xml:"data>delivery"`
+}
+
+// PrizeTrendResponse represents the prizes of a year, a decade or a category.
+// Prizes without laureates were not awarded, prizes with several laureates are shared.
+//
+//	@Description	Prize counts of a year, a decade or a category
+type PrizeTrendResponse struct {
+	XMLName         xml.Name `json:"-" xml:"trend"`
+	Year            *int32   `json:"year,omitempty" xml:"year,omitempty"`
+	Decade          *int32   `json:"decade,omitempty" xml:"decade,omitempty"`
+	Category        string   `json:"category,omitempty" xml:"category,omitempty"`
+	AwardedCount    int32    `json:"awarded_count" xml:"awarded_count"`
+	NotAwardedCount int32    `json:"not_awarded_count" xml:"not_awarded_count"`
+	SoloCount       int32    `json:"solo_count" xml:"solo_count"`
+	SharedCount     int32    `json:"shared_count" xml:"shared_count"`
+	LaureatesCount  int32    `json:"laureates_count" xml:"laureates_count"`
+}
+
+// PrizeTrendListResponse represents prize counts grouped by year, decade or category
+//
+//	@Description	Prize counts grouped by year, decade or category
+type PrizeTrendListResponse struct {
+	XMLName  xml.Name             `json:"-" xml:"prize_trends"`
+	GroupBy  string               `json:"group_by" xml:"group_by" enums:"year,decade,category"`
+	Category string               `json:"category,omitempty" xml:"category,omitempty"`
+	Data     []PrizeTrendResponse `json:"data" xml:"data>trend"`
+}
+
+// LaureateBreakdownResponse represents the awarded laureates of a year, a decade or a category
+// by type and, for persons, by gender. A laureate is counted once per prize.
+//
+//	@Description	Awarded persons by gender and organizations of a year, a decade or a category
+type LaureateBreakdownResponse struct {
+	XMLName            xml.Name `json:"-" xml:"breakdown"`
+	Year               *int32   `json:"year,omitempty" xml:"year,omitempty"`
+	Decade             *int32   `json:"decade,omitempty" xml:"decade,omitempty"`
+	Category           string   `json:"category,omitempty" xml:"category,omitempty"`
+	PersonsCount       int32    `json:"persons_count" xml:"persons_count"`
+	OrganizationsCount int32    `json:"organizations_count" xml:"organizations_count"`
+	MaleCount          int32    `json:"male_count" xml:"male_count"`
+	FemaleCount        int32    `json:"female_count" xml:"female_count"`
+	OtherGenderCount   int32    `json:"other_gender_count" xml:"other_gender_count"`
+	UnknownGenderCount int32    `json:"unknown_gender_count" xml:"unknown_gender_count"`
+}
+
+// LaureateBreakdownListResponse represents laureate breakdowns grouped by year, decade or category
+//
+//	@Description	Awarded persons by gender and organizations grouped by year, decade or category
+type LaureateBreakdownListResponse struct {
+	XMLName  xml.Name                    `json:"-" xml:"laureate_breakdowns"`
+	GroupBy  string                      `json:"group_by" xml:"group_by" enums:"year,decade,category"`
+	Category string                      `json:"category,omitempty" xml:"category,omitempty"`
+	Data     []LaureateBreakdownResponse `json:"data" xml:"data>breakdown"`
+}
+
+// LaureatesPerPrizeResponse represents the number of prizes with a number of laureates
+//
+//	@Description	Number of prizes with the number of laureates
+type LaureatesPerPrizeResponse struct {
+	XMLName        xml.Name `json:"-" xml:"laureates_per_prize"`
+	LaureatesCount int32    `json:"laureates_count" xml:"laureates_count"`
+	PrizesCount    int32    `json:"prizes_count" xml:"prizes_count"`
+}
+
+// SharedPrizesResponse represents shared and solo prizes
+//
+//	@Description	Shared, solo and not awarded prizes
+type SharedPrizesResponse struct {
+	XMLName         xml.Name                    `json:"-" xml:"shared_prizes"`
+	SoloCount       int32                       `json:"solo_count" xml:"solo_count"`
+	SharedCount     int32                       `json:"shared_count" xml:"shared_count"`
+	NotAwardedCount int32                       `json:"not_awarded_count" xml:"not_awarded_count"`
+	Data            []LaureatesPerPrizeResponse `json:"data" xml:"data>laureates_per_prize"`
+}
+
+// ShareCountResponse represents the number of laureates with a prize share
+//
+//	@Description	Number of laureates with the share, a laureate received 1/share of the prize
+type ShareCountResponse struct {
+	XMLName        xml.Name `json:"-" xml:"share"`
+	Share          int32    `json:"share" xml:"share"`
+	LaureatesCount int32    `json:"laureates_count" xml:"laureates_count"`
+}
+
+// ShareDistributionResponse represents the distribution of prize shares
+//
+//	@Description	Distribution of prize shares of laureates
+type ShareDistributionResponse struct {
+	XMLName xml.Name             `json:"-" xml:"shares"`
+	Data    []ShareCountResponse `json:"data" xml:"data>share"`
+}
+
+// PrizesPerLaureateResponse represents the number of laureates with a number of prizes
+//
+//	@Description	Number of laureates with the number of prizes
+type PrizesPerLaureateResponse struct {
+	XMLName        xml.Name `json:"-" xml:"prizes_per_laureate"`
+	PrizesCount    int32    `json:"prizes_count" xml:"prizes_count"`
+	LaureatesCount int32    `json:"laureates_count" xml:"laureates_count"`
+}
+
+// MultipleWinnerResponse represents a laureate with several prizes
+//
+//	@Description	Laureate with several prizes
+type MultipleWinnerResponse struct {
+	XMLName     xml.Name `json:"-" xml:"laureate"`
+	ID          int32    `json:"id" xml:"id"`
+	Firstname   string   `json:"firstname" xml:"firstname"`
+	Surname     string   `json:"surname,omitempty" xml:"surname,omitempty"`
+	PrizesCount int32    `json:"prizes_count" xml:"prizes_count"`
+	FirstYear   int32    `json:"first_year" xml:"first_year"`
+	LastYear    int32    `json:"last_year" xml:"last_year"`
+}
+
+// MultipleWinnersResponse represents laureates with several prizes
+//
+//	@Description	Laureates with several prizes and the distribution of prizes per laureate
+type MultipleWinnersResponse struct {
+	XMLName      xml.Name                    `json:"-" xml:"multiple_winners"`
+	Distribution []PrizesPerLaureateResponse `json:"distribution" xml:"distribution>prizes_per_laureate"`
+	Data         []MultipleWinnerResponse    `json:"data" xml:"data>laureate"`
+}
+
+// NotAwardedYearsResponse represents the years a category was not awarded
+//
+//	@Description	Years without an awarded prize of a category
+type NotAwardedYearsResponse struct {
+	XMLName  xml.Name `json:"-" xml:"category"`
+	Category string   `json:"category" xml:"category"`
+	Years    []int32  `json:"years" xml:"years>year"`
+}
+
+// NotAwardedYearsListResponse represents the years categories were not awarded
+//
+//	@Description	Years without an awarded prize per category, from the first prize of the category to the last year of the dataset
+type NotAwardedYearsListResponse struct {
+	XMLName xml.Name                  `json:"-" xml:"not_awarded"`
+	Data    []NotAwardedYearsResponse `json:"data" xml:"data>category"`
 }
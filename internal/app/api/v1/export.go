@@ -0,0 +1,115 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"log/slog"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+	"ris/internal/export"
+	"ris/internal/metrics"
+)
+
+// ExportDataset godoc
+//
+//	@Summary		Export the dataset
+//	@Description	Streams all laureates, prizes or awards (a row per laureate of a prize) matching the filters, read through a database cursor.
+//	@Description	The format is chosen by the format parameter or else by the Accept header, CSV by default.
+//	@Description	Laureates are filtered by name and share, prizes by category and years, awards by all of them.
+//	@Description	A failing export ends the stream early: Parquet and XLSX files are incomplete then, CSV and NDJSON files are cut off.
+//	@Tags			Export
+//	@Produce		text/csv,application/x-ndjson,application/vnd.apache.parquet,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			entity		path		string				true	"Exported entity"	Enums(laureates, prizes, awards)
+//	@Param			format		query		string				false	"File format"		Enums(csv, ndjson, parquet, xlsx)
+//	@Param			category	query		string				false	"Prize category"
+//	@Param			year		query		int					false	"Prize year, overrides year_from and year_to"
+//	@Param			year_from	query		int					false	"First prize year"
+//	@Param			year_to		query		int					false	"Last prize year"
+//	@Param			name		query		string				false	"Part of the first name or surname of laureates, case insensitive"
+//	@Param			share		query		int					false	"Prize share of laureates"
+//	@Success		200			{file}		file				"Exported rows"
+//	@Header			200			{string}	Content-Disposition	"attachment with the file name"
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		401			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/api/v1/export/{entity} [get]
+//	@security		ApiKeyAuth
+func (h *Handler) ExportDataset(c *fiber.Ctx) error {
+	entity, ok := export.LookupEntity(c.Params("entity"))
+	if !ok {
+		return render.Send(c.Status(fiber.StatusNotFound), ErrorResponse{
+			Error:   "Not Found",
+			Message: "Unknown entity, expected laureates, prizes or awards",
+		})
+	}
+	format, ok := exportFormat(c)
+	if !ok {
+		return nil
+	}
+	filter, err := export.ParseFilter(func(name string) string { return c.Query(name) })
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+
+	// The rows are written after the handler returns, when the request context is gone
+	cursor, err := h.exporter.Open(context.WithoutCancel(c.UserContext()), entity, filter)
+	if err != nil {
+		metrics.Exports.WithLabelValues(entity.Name, format.Name, "error").Inc()
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+			Error:   "Internal Server Error",
+			Message: err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, format.ContentType)
+	c.Set(fiber.HeaderContentDisposition, `attachment; filename="`+entity.Name+"."+format.Name+`"`)
+	c.Set(fiber.HeaderCacheControl, "no-store")
+	// Disable response buffering of nginx
+	c.Set("X-Accel-Buffering", "no")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer func() { _ = cursor.Close() }()
+		rows, err := cursor.Copy(w, format)
+		metrics.ExportRows.WithLabelValues(entity.Name).Add(float64(rows))
+		if err != nil {
+			metrics.Exports.WithLabelValues(entity.Name, format.Name, "error").Inc()
+			slog.Error("Export failed", "entity", entity.Name, "format", format.Name, "rows", rows, "error", err)
+			return
+		}
+		metrics.Exports.WithLabelValues(entity.Name, format.Name, "ok").Inc()
+	})
+	return nil
+}
+
+// exportFormat chooses the format of an export, the format parameter takes precedence over Accept.
+// It responds with an error if there is no suitable format.
+func exportFormat(c *fiber.Ctx) (export.Format, bool) {
+	if name := c.Query("format"); name != "" {
+		format, ok := export.LookupFormat(strings.ToLower(name))
+		if !ok {
+			_ = render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+				Error:   "Bad Request",
+				Message: "Unknown format, expected csv, ndjson, parquet or xlsx",
+			})
+		}
+		return format, ok
+	}
+	format, ok := export.FormatByContentType(c.Accepts(export.ContentTypes()...))
+	if !ok {
+		_ = render.Send(c.Status(fiber.StatusNotAcceptable), ErrorResponse{
+			Error:   "Not Acceptable",
+			Message: "Acceptable types are " + strings.Join(export.ContentTypes(), ", "),
+		})
+	}
+	return format, ok
+}
@@ -2,10 +2,17 @@ package v1
 
 import (
 	"context"
+	"errors"
+	"regexp"
 	"strconv"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+	"ris/internal/export"
+	"ris/internal/live"
+	"ris/internal/webhook"
 )
 
 // Service defines the interface for the Nobel Prize API service
@@ -15,7 +22,7 @@ type Service interface {
 	GetLastUpdate(ctx context.Context) (*LastUpdateResponse, error)
 
 	// Laureates
-	ListLaureates(ctx context.Context, page, perPage int) (*LaureateListResponse, error)
+	ListLaureates(ctx context.Context, page, perPage int, filter LaureateFilter) (*LaureateListResponse, error)
 	GetLaureate(ctx context.Context, id int32) (*LaureateResponse, error)
 	CreateLaureate(ctx context.Context, req *CreateLaureateRequest) (*LaureateResponse, error)
 	UpdateLaureate(ctx context.Context, id int32, req *UpdateLaureateRequest) (*LaureateResponse, error)
@@ -29,20 +36,48 @@ type Service interface {
 	CreatePrize(ctx context.Context, req *CreatePrizeRequest) (*PrizeResponse, error)
 	UpdatePrize(ctx context.Context, id int32, req *UpdatePrizeRequest) (*PrizeResponse, error)
 	DeletePrize(ctx context.Context, id int32) error
+	LinkLaureate(ctx context.Context, prizeID, laureateID int32) (*PrizeResponse, error)
+	UnlinkLaureate(ctx context.Context, prizeID, laureateID int32) (*PrizeResponse, error)
+
+	// Categories
 	GetCategories(ctx context.Context) (*CategoriesResponse, error)
+	GetCategory(ctx context.Context, slug string) (*CategoryResponse, error)
+	CreateCategory(ctx context.Context, req *CreateCategoryRequest) (*CategoryResponse, error)
+	UpdateCategory(ctx context.Context, slug string, req *UpdateCategoryRequest) (*CategoryResponse, error)
+	DeleteCategory(ctx context.Context, slug string) error
 }
 
+// categorySlug matches lower-case words of letters and digits joined by dashes
+var categorySlug = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 // Handler handles HTTP requests for the Nobel Prize API
 type Handler struct {
 	service   Service
+	webhooks  WebhookService
+	analytics AnalyticsService
+	streamer  *live.Streamer
+	exporter  *export.Exporter
 	validator *validator.Validate
 }
 
 // NewHandler creates a new Handler instance
-func NewHandler(service Service) *Handler {
+func NewHandler(service Service, webhooks WebhookService, analytics AnalyticsService, streamer *live.Streamer, exporter *export.Exporter) *Handler {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	// Event types a webhook can subscribe to
+	_ = v.RegisterValidation("webhook_event", func(fl validator.FieldLevel) bool {
+		return webhook.ValidEventType(fl.Field().String())
+	})
+	// Slugs of categories, as checked by the categories table
+	_ = v.RegisterValidation("category_slug", func(fl validator.FieldLevel) bool {
+		return categorySlug.MatchString(fl.Field().String())
+	})
 	return &Handler{
 		service:   service,
-		validator: validator.New(validator.WithRequiredStructEnabled()),
+		webhooks:  webhooks,
+		analytics: analytics,
+		streamer:  streamer,
+		exporter:  exporter,
+		validator: v,
 	}
 }
 
@@ -52,23 +87,30 @@ func NewHandler(service Service) *Handler {
 //	@Description	Returns count of laureates, prizes, and categories
 //	@Tags			Stats
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	StatsResponse
-//	@Failure		401	{object}	ErrorResponse
-//	@Failure		500	{object}	ErrorResponse
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	StatsResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/stats [get]
 //	@security		ApiKeyAuth
 func (h *Handler) GetStats(c *fiber.Ctx) error {
-	stats, err := h.service.GetStats(c.Context())
+	stats, err := h.service.GetStats(c.UserContext())
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(stats)
+	return render.Send(c, stats)
 }
 
 // GetLastUpdate godoc
@@ -77,53 +119,88 @@ func (h *Handler) GetStats(c *fiber.Ctx) error {
 //	@Description	Returns the timestamp of the last dataset update
 //	@Tags			Stats
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	LastUpdateResponse
-//	@Failure		401	{object}	ErrorResponse
-//	@Failure		500	{object}	ErrorResponse
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	LastUpdateResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/stats/last-update [get]
 //	@security		ApiKeyAuth
 func (h *Handler) GetLastUpdate(c *fiber.Ctx) error {
-	lastUpdate, err := h.service.GetLastUpdate(c.Context())
+	lastUpdate, err := h.service.GetLastUpdate(c.UserContext())
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(lastUpdate)
+	return render.Send(c, lastUpdate)
 }
 
 // ListLaureates godoc
 //
 //	@Summary		List laureates
-//	@Description	Returns a paginated list of Nobel laureates
+//	@Description	Returns a paginated list of Nobel laureates, optionally filtered by type, gender, birth country and birth years.
+//	@Description	Laureates with an unknown birth date do not match birth years.
 //	@Tags			Laureates
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml,text/csv
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Param			page		query		int	false	"Page number"		default(1)
-//	@Param			per_page	query		int	false	"Items per page"	default(10)	maximum(100)
-//	@Success		200			{object}	LaureateListResponse
-//	@Failure		401			{object}	ErrorResponse
-//	@Failure		500			{object}	ErrorResponse
+//	@Param			page				query		int		false	"Page number"		default(1)
+//	@Param			per_page			query		int		false	"Items per page"	default(10)	maximum(100)
+//	@Param			type				query		string	false	"Laureate type"		Enums(person, organization)
+//	@Param			gender				query		string	false	"Gender"			Enums(male, female, other)
+//	@Param			country				query		string	false	"Code or part of the name of the birth country"
+//	@Param			born_from			query		int		false	"First birth year"
+//	@Param			born_to				query		int		false	"Last birth year"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	LaureateListResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		400					{object}	ErrorResponse
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/laureates [get]
 //	@security		ApiKeyAuth
 func (h *Handler) ListLaureates(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	perPage, _ := strconv.Atoi(c.Query("per_page", "10"))
 
-	result, err := h.service.ListLaureates(c.Context(), page, perPage)
+	var filter LaureateFilter
+	if err := c.QueryParser(&filter); err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid filter",
+		})
+	}
+	if err := h.validator.Struct(filter); err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Validation Error",
+			Message: err.Error(),
+		})
+	}
+
+	result, err := h.service.ListLaureates(c.UserContext(), page, perPage, filter)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(result)
+	return render.Send(c, result)
 }
 
 // GetLaureate godoc
@@ -132,33 +209,40 @@ func (h *Handler) ListLaureates(c *fiber.Ctx) error {
 //	@Description	Returns a single laureate by their ID
 //	@Tags			Laureates
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Param			id	path		int	true	"Laureate ID"
-//	@Success		200	{object}	LaureateResponse
-//	@Failure		401	{object}	ErrorResponse
-//	@Failure		404	{object}	ErrorResponse
-//	@Failure		500	{object}	ErrorResponse
+//	@Param			id					path		int		true	"Laureate ID"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	LaureateResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		404					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/laureates/{id} [get]
 //	@security		ApiKeyAuth
 func (h *Handler) GetLaureate(c *fiber.Ctx) error {
 	id, err := strconv.ParseInt(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid laureate ID",
 		})
 	}
 
-	laureate, err := h.service.GetLaureate(c.Context(), int32(id))
+	laureate, err := h.service.GetLaureate(c.UserContext(), int32(id))
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusNotFound), ErrorResponse{
 			Error:   "Not Found",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(laureate)
+	return render.Send(c, laureate)
 }
 
 // CreateLaureate godoc
@@ -167,30 +251,32 @@ func (h *Handler) GetLaureate(c *fiber.Ctx) error {
 //	@Description	Creates a new Nobel laureate
 //	@Tags			Laureates
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
 //	@Param			laureate	body		CreateLaureateRequest	true	"Laureate data"
 //	@Success		201			{object}	LaureateResponse
 //	@Failure		400			{object}	ErrorResponse
 //	@Failure		401			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
 //	@Failure		500			{object}	ErrorResponse
 //	@Router			/api/v1/laureates [post]
 //	@security		ApiKeyAuth
 func (h *Handler) CreateLaureate(c *fiber.Ctx) error {
-	req, err := parseBody[CreateLaureateRequest](h, c)
-	if err != nil {
-		return err
+	req, ok := parseBody[CreateLaureateRequest](h, c)
+	if !ok {
+		return nil
 	}
 
-	laureate, err := h.service.CreateLaureate(c.Context(), &req)
+	laureate, err := h.service.CreateLaureate(c.UserContext(), &req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.Status(fiber.StatusCreated).JSON(laureate)
+	return render.Send(c.Status(fiber.StatusCreated), laureate)
 }
 
 // UpdateLaureate godoc
@@ -199,7 +285,7 @@ func (h *Handler) CreateLaureate(c *fiber.Ctx) error {
 //	@Description	Updates an existing Nobel laureate
 //	@Tags			Laureates
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
 //	@Param			id			path		int						true	"Laureate ID"
@@ -207,6 +293,8 @@ func (h *Handler) CreateLaureate(c *fiber.Ctx) error {
 //	@Success		200			{object}	LaureateResponse
 //	@Failure		400			{object}	ErrorResponse
 //	@Failure		401			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
 //	@Failure		404			{object}	ErrorResponse
 //	@Failure		500			{object}	ErrorResponse
 //	@Router			/api/v1/laureates/{id} [put]
@@ -214,7 +302,7 @@ func (h *Handler) CreateLaureate(c *fiber.Ctx) error {
 func (h *Handler) UpdateLaureate(c *fiber.Ctx) error {
 	id, err := strconv.ParseInt(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid laureate ID",
 		})
@@ -222,27 +310,33 @@ func (h *Handler) UpdateLaureate(c *fiber.Ctx) error {
 
 	var req UpdateLaureateRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid request body",
 		})
 	}
 
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Validation Error",
 			Message: err.Error(),
 		})
 	}
 
-	laureate, err := h.service.UpdateLaureate(c.Context(), int32(id), &req)
+	laureate, err := h.service.UpdateLaureate(c.UserContext(), int32(id), &req)
+	if errors.Is(err, ErrOrganizationGender) {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Validation Error",
+			Message: err.Error(),
+		})
+	}
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(laureate)
+	return render.Send(c, laureate)
 }
 
 // DeleteLaureate godoc
@@ -251,33 +345,35 @@ func (h *Handler) UpdateLaureate(c *fiber.Ctx) error {
 //	@Description	Deletes an existing Nobel laureate
 //	@Tags			Laureates
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
 //	@Param			id	path		int	true	"Laureate ID"
 //	@Success		200	{object}	SuccessResponse
 //	@Failure		400	{object}	ErrorResponse
 //	@Failure		401	{object}	ErrorResponse
+//	@Failure		406	{object}	ErrorResponse
+//	@Failure		429	{object}	ErrorResponse
 //	@Failure		500	{object}	ErrorResponse
 //	@Router			/api/v1/laureates/{id} [delete]
 //	@security		ApiKeyAuth
 func (h *Handler) DeleteLaureate(c *fiber.Ctx) error {
 	id, err := strconv.ParseInt(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid laureate ID",
 		})
 	}
 
-	err = h.service.DeleteLaureate(c.Context(), int32(id))
+	err = h.service.DeleteLaureate(c.UserContext(), int32(id))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(SuccessResponse{Message: "Laureate deleted successfully"})
+	return render.Send(c, SuccessResponse{Message: "Laureate deleted successfully"})
 }
 
 // ListPrizes godoc
@@ -286,28 +382,35 @@ func (h *Handler) DeleteLaureate(c *fiber.Ctx) error {
 //	@Description	Returns a paginated list of Nobel prizes
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml,text/csv
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Param			page		query		int	false	"Page number"		default(1)
-//	@Param			per_page	query		int	false	"Items per page"	default(10)	maximum(100)
-//	@Success		200			{object}	PrizeListResponse
-//	@Failure		401			{object}	ErrorResponse
-//	@Failure		500			{object}	ErrorResponse
+//	@Param			page				query		int		false	"Page number"		default(1)
+//	@Param			per_page			query		int		false	"Items per page"	default(10)	maximum(100)
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	PrizeListResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/prizes [get]
 //	@security		ApiKeyAuth
 func (h *Handler) ListPrizes(c *fiber.Ctx) error {
 	page, _ := strconv.Atoi(c.Query("page", "1"))
 	perPage, _ := strconv.Atoi(c.Query("per_page", "10"))
 
-	result, err := h.service.ListPrizes(c.Context(), page, perPage)
+	result, err := h.service.ListPrizes(c.UserContext(), page, perPage)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(result)
+	return render.Send(c, result)
 }
 
 // GetPrize godoc
@@ -316,67 +419,79 @@ func (h *Handler) ListPrizes(c *fiber.Ctx) error {
 //	@Description	Returns a single prize by its ID with associated laureates
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Param			id	path		int	true	"Prize ID"
-//	@Success		200	{object}	PrizeResponse
-//	@Failure		401	{object}	ErrorResponse
-//	@Failure		404	{object}	ErrorResponse
-//	@Failure		500	{object}	ErrorResponse
+//	@Param			id					path		int		true	"Prize ID"
+//	@Param			If-None-Match		header		string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header		string	false	"Last-Modified of a previously received response"
+//	@Success		200					{object}	PrizeResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		404					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/prizes/{id} [get]
 //	@security		ApiKeyAuth
 func (h *Handler) GetPrize(c *fiber.Ctx) error {
 	id, err := strconv.ParseInt(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid prize ID",
 		})
 	}
 
-	prize, err := h.service.GetPrize(c.Context(), int32(id))
+	prize, err := h.service.GetPrize(c.UserContext(), int32(id))
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusNotFound), ErrorResponse{
 			Error:   "Not Found",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(prize)
+	return render.Send(c, prize)
 }
 
 // GetPrizesByCategory godoc
 //
 //	@Summary		Get prizes by category
-//	@Description	Returns all prizes for a specific category with their laureates
+//	@Description	Returns all prizes for a specific category with their laureates, the category is matched case-insensitively
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml,text/csv
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Param			category	path		string	true	"Prize category (e.g., physics, chemistry, medicine, literature, peace, economics)"
-//	@Success		200			{array}		PrizeResponse
-//	@Failure		401			{object}	ErrorResponse
-//	@Failure		500			{object}	ErrorResponse
+//	@Param			category			path	string	true	"Prize category (e.g., physics, chemistry, medicine, literature, peace, economics)"
+//	@Param			If-None-Match		header	string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header	string	false	"Last-Modified of a previously received response"
+//	@Success		200					{array}	PrizeResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		404					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/prizes/category/{category} [get]
 //	@security		ApiKeyAuth
 func (h *Handler) GetPrizesByCategory(c *fiber.Ctx) error {
 	category := c.Params("category")
 	if category == "" {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Category is required",
 		})
 	}
 
-	prizes, err := h.service.GetPrizesByCategory(c.Context(), category)
+	prizes, err := h.service.GetPrizesByCategory(c.UserContext(), category)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: err.Error(),
-		})
+		return categoryError(c, err)
 	}
-	return c.JSON(prizes)
+	return render.Send(c, PrizesResponse(prizes))
 }
 
 // GetPrizesByYear godoc
@@ -385,32 +500,39 @@ func (h *Handler) GetPrizesByCategory(c *fiber.Ctx) error {
 //	@Description	Returns all prizes for a specific year
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml,text/csv
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Param			year	path		int	true	"Prize year"
-//	@Success		200		{array}		PrizeResponse
-//	@Failure		401		{object}	ErrorResponse
-//	@Failure		500		{object}	ErrorResponse
+//	@Param			year				path	int		true	"Prize year"
+//	@Param			If-None-Match		header	string	false	"ETag of a previously received response"
+//	@Param			If-Modified-Since	header	string	false	"Last-Modified of a previously received response"
+//	@Success		200					{array}	PrizeResponse
+//	@Success		304					"Not Modified"
+//	@Header			200					{string}	ETag			"Entity tag of the response"
+//	@Header			200					{string}	Last-Modified	"Last dataset update time"
+//	@Failure		401					{object}	ErrorResponse
+//	@Failure		406					{object}	ErrorResponse
+//	@Failure		429					{object}	ErrorResponse
+//	@Failure		500					{object}	ErrorResponse
 //	@Router			/api/v1/prizes/year/{year} [get]
 //	@security		ApiKeyAuth
 func (h *Handler) GetPrizesByYear(c *fiber.Ctx) error {
 	year, err := strconv.ParseInt(c.Params("year"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid year",
 		})
 	}
 
-	prizes, err := h.service.GetPrizesByYear(c.Context(), int32(year))
+	prizes, err := h.service.GetPrizesByYear(c.UserContext(), int32(year))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(prizes)
+	return render.Send(c, PrizesResponse(prizes))
 }
 
 // CreatePrize godoc
@@ -419,49 +541,51 @@ func (h *Handler) GetPrizesByYear(c *fiber.Ctx) error {
 //	@Description	Creates a new Nobel prize
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
 //	@Param			prize	body		CreatePrizeRequest	true	"Prize data"
 //	@Success		201		{object}	PrizeResponse
 //	@Failure		400		{object}	ErrorResponse
 //	@Failure		401		{object}	ErrorResponse
+//	@Failure		406		{object}	ErrorResponse
+//	@Failure		429		{object}	ErrorResponse
 //	@Failure		500		{object}	ErrorResponse
 //	@Router			/api/v1/prizes [post]
 //	@security		ApiKeyAuth
 func (h *Handler) CreatePrize(c *fiber.Ctx) error {
-	req, err := parseBody[CreatePrizeRequest](h, c)
-	if err != nil {
-		return err
+	req, ok := parseBody[CreatePrizeRequest](h, c)
+	if !ok {
+		return nil
 	}
 
-	prize, err := h.service.CreatePrize(c.Context(), &req)
+	prize, err := h.service.CreatePrize(c.UserContext(), &req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: err.Error(),
-		})
+		return categoryError(c, err)
 	}
-	return c.Status(fiber.StatusCreated).JSON(prize)
+	return render.Send(c.Status(fiber.StatusCreated), prize)
 }
 
-func parseBody[T any](h *Handler, c *fiber.Ctx) (T, error) {
-	var req T
+// parseBody parses and validates the request body.
+// When it is invalid, the error response is sent and ok is false.
+func parseBody[T any](h *Handler, c *fiber.Ctx) (req T, ok bool) {
 	if err := c.BodyParser(&req); err != nil {
-		return req, c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		_ = render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid request body",
 		})
+		return req, false
 	}
 
 	if err := h.validator.Struct(req); err != nil {
-		return req, c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		_ = render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Validation Error",
 			Message: err.Error(),
 		})
+		return req, false
 	}
 
-	return req, nil
+	return req, true
 }
 
 // UpdatePrize godoc
@@ -470,7 +594,7 @@ func parseBody[T any](h *Handler, c *fiber.Ctx) (T, error) {
 //	@Description	Updates an existing Nobel prize
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
 //	@Param			id		path		int					true	"Prize ID"
@@ -478,6 +602,8 @@ func parseBody[T any](h *Handler, c *fiber.Ctx) (T, error) {
 //	@Success		200		{object}	PrizeResponse
 //	@Failure		400		{object}	ErrorResponse
 //	@Failure		401		{object}	ErrorResponse
+//	@Failure		406		{object}	ErrorResponse
+//	@Failure		429		{object}	ErrorResponse
 //	@Failure		404		{object}	ErrorResponse
 //	@Failure		500		{object}	ErrorResponse
 //	@Router			/api/v1/prizes/{id} [put]
@@ -485,7 +611,7 @@ func parseBody[T any](h *Handler, c *fiber.Ctx) (T, error) {
 func (h *Handler) UpdatePrize(c *fiber.Ctx) error {
 	id, err := strconv.ParseInt(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid prize ID",
 		})
@@ -493,27 +619,24 @@ func (h *Handler) UpdatePrize(c *fiber.Ctx) error {
 
 	var req UpdatePrizeRequest
 	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid request body",
 		})
 	}
 
 	if err := h.validator.Struct(req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Validation Error",
 			Message: err.Error(),
 		})
 	}
 
-	prize, err := h.service.UpdatePrize(c.Context(), int32(id), &req)
+	prize, err := h.service.UpdatePrize(c.UserContext(), int32(id), &req)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
-			Error:   "Internal Server Error",
-			Message: err.Error(),
-		})
+		return categoryError(c, err)
 	}
-	return c.JSON(prize)
+	return render.Send(c, prize)
 }
 
 // DeletePrize godoc
@@ -522,56 +645,122 @@ func (h *Handler) UpdatePrize(c *fiber.Ctx) error {
 //	@Description	Deletes an existing Nobel prize
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
 //	@Param			id	path		int	true	"Prize ID"
 //	@Success		200	{object}	SuccessResponse
 //	@Failure		400	{object}	ErrorResponse
 //	@Failure		401	{object}	ErrorResponse
+//	@Failure		406	{object}	ErrorResponse
+//	@Failure		429	{object}	ErrorResponse
 //	@Failure		500	{object}	ErrorResponse
 //	@Router			/api/v1/prizes/{id} [delete]
 //	@security		ApiKeyAuth
 func (h *Handler) DeletePrize(c *fiber.Ctx) error {
 	id, err := strconv.ParseInt(c.Params("id"), 10, 32)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
 			Error:   "Bad Request",
 			Message: "Invalid prize ID",
 		})
 	}
 
-	err = h.service.DeletePrize(c.Context(), int32(id))
+	err = h.service.DeletePrize(c.UserContext(), int32(id))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(SuccessResponse{Message: "Prize deleted successfully"})
+	return render.Send(c, SuccessResponse{Message: "Prize deleted successfully"})
 }
 
-// GetCategories godoc
+// LinkLaureate godoc
 //
-//	@Summary		Get all categories
-//	@Description	Returns a list of all unique prize categories
+//	@Summary		Link a laureate to a prize
+//	@Description	Adds an existing laureate to an existing prize. Linking an already linked laureate does nothing.
 //	@Tags			Prizes
 //	@Accept			json
-//	@Produce		json
+//	@Produce		json,application/xml,application/yaml
 //	@Security		BearerAuth
 //	@Security		ApiKeyAuth
-//	@Success		200	{object}	CategoriesResponse
-//	@Failure		401	{object}	ErrorResponse
-//	@Failure		500	{object}	ErrorResponse
-//	@Router			/api/v1/categories [get]
+//	@Param			id			path		int	true	"Prize ID"
+//	@Param			laureateId	path		int	true	"Laureate ID"
+//	@Success		200			{object}	PrizeResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		401			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/api/v1/prizes/{id}/laureates/{laureateId} [put]
 //	@security		ApiKeyAuth
-func (h *Handler) GetCategories(c *fiber.Ctx) error {
-	categories, err := h.service.GetCategories(c.Context())
+func (h *Handler) LinkLaureate(c *fiber.Ctx) error {
+	prizeID, laureateID, err := linkParams(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(ErrorResponse{
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+
+	prize, err := h.service.LinkLaureate(c.UserContext(), prizeID, laureateID)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
 			Error:   "Internal Server Error",
 			Message: err.Error(),
 		})
 	}
-	return c.JSON(categories)
+	return render.Send(c, prize)
+}
+
+// UnlinkLaureate godoc
+//
+//	@Summary		Unlink a laureate from a prize
+//	@Description	Removes a laureate from a prize. Unlinking a not linked laureate does nothing.
+//	@Tags			Prizes
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		int	true	"Prize ID"
+//	@Param			laureateId	path		int	true	"Laureate ID"
+//	@Success		200			{object}	PrizeResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		401			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/api/v1/prizes/{id}/laureates/{laureateId} [delete]
+//	@security		ApiKeyAuth
+func (h *Handler) UnlinkLaureate(c *fiber.Ctx) error {
+	prizeID, laureateID, err := linkParams(c)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+
+	prize, err := h.service.UnlinkLaureate(c.UserContext(), prizeID, laureateID)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+			Error:   "Internal Server Error",
+			Message: err.Error(),
+		})
+	}
+	return render.Send(c, prize)
+}
+
+// linkParams parses prize and laureate IDs of link routes
+func linkParams(c *fiber.Ctx) (int32, int32, error) {
+	prizeID, err := strconv.ParseInt(c.Params("id"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.New("Invalid prize ID")
+	}
+	laureateID, err := strconv.ParseInt(c.Params("laureateId"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.New("Invalid laureate ID")
+	}
+	return int32(prizeID), int32(laureateID), nil
 }
@@ -0,0 +1,195 @@
+package v1
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+	"ris/internal/live"
+	"ris/internal/metrics"
+)
+
+// LiveEventMessage is a WebSocket message with an event
+//
+//	@Description	Event sent over WebSocket, data is the event envelope
+type LiveEventMessage struct {
+	ID    uint64 `json:"id"`
+	Event string `json:"event"`
+	Data  any    `json:"data" swaggertype:"object"`
+}
+
+// StreamEvents godoc
+//
+//	@Summary		Stream live events (SSE)
+//	@Description	Sends prize and laureate events as Server-Sent Events: "id" is the stream sequence, "event" is the event type and "data" is the event envelope.
+//	@Description	Heartbeat comments are sent to idle streams. Reconnecting EventSource clients resume after the Last-Event-ID header, other clients can pass last_event_id.
+//	@Tags			Events
+//	@Produce		text/event-stream
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			types			query		string	false	"Comma separated event types or prize.*, laureate.*, category.*"
+//	@Param			categories		query		string	false	"Comma separated prize categories, only prize events with these categories are sent"
+//	@Param			last_event_id	query		int		false	"Stream sequence of the last received event"
+//	@Param			Last-Event-ID	header		int		false	"Stream sequence of the last received event"
+//	@Success		200				{string}	string	"Event stream"
+//	@Failure		400				{object}	ErrorResponse
+//	@Failure		401				{object}	ErrorResponse
+//	@Failure		429				{object}	ErrorResponse
+//	@Failure		503				{object}	ErrorResponse
+//	@Router			/api/v1/events/stream [get]
+//	@security		ApiKeyAuth
+func (h *Handler) StreamEvents(c *fiber.Ctx) error {
+	filter, after, err := liveParams(c)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+	stream, err := h.streamer.Open(after, filter)
+	if err != nil {
+		return liveError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	// Disable response buffering of nginx
+	c.Set("X-Accel-Buffering", "no")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		metrics.LiveConnections.WithLabelValues("sse").Inc()
+		defer metrics.LiveConnections.WithLabelValues("sse").Dec()
+
+		// Sends the headers right away, a failed write means the client is gone
+		_, _ = w.WriteString(": connected\n\n")
+		if w.Flush() != nil {
+			stream.Close()
+			return
+		}
+		_ = stream.Run(context.Background(), func(e live.Event) error {
+			_, _ = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", e.Sequence, e.Type, e.Data)
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			metrics.LiveEventsSent.WithLabelValues("sse").Inc()
+			return nil
+		}, func() error {
+			_, _ = w.WriteString(": heartbeat\n\n")
+			return w.Flush()
+		})
+	})
+	return nil
+}
+
+// UpgradeEventsWebSocket godoc
+//
+//	@Summary		Stream live events (WebSocket)
+//	@Description	Upgrades to WebSocket and sends prize and laureate events as LiveEventMessage JSON messages, id is the stream sequence.
+//	@Description	Ping frames are sent to idle connections. Reconnecting clients resume by passing the id of the last received event as last_event_id.
+//	@Tags			Events
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			types			query		string	false	"Comma separated event types or prize.*, laureate.*, category.*"
+//	@Param			categories		query		string	false	"Comma separated prize categories, only prize events with these categories are sent"
+//	@Param			last_event_id	query		int		false	"Stream sequence of the last received event"
+//	@Success		101				{object}	LiveEventMessage
+//	@Failure		400				{object}	ErrorResponse
+//	@Failure		401				{object}	ErrorResponse
+//	@Failure		426				{object}	ErrorResponse
+//	@Failure		429				{object}	ErrorResponse
+//	@Router			/api/v1/events/ws [get]
+//	@security		ApiKeyAuth
+func (h *Handler) UpgradeEventsWebSocket(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		return render.Send(c.Status(fiber.StatusUpgradeRequired), ErrorResponse{
+			Error:   "Upgrade Required",
+			Message: "Use a WebSocket client or /api/v1/events/stream",
+		})
+	}
+	filter, after, err := liveParams(c)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: err.Error(),
+		})
+	}
+	c.Locals("live_filter", filter)
+	c.Locals("live_after", after)
+	return c.Next()
+}
+
+// streamWebSocket sends events to an upgraded connection. The stream is opened after the upgrade,
+// so that a failed upgrade does not hold a connection slot; errors are reported in the close frame.
+func (h *Handler) streamWebSocket(conn *websocket.Conn) {
+	filter, _ := conn.Locals("live_filter").(live.Filter)
+	after, _ := conn.Locals("live_after").(uint64)
+	stream, err := h.streamer.Open(after, filter)
+	if err != nil {
+		_ = conn.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, err.Error()))
+		return
+	}
+	metrics.LiveConnections.WithLabelValues("websocket").Inc()
+	defer metrics.LiveConnections.WithLabelValues("websocket").Dec()
+
+	// Clients are not expected to send messages, reading handles pongs and detects closed connections
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		defer cancel()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	err = stream.Run(ctx, func(e live.Event) error {
+		if err := conn.WriteJSON(LiveEventMessage{ID: e.Sequence, Event: e.Type, Data: e.Data}); err != nil {
+			return err
+		}
+		metrics.LiveEventsSent.WithLabelValues("websocket").Inc()
+		return nil
+	}, func() error {
+		return conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(10*time.Second))
+	})
+	code, reason := websocket.CloseGoingAway, "server is shutting down"
+	if err != nil {
+		code, reason = websocket.CloseInternalServerErr, err.Error()
+	}
+	_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(time.Second))
+}
+
+// liveParams parses the filter and the sequence of the last received event of live stream routes
+func liveParams(c *fiber.Ctx) (live.Filter, uint64, error) {
+	filter, err := live.ParseFilter(c.Query("types"), c.Query("categories"))
+	if err != nil {
+		return live.Filter{}, 0, err
+	}
+	lastID := c.Get("Last-Event-ID", c.Query("last_event_id"))
+	if lastID == "" {
+		return filter, 0, nil
+	}
+	after, err := strconv.ParseUint(lastID, 10, 64)
+	if err != nil {
+		return live.Filter{}, 0, errors.New("Invalid last event ID")
+	}
+	return filter, after, nil
+}
+
+// liveError responds with 503 when a live stream cannot be opened
+func liveError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, live.ErrTooManyConnections) {
+		c.Set(fiber.HeaderRetryAfter, "10")
+	}
+	return render.Send(c.Status(fiber.StatusServiceUnavailable), ErrorResponse{
+		Error:   "Service Unavailable",
+		Message: err.Error(),
+	})
+}
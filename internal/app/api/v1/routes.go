@@ -1,22 +1,59 @@
 package v1
 
 import (
+	"github.com/gofiber/contrib/websocket"
 	"github.com/gofiber/fiber/v2"
 
 	"ris/internal/app/api/middleware"
 )
 
-// RegisterRoutes registers all API v1 routes
-func RegisterRoutes(app *fiber.App, handler *Handler) {
+// RegisterRoutes registers all API v1 routes.
+// Additional middlewares (e.g. rate limiting) are applied before authentication.
+func RegisterRoutes(app *fiber.App, handler *Handler, apiToken string, middlewares ...fiber.Handler) {
 	// API v1 group with authentication
-	api := app.Group("/api/v1", middleware.AuthMiddleware())
+	api := app.Group("/api/v1", append(middlewares, middleware.AuthMiddleware(apiToken))...)
+
+	// Webhooks routes are registered before conditionalGet:
+	// their responses do not depend on the dataset update time
+	webhooks := api.Group("/webhooks")
+	webhooks.Get("/", handler.ListWebhooks)
+	webhooks.Get("/:id", handler.GetWebhook)
+	webhooks.Post("/", handler.CreateWebhook)
+	webhooks.Put("/:id", handler.UpdateWebhook)
+	webhooks.Delete("/:id", handler.DeleteWebhook)
+	webhooks.Get("/:id/deliveries", handler.ListWebhookDeliveries)
+	webhooks.Post("/:id/deliveries/:deliveryId/redeliver", handler.RedeliverWebhookDelivery)
+
+	// Live events never end, they must not be buffered by conditionalGet
+	api.Get("/events/stream", handler.StreamEvents)
+	api.Get("/events/ws", handler.UpgradeEventsWebSocket, websocket.New(handler.streamWebSocket))
+
+	// Exports are streamed, conditionalGet would read them into memory to hash them
+	api.Get("/export/:entity", handler.ExportDataset)
+
+	// ETag / Last-Modified support for GET requests
+	api.Use(handler.conditionalGet)
 
 	// Stats routes
 	api.Get("/stats", handler.GetStats)
 	api.Get("/stats/last-update", handler.GetLastUpdate)
 
-	// Categories route
-	api.Get("/categories", handler.GetCategories)
+	// Analytics routes
+	analytics := api.Group("/analytics")
+	analytics.Get("/prizes", handler.GetPrizeTrends)
+	analytics.Get("/laureates", handler.GetLaureateBreakdown)
+	analytics.Get("/shared-prizes", handler.GetSharedPrizes)
+	analytics.Get("/shares", handler.GetShareDistribution)
+	analytics.Get("/multiple-winners", handler.GetMultipleWinners)
+	analytics.Get("/not-awarded", handler.GetNotAwardedYears)
+
+	// Categories routes
+	categories := api.Group("/categories")
+	categories.Get("/", handler.GetCategories)
+	categories.Get("/:slug", handler.GetCategory)
+	categories.Post("/", handler.CreateCategory)
+	categories.Put("/:slug", handler.UpdateCategory)
+	categories.Delete("/:slug", handler.DeleteCategory)
 
 	// Laureates routes
 	laureates := api.Group("/laureates")
@@ -35,4 +72,6 @@ func RegisterRoutes(app *fiber.App, handler *Handler) {
 	prizes.Post("/", handler.CreatePrize)
 	prizes.Put("/:id", handler.UpdatePrize)
 	prizes.Delete("/:id", handler.DeletePrize)
+	prizes.Put("/:id/laureates/:laureateId", handler.LinkLaureate)
+	prizes.Delete("/:id/laureates/:laureateId", handler.UnlinkLaureate)
 }
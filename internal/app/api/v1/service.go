@@ -2,32 +2,42 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"ris/internal/domain"
+	"ris/internal/events"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 
 	"ris/pkg/postgres/queries"
+	"ris/pkg/utills"
 )
 
-type Publisher interface {
-	PublishPrizeCreated(prize domain.Prize) error
-	PublishLaureateCreated(laureate domain.Laureate) error
+// ErrOrganizationGender is returned when a gender is given to an organization
+var ErrOrganizationGender = errors.New("organizations have no gender")
+
+// Outbox stores events in the transaction of the data change,
+// they are published to NATS after commit
+type Outbox interface {
+	InTx(ctx context.Context, fn func(q *queries.Queries) error) error
+	Enqueue(ctx context.Context, q *queries.Queries, eventType, entityID string, payload any) error
 }
 
 // NobelService implements the Service interface
 type NobelService struct {
 	queries *queries.Queries
 
-	publisher Publisher
+	outbox Outbox
 }
 
 // NewNobelService creates a new NobelService instance
-func NewNobelService(q *queries.Queries, publisher Publisher) *NobelService {
-	return &NobelService{queries: q, publisher: publisher}
+func NewNobelService(q *queries.Queries, outbox Outbox) *NobelService {
+	return &NobelService{queries: q, outbox: outbox}
 }
 
 // GetStats returns statistics about the dataset
@@ -66,8 +76,8 @@ func (s *NobelService) GetLastUpdate(ctx context.Context) (*LastUpdateResponse,
 	return &LastUpdateResponse{LastUpdate: t}, nil
 }
 
-// ListLaureates returns a paginated list of laureates
-func (s *NobelService) ListLaureates(ctx context.Context, page, perPage int) (*LaureateListResponse, error) {
+// ListLaureates returns a paginated list of laureates matching the filter
+func (s *NobelService) ListLaureates(ctx context.Context, page, perPage int, filter LaureateFilter) (*LaureateListResponse, error) {
 	if page < 1 {
 		page = 1
 	}
@@ -80,22 +90,43 @@ func (s *NobelService) ListLaureates(ctx context.Context, page, perPage int) (*L
 
 	offset := (page - 1) * perPage
 
-	laureates, err := s.queries.ListLaureatesPaginated(ctx, queries.ListLaureatesPaginatedParams{
-		Limit:  int32(perPage),
-		Offset: int32(offset),
+	laureates, err := s.queries.ListLaureatesFiltered(ctx, queries.ListLaureatesFilteredParams{
+		Type:       utills.OptionalPgText(filter.Type),
+		Gender:     utills.OptionalPgText(filter.Gender),
+		Country:    utills.OptionalPgText(filter.Country),
+		BornFrom:   pgtype.Int4{Int32: filter.BornFrom, Valid: filter.BornFrom != 0},
+		BornTo:     pgtype.Int4{Int32: filter.BornTo, Valid: filter.BornTo != 0},
+		PageLimit:  int32(perPage),
+		PageOffset: int32(offset),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list laureates: %w", err)
 	}
 
-	total, err := s.queries.CountLaureates(ctx)
+	total, err := s.queries.CountLaureatesFiltered(ctx, queries.CountLaureatesFilteredParams{
+		Type:     utills.OptionalPgText(filter.Type),
+		Gender:   utills.OptionalPgText(filter.Gender),
+		Country:  utills.OptionalPgText(filter.Country),
+		BornFrom: pgtype.Int4{Int32: filter.BornFrom, Valid: filter.BornFrom != 0},
+		BornTo:   pgtype.Int4{Int32: filter.BornTo, Valid: filter.BornTo != 0},
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to count laureates: %w", err)
 	}
 
+	ids := make([]int32, len(laureates))
+	for i, l := range laureates {
+		ids[i] = l.ID
+	}
+	affiliations, err := s.laureateAffiliations(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
 	data := make([]LaureateResponse, len(laureates))
 	for i, l := range laureates {
 		data[i] = laureateToResponse(l)
+		data[i].Affiliations = affiliations[l.ID]
 	}
 
 	totalPages := int(math.Ceil(float64(total) / float64(perPage)))
@@ -115,7 +146,12 @@ func (s *NobelService) GetLaureate(ctx context.Context, id int32) (*LaureateResp
 	if err != nil {
 		return nil, fmt.Errorf("laureate not found: %w", err)
 	}
+	affiliations, err := s.laureateAffiliations(ctx, []int32{id})
+	if err != nil {
+		return nil, err
+	}
 	resp := laureateToResponse(laureate)
+	resp.Affiliations = affiliations[id]
 	return &resp, nil
 }
 
@@ -128,32 +164,41 @@ func (s *NobelService) CreateLaureate(ctx context.Context, req *CreateLaureateRe
 		_ = surname.Scan(req.Surname)
 	}
 
-	laureate, err := s.queries.CreateLaureateSingle(ctx, queries.CreateLaureateSingleParams{
-		ID:         req.ID,
-		Firstname:  req.Firstname,
-		Surname:    surname,
-		Motivation: req.Motivation,
-		Share:      req.Share,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create laureate: %w", err)
-	}
+	var laureate queries.Laureate
+	err := s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		var err error
+		laureate, err = q.CreateLaureateSingle(ctx, queries.CreateLaureateSingleParams{
+			ID:               req.ID,
+			Firstname:        req.Firstname,
+			Surname:          surname,
+			Motivation:       req.Motivation,
+			Share:            req.Share,
+			Type:             laureateType(req.Type),
+			Gender:           utills.OptionalPgText(req.Gender),
+			BirthDate:        utills.PgDate(req.BirthDate),
+			BirthCity:        utills.OptionalPgText(req.BirthCity),
+			BirthCountry:     utills.OptionalPgText(req.BirthCountry),
+			BirthCountryCode: utills.OptionalPgText(req.BirthCountryCode),
+			DeathDate:        utills.PgDate(req.DeathDate),
+			DeathCity:        utills.OptionalPgText(req.DeathCity),
+			DeathCountry:     utills.OptionalPgText(req.DeathCountry),
+			DeathCountryCode: utills.OptionalPgText(req.DeathCountryCode),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to create laureate: %w", err)
+		}
 
-	err = s.publisher.PublishLaureateCreated(domain.Laureate{
-		Id:         laureate.ID,
-		Firstname:  laureate.Firstname,
-		Surname:    laureate.Surname.String,
-		Motivation: laureate.Motivation,
-		Share:      laureate.Share,
+		return s.outbox.Enqueue(ctx, q, events.TypeLaureateCreated, entityID(laureate.ID), laureateToDomain(laureate))
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to publish laureate created event: %w", err)
+		return nil, err
 	}
 	resp := laureateToResponse(laureate)
 	return &resp, nil
 }
 
-// UpdateLaureate updates an existing laureate
+// UpdateLaureate updates an existing laureate.
+// The bio data is replaced when the request has any of it, the type is kept when omitted.
 func (s *NobelService) UpdateLaureate(ctx context.Context, id int32, req *UpdateLaureateRequest) (*LaureateResponse, error) {
 	surname := pgtype.Text{}
 	if req.Surname != "" {
@@ -162,27 +207,89 @@ func (s *NobelService) UpdateLaureate(ctx context.Context, id int32, req *Update
 		_ = surname.Scan(req.Surname)
 	}
 
-	laureate, err := s.queries.UpdateLaureate(ctx, queries.UpdateLaureateParams{
-		ID:         id,
-		Firstname:  req.Firstname,
-		Surname:    surname,
-		Motivation: req.Motivation,
-		Share:      req.Share,
+	var laureate queries.Laureate
+	err := s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		previous, err := q.GetLaureateForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to update laureate: %w", err)
+		}
+
+		// Clients unaware of bio data, like the gRPC and GraphQL APIs, keep it
+		bio := req.LaureateBio
+		if bio == (LaureateBio{}) {
+			bio = laureateBio(previous)
+		}
+		if bio.Type == "" {
+			bio.Type = previous.Type
+		}
+		if bio.Type == domain.LaureateOrganization && bio.Gender != "" {
+			return ErrOrganizationGender
+		}
+		laureate, err = q.UpdateLaureate(ctx, queries.UpdateLaureateParams{
+			ID:               id,
+			Firstname:        req.Firstname,
+			Surname:          surname,
+			Motivation:       req.Motivation,
+			Share:            req.Share,
+			Type:             bio.Type,
+			Gender:           utills.OptionalPgText(bio.Gender),
+			BirthDate:        utills.PgDate(bio.BirthDate),
+			BirthCity:        utills.OptionalPgText(bio.BirthCity),
+			BirthCountry:     utills.OptionalPgText(bio.BirthCountry),
+			BirthCountryCode: utills.OptionalPgText(bio.BirthCountryCode),
+			DeathDate:        utills.PgDate(bio.DeathDate),
+			DeathCity:        utills.OptionalPgText(bio.DeathCity),
+			DeathCountry:     utills.OptionalPgText(bio.DeathCountry),
+			DeathCountryCode: utills.OptionalPgText(bio.DeathCountryCode),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update laureate: %w", err)
+		}
+
+		event := domain.LaureateUpdated{
+			Id:       id,
+			Current:  laureateToDomain(laureate),
+			Previous: laureateToDomain(previous),
+		}
+		event.Changed = changedLaureateFields(event.Previous, event.Current)
+		if len(event.Changed) == 0 {
+			return nil
+		}
+		return s.outbox.Enqueue(ctx, q, events.TypeLaureateUpdated, entityID(id), event)
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update laureate: %w", err)
+		return nil, err
 	}
 	resp := laureateToResponse(laureate)
 	return &resp, nil
 }
 
-// DeleteLaureate deletes a laureate by ID
+// DeleteLaureate deletes a laureate by ID. Deleting a missing laureate is not an error.
 func (s *NobelService) DeleteLaureate(ctx context.Context, id int32) error {
-	err := s.queries.DeleteLaureate(ctx, id)
-	if err != nil {
-		return fmt.Errorf("failed to delete laureate: %w", err)
-	}
-	return nil
+	return s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		previous, err := q.GetLaureateForUpdate(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete laureate: %w", err)
+		}
+
+		prizeIDs, err := q.GetPrizeIdsByLaureate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get laureate prizes: %w", err)
+		}
+
+		if err := q.DeleteLaureate(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete laureate: %w", err)
+		}
+
+		return s.outbox.Enqueue(ctx, q, events.TypeLaureateDeleted, entityID(id), domain.LaureateDeleted{
+			Id:       id,
+			Previous: laureateToDomain(previous),
+			PrizeIds: nonNil(prizeIDs),
+		})
+	})
 }
 
 // ListPrizes returns a paginated list of prizes
@@ -240,18 +347,39 @@ func (s *NobelService) GetPrize(ctx context.Context, id int32) (*PrizeResponse,
 		return nil, fmt.Errorf("failed to get laureates: %w", err)
 	}
 
+	rows, err := s.queries.GetAffiliationsByPrizeIds(ctx, []int32{id})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get affiliations: %w", err)
+	}
+	affiliations := make(map[int32][]AffiliationResponse)
+	for _, r := range rows {
+		affiliations[r.LaureateID] = append(affiliations[r.LaureateID], AffiliationResponse{
+			Name:    r.Name,
+			City:    r.City,
+			Country: r.Country,
+		})
+	}
+
 	resp := prizeToResponse(prize)
 	resp.Laureates = make([]LaureateResponse, len(laureates))
 	for i, l := range laureates {
 		resp.Laureates[i] = laureateToResponse(l)
+		resp.Laureates[i].Affiliations = affiliations[l.ID]
 	}
 
 	return &resp, nil
 }
 
-// GetPrizesByCategory returns prizes filtered by category
+// GetPrizesByCategory returns prizes filtered by category.
+// The category is matched case-insensitively, ErrCategoryNotFound is returned for unknown ones.
 func (s *NobelService) GetPrizesByCategory(ctx context.Context, category string) ([]PrizeResponse, error) {
-	rows, err := s.queries.GetPrizesByCategoryWithLaureates(ctx, category)
+	slug := strings.ToLower(strings.TrimSpace(category))
+	if _, err := s.queries.GetCategory(ctx, slug); errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrCategoryNotFound
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	rows, err := s.queries.GetPrizesByCategoryWithLaureates(ctx, slug)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get prizes by category: %w", err)
 	}
@@ -275,35 +403,50 @@ func (s *NobelService) GetPrizesByYear(ctx context.Context, year int32) ([]Prize
 
 // CreatePrize creates a new prize
 func (s *NobelService) CreatePrize(ctx context.Context, req *CreatePrizeRequest) (*PrizeResponse, error) {
-	prize, err := s.queries.AddPrizeSingle(ctx, queries.AddPrizeSingleParams{
-		Year:     req.Year,
-		Category: req.Category,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to create prize: %w", err)
-	}
-
-	laureates := make([]domain.Laureate, len(req.LaureateIDs))
-	// Link laureates if provided
-	for i, laureateID := range req.LaureateIDs {
-		err = s.queries.LinkLaureateToPrizeSingle(ctx, queries.LinkLaureateToPrizeSingleParams{
-			PrizeID:    prize.ID,
-			LaureateID: laureateID,
+	var prize queries.Prize
+	err := s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		category, err := prizeCategory(ctx, q, req.Category, req.Year)
+		if err != nil {
+			return err
+		}
+		prize, err = q.AddPrizeSingle(ctx, queries.AddPrizeSingleParams{
+			Year:     req.Year,
+			Category: category,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("failed to link laureate %d to prize: %w", laureateID, err)
+			return fmt.Errorf("failed to create prize: %w", err)
 		}
 
-		laureates[i] = domain.Laureate{Id: laureateID}
-	}
+		// Link laureates if provided
+		for _, laureateID := range req.LaureateIDs {
+			err = q.LinkLaureateToPrizeSingle(ctx, queries.LinkLaureateToPrizeSingleParams{
+				PrizeID:    prize.ID,
+				LaureateID: laureateID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to link laureate %d to prize: %w", laureateID, err)
+			}
+		}
 
-	err = s.publisher.PublishPrizeCreated(domain.Prize{
-		Year:      strconv.Itoa(int(prize.Year)),
-		Category:  prize.Category,
-		Laureates: laureates,
+		// The event carries full laureates, so consumers don't need to look them up
+		linked, err := q.GetLaureatesByPrizeId(ctx, prize.ID)
+		if err != nil {
+			return fmt.Errorf("failed to get laureates: %w", err)
+		}
+		laureates := make([]domain.Laureate, len(linked))
+		for i, l := range linked {
+			laureates[i] = laureateToDomain(l)
+		}
+
+		return s.outbox.Enqueue(ctx, q, events.TypePrizeCreated, entityID(prize.ID), domain.Prize{
+			Id:        prize.ID,
+			Year:      strconv.Itoa(int(prize.Year)),
+			Category:  prize.Category,
+			Laureates: laureates,
+		})
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to publish prize created event: %w", err)
+		return nil, err
 	}
 
 	return s.GetPrize(ctx, prize.ID)
@@ -311,47 +454,179 @@ func (s *NobelService) CreatePrize(ctx context.Context, req *CreatePrizeRequest)
 
 // UpdatePrize updates an existing prize
 func (s *NobelService) UpdatePrize(ctx context.Context, id int32, req *UpdatePrizeRequest) (*PrizeResponse, error) {
-	prize, err := s.queries.UpdatePrize(ctx, queries.UpdatePrizeParams{
-		ID:       id,
-		Year:     req.Year,
-		Category: req.Category,
+	var prize queries.Prize
+	err := s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		previous, err := q.GetPrizeForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to update prize: %w", err)
+		}
+		category, err := prizeCategory(ctx, q, req.Category, req.Year)
+		if err != nil {
+			return err
+		}
+
+		prize, err = q.UpdatePrize(ctx, queries.UpdatePrizeParams{
+			ID:       id,
+			Year:     req.Year,
+			Category: category,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to update prize: %w", err)
+		}
+
+		event := domain.PrizeUpdated{
+			Id:       id,
+			Current:  prizeFields(prize),
+			Previous: prizeFields(previous),
+		}
+		event.Changed = changedPrizeFields(event.Previous, event.Current)
+		if len(event.Changed) == 0 {
+			return nil
+		}
+		return s.outbox.Enqueue(ctx, q, events.TypePrizeUpdated, entityID(id), event)
 	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to update prize: %w", err)
+		return nil, err
 	}
 	resp := prizeToResponse(prize)
 	return &resp, nil
 }
 
-// DeletePrize deletes a prize by ID
+// DeletePrize deletes a prize by ID. Deleting a missing prize is not an error.
 func (s *NobelService) DeletePrize(ctx context.Context, id int32) error {
-	err := s.queries.DeletePrize(ctx, id)
+	return s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		previous, err := q.GetPrizeForUpdate(ctx, id)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete prize: %w", err)
+		}
+
+		laureateIDs, err := q.GetLaureateIdsByPrize(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get prize laureates: %w", err)
+		}
+
+		if err := q.DeletePrize(ctx, id); err != nil {
+			return fmt.Errorf("failed to delete prize: %w", err)
+		}
+
+		return s.outbox.Enqueue(ctx, q, events.TypePrizeDeleted, entityID(id), domain.PrizeDeleted{
+			Id:          id,
+			Previous:    prizeFields(previous),
+			LaureateIds: nonNil(laureateIDs),
+		})
+	})
+}
+
+// LinkLaureate adds a laureate to a prize. Linking an already linked laureate is not an error.
+func (s *NobelService) LinkLaureate(ctx context.Context, prizeID, laureateID int32) (*PrizeResponse, error) {
+	err := s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		linked, err := q.LinkLaureateToPrizeIfMissing(ctx, queries.LinkLaureateToPrizeIfMissingParams{
+			PrizeID:    prizeID,
+			LaureateID: laureateID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to link laureate %d to prize: %w", laureateID, err)
+		}
+		if linked == 0 {
+			return nil
+		}
+		return s.outbox.Enqueue(ctx, q, events.TypePrizeLaureateLinked, entityID(prizeID), domain.PrizeLaureateLink{
+			PrizeId:    prizeID,
+			LaureateId: laureateID,
+		})
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete prize: %w", err)
+		return nil, err
 	}
-	return nil
+	return s.GetPrize(ctx, prizeID)
 }
 
-// GetCategories returns all unique prize categories
-func (s *NobelService) GetCategories(ctx context.Context) (*CategoriesResponse, error) {
-	categories, err := s.queries.GetCategories(ctx)
+// UnlinkLaureate removes a laureate from a prize. Unlinking a not linked laureate is not an error.
+func (s *NobelService) UnlinkLaureate(ctx context.Context, prizeID, laureateID int32) (*PrizeResponse, error) {
+	err := s.outbox.InTx(ctx, func(q *queries.Queries) error {
+		unlinked, err := q.UnlinkLaureateFromPrize(ctx, queries.UnlinkLaureateFromPrizeParams{
+			PrizeID:    prizeID,
+			LaureateID: laureateID,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to unlink laureate %d from prize: %w", laureateID, err)
+		}
+		if unlinked == 0 {
+			return nil
+		}
+		return s.outbox.Enqueue(ctx, q, events.TypePrizeLaureateUnlinked, entityID(prizeID), domain.PrizeLaureateLink{
+			PrizeId:    prizeID,
+			LaureateId: laureateID,
+		})
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to get categories: %w", err)
+		return nil, err
 	}
-	if categories == nil {
-		categories = []string{}
-	}
-	return &CategoriesResponse{Categories: categories}, nil
+	return s.GetPrize(ctx, prizeID)
 }
 
 // Helper functions
 
+// laureateAffiliations returns the affiliations of laureates for all their prizes by laureate id
+func (s *NobelService) laureateAffiliations(ctx context.Context, ids []int32) (map[int32][]AffiliationResponse, error) {
+	rows, err := s.queries.GetAffiliationsByLaureateIds(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get affiliations: %w", err)
+	}
+	affiliations := make(map[int32][]AffiliationResponse)
+	for _, r := range rows {
+		affiliations[r.LaureateID] = append(affiliations[r.LaureateID], AffiliationResponse{
+			PrizeID: r.PrizeID,
+			Name:    r.Name,
+			City:    r.City,
+			Country: r.Country,
+		})
+	}
+	return affiliations, nil
+}
+
+// laureateType returns the type of a laureate request, persons by default
+func laureateType(t string) string {
+	if t == "" {
+		return domain.LaureatePerson
+	}
+	return t
+}
+
+func laureateBio(l queries.Laureate) LaureateBio {
+	return LaureateBio{
+		Type:             l.Type,
+		Gender:           l.Gender.String,
+		BirthDate:        utills.FormatPgDate(l.BirthDate),
+		BirthCity:        l.BirthCity.String,
+		BirthCountry:     l.BirthCountry.String,
+		BirthCountryCode: l.BirthCountryCode.String,
+		DeathDate:        utills.FormatPgDate(l.DeathDate),
+		DeathCity:        l.DeathCity.String,
+		DeathCountry:     l.DeathCountry.String,
+		DeathCountryCode: l.DeathCountryCode.String,
+	}
+}
+
 func laureateToResponse(l queries.Laureate) LaureateResponse {
 	resp := LaureateResponse{
-		ID:         l.ID,
-		Firstname:  l.Firstname,
-		Motivation: l.Motivation,
-		Share:      l.Share,
+		ID:               l.ID,
+		Firstname:        l.Firstname,
+		Motivation:       l.Motivation,
+		Share:            l.Share,
+		Type:             l.Type,
+		Gender:           l.Gender.String,
+		BirthDate:        utills.FormatPgDate(l.BirthDate),
+		BirthCity:        l.BirthCity.String,
+		BirthCountry:     l.BirthCountry.String,
+		BirthCountryCode: l.BirthCountryCode.String,
+		DeathDate:        utills.FormatPgDate(l.DeathDate),
+		DeathCity:        l.DeathCity.String,
+		DeathCountry:     l.DeathCountry.String,
+		DeathCountryCode: l.DeathCountryCode.String,
 	}
 	if l.Surname.Valid {
 		resp.Surname = l.Surname.String
@@ -363,6 +638,90 @@ func laureateToResponse(l queries.Laureate) LaureateResponse {
 	return resp
 }
 
+func laureateToDomain(l queries.Laureate) domain.Laureate {
+	return domain.Laureate{
+		Id:               l.ID,
+		Firstname:        l.Firstname,
+		Surname:          l.Surname.String,
+		Motivation:       l.Motivation,
+		Share:            l.Share,
+		Type:             l.Type,
+		Gender:           l.Gender.String,
+		BirthDate:        utills.FormatPgDate(l.BirthDate),
+		BirthCity:        l.BirthCity.String,
+		BirthCountry:     l.BirthCountry.String,
+		BirthCountryCode: l.BirthCountryCode.String,
+		DeathDate:        utills.FormatPgDate(l.DeathDate),
+		DeathCity:        l.DeathCity.String,
+		DeathCountry:     l.DeathCountry.String,
+		DeathCountryCode: l.DeathCountryCode.String,
+	}
+}
+
+func prizeFields(p queries.Prize) domain.PrizeFields {
+	return domain.PrizeFields{Year: p.Year, Category: p.Category}
+}
+
+// changedLaureateFields returns JSON names of the fields which differ
+func changedLaureateFields(prev, cur domain.Laureate) []string {
+	var changed []string
+	if prev.Firstname != cur.Firstname {
+		changed = append(changed, "firstname")
+	}
+	if prev.Surname != cur.Surname {
+		changed = append(changed, "surname")
+	}
+	if prev.Motivation != cur.Motivation {
+		changed = append(changed, "motivation")
+	}
+	if prev.Share != cur.Share {
+		changed = append(changed, "share")
+	}
+	bio := []struct{ name, prev, cur string }{
+		{"type", prev.Type, cur.Type},
+		{"gender", prev.Gender, cur.Gender},
+		{"birth_date", prev.BirthDate, cur.BirthDate},
+		{"birth_city", prev.BirthCity, cur.BirthCity},
+		{"birth_country", prev.BirthCountry, cur.BirthCountry},
+		{"birth_country_code", prev.BirthCountryCode, cur.BirthCountryCode},
+		{"death_date", prev.DeathDate, cur.DeathDate},
+		{"death_city", prev.DeathCity, cur.DeathCity},
+		{"death_country", prev.DeathCountry, cur.DeathCountry},
+		{"death_country_code", prev.DeathCountryCode, cur.DeathCountryCode},
+	}
+	for _, f := range bio {
+		if f.prev != f.cur {
+			changed = append(changed, f.name)
+		}
+	}
+	return changed
+}
+
+// changedPrizeFields returns JSON names of the fields which differ
+func changedPrizeFields(prev, cur domain.PrizeFields) []string {
+	var changed []string
+	if prev.Year != cur.Year {
+		changed = append(changed, "year")
+	}
+	if prev.Category != cur.Category {
+		changed = append(changed, "category")
+	}
+	return changed
+}
+
+// entityID formats the id of a prize or laureate as the entity id of its events
+func entityID(id int32) string {
+	return strconv.Itoa(int(id))
+}
+
+// nonNil makes empty lists encode as [] rather than null
+func nonNil(ids []int32) []int32 {
+	if ids == nil {
+		return []int32{}
+	}
+	return ids
+}
+
 func prizeToResponse(p queries.Prize) PrizeResponse {
 	resp := PrizeResponse{
 		ID:       p.ID,
@@ -0,0 +1,263 @@
+package v1
+
+import (
+	"encoding/xml"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// List responses are tables, they can be sent as CSV. Nested lists are joined with ";".
+
+// PrizesResponse is a list of prizes without pagination
+//
+//	@Description	List of prizes
+type PrizesResponse []PrizeResponse
+
+// MarshalXML wraps the prizes into a prizes element
+func (p PrizesResponse) MarshalXML(e *xml.Encoder, _ xml.StartElement) error {
+	list := struct {
+		XMLName xml.Name        `xml:"prizes"`
+		Prizes  []PrizeResponse `xml:"prize"`
+	}{Prizes: p}
+	return e.Encode(list)
+}
+
+func (p PrizesResponse) CSVHeader() []string { return prizeCSVHeader }
+
+func (p PrizesResponse) CSVRows() [][]string {
+	rows := make([][]string, len(p))
+	for i, prize := range p {
+		rows[i] = prize.csvRow()
+	}
+	return rows
+}
+
+var prizeCSVHeader = []string{"id", "year", "category", "laureate_ids", "updated_at"}
+
+func (p PrizeResponse) csvRow() []string {
+	ids := make([]string, len(p.Laureates))
+	for i, l := range p.Laureates {
+		ids[i] = strconv.Itoa(int(l.ID))
+	}
+	return []string{
+		strconv.Itoa(int(p.ID)),
+		strconv.Itoa(int(p.Year)),
+		p.Category,
+		strings.Join(ids, ";"),
+		optional(p.UpdatedAt),
+	}
+}
+
+func (l PrizeListResponse) CSVHeader() []string { return prizeCSVHeader }
+func (l PrizeListResponse) CSVRows() [][]string { return PrizesResponse(l.Data).CSVRows() }
+
+func (l PrizeListResponse) Pagination() (int64, int, int, int) {
+	return l.Total, l.Page, l.PerPage, l.TotalPages
+}
+
+func (l LaureateListResponse) CSVHeader() []string {
+	return []string{
+		"id", "firstname", "surname", "motivation", "share", "type", "gender",
+		"birth_date", "birth_city", "birth_country", "birth_country_code",
+		"death_date", "death_city", "death_country", "death_country_code", "updated_at",
+	}
+}
+
+func (l LaureateListResponse) CSVRows() [][]string {
+	rows := make([][]string, len(l.Data))
+	for i, la := range l.Data {
+		rows[i] = []string{
+			strconv.Itoa(int(la.ID)),
+			la.Firstname,
+			la.Surname,
+			la.Motivation,
+			strconv.Itoa(int(la.Share)),
+			la.Type,
+			la.Gender,
+			la.BirthDate,
+			la.BirthCity,
+			la.BirthCountry,
+			la.BirthCountryCode,
+			la.DeathDate,
+			la.DeathCity,
+			la.DeathCountry,
+			la.DeathCountryCode,
+			optional(la.UpdatedAt),
+		}
+	}
+	return rows
+}
+
+func (l LaureateListResponse) Pagination() (int64, int, int, int) {
+	return l.Total, l.Page, l.PerPage, l.TotalPages
+}
+
+func (r CategoriesResponse) CSVHeader() []string {
+	return []string{"slug", "name", "localized_names", "established_year", "created_at", "updated_at"}
+}
+
+func (r CategoriesResponse) CSVRows() [][]string {
+	rows := make([][]string, len(r.Data))
+	for i, c := range r.Data {
+		names := make([]string, 0, len(c.LocalizedNames))
+		for _, lang := range slices.Sorted(maps.Keys(c.LocalizedNames)) {
+			names = append(names, lang+"="+c.LocalizedNames[lang])
+		}
+		rows[i] = []string{
+			c.Slug,
+			c.Name,
+			strings.Join(names, ";"),
+			strconv.Itoa(int(c.EstablishedYear)),
+			c.CreatedAt,
+			c.UpdatedAt,
+		}
+	}
+	return rows
+}
+
+func (l WebhookListResponse) CSVHeader() []string {
+	return []string{"id", "url", "event_types", "description", "active", "consecutive_failures",
+		"disabled_at", "disabled_reason", "created_at", "updated_at"}
+}
+
+func (l WebhookListResponse) CSVRows() [][]string {
+	rows := make([][]string, len(l.Data))
+	for i, w := range l.Data {
+		rows[i] = []string{
+			strconv.FormatInt(w.ID, 10),
+			w.URL,
+			strings.Join(w.EventTypes, ";"),
+			w.Description,
+			strconv.FormatBool(w.Active),
+			strconv.Itoa(int(w.ConsecutiveFailures)),
+			optional(w.DisabledAt),
+			optional(w.DisabledReason),
+			w.CreatedAt,
+			w.UpdatedAt,
+		}
+	}
+	return rows
+}
+
+func (l WebhookDeliveryListResponse) CSVHeader() []string {
+	return []string{"id", "event_id", "event_type", "status", "attempts", "next_attempt_at",
+		"response_status", "last_error", "duration_ms", "created_at", "completed_at"}
+}
+
+func (l WebhookDeliveryListResponse) CSVRows() [][]string {
+	rows := make([][]string, len(l.Data))
+	for i, d := range l.Data {
+		rows[i] = []string{
+			strconv.FormatInt(d.ID, 10),
+			d.EventID,
+			d.EventType,
+			d.Status,
+			strconv.Itoa(int(d.Attempts)),
+			optional(d.NextAttemptAt),
+			optionalInt(d.ResponseStatus),
+			optional(d.LastError),
+			optionalInt(d.DurationMs),
+			d.CreatedAt,
+			optional(d.CompletedAt),
+		}
+	}
+	return rows
+}
+
+// optional returns the value or an empty CSV field
+func optional(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func optionalInt(i *int32) string {
+	if i == nil {
+		return ""
+	}
+	return strconv.Itoa(int(*i))
+}
+
+func (l PrizeTrendListResponse) CSVHeader() []string {
+	return []string{l.GroupBy, "awarded_count", "not_awarded_count", "solo_count", "shared_count", "laureates_count"}
+}
+
+func (l PrizeTrendListResponse) CSVRows() [][]string {
+	rows := make([][]string, len(l.Data))
+	for i, t := range l.Data {
+		group := t.Category
+		if t.Year != nil {
+			group = strconv.Itoa(int(*t.Year))
+		} else if t.Decade != nil {
+			group = strconv.Itoa(int(*t.Decade))
+		}
+		rows[i] = []string{
+			group,
+			strconv.Itoa(int(t.AwardedCount)),
+			strconv.Itoa(int(t.NotAwardedCount)),
+			strconv.Itoa(int(t.SoloCount)),
+			strconv.Itoa(int(t.SharedCount)),
+			strconv.Itoa(int(t.LaureatesCount)),
+		}
+	}
+	return rows
+}
+
+func (r SharedPrizesResponse) CSVHeader() []string {
+	return []string{"laureates_count", "prizes_count"}
+}
+
+// CSVRows are the laureates per prize, the totals are their sums
+func (r SharedPrizesResponse) CSVRows() [][]string {
+	rows := make([][]string, len(r.Data))
+	for i, d := range r.Data {
+		rows[i] = []string{strconv.Itoa(int(d.LaureatesCount)), strconv.Itoa(int(d.PrizesCount))}
+	}
+	return rows
+}
+
+func (r ShareDistributionResponse) CSVHeader() []string { return []string{"share", "laureates_count"} }
+
+func (r ShareDistributionResponse) CSVRows() [][]string {
+	rows := make([][]string, len(r.Data))
+	for i, d := range r.Data {
+		rows[i] = []string{strconv.Itoa(int(d.Share)), strconv.Itoa(int(d.LaureatesCount))}
+	}
+	return rows
+}
+
+func (r MultipleWinnersResponse) CSVHeader() []string {
+	return []string{"id", "firstname", "surname", "prizes_count", "first_year", "last_year"}
+}
+
+// CSVRows are the laureates, without the distribution of prizes per laureate
+func (r MultipleWinnersResponse) CSVRows() [][]string {
+	rows := make([][]string, len(r.Data))
+	for i, l := range r.Data {
+		rows[i] = []string{
+			strconv.Itoa(int(l.ID)),
+			l.Firstname,
+			l.Surname,
+			strconv.Itoa(int(l.PrizesCount)),
+			strconv.Itoa(int(l.FirstYear)),
+			strconv.Itoa(int(l.LastYear)),
+		}
+	}
+	return rows
+}
+
+func (r NotAwardedYearsListResponse) CSVHeader() []string { return []string{"category", "year"} }
+
+// CSVRows has a row per category and year
+func (r NotAwardedYearsListResponse) CSVRows() [][]string {
+	rows := make([][]string, 0, len(r.Data))
+	for _, c := range r.Data {
+		for _, year := range c.Years {
+			rows = append(rows, []string{c.Category, strconv.Itoa(int(year))})
+		}
+	}
+	return rows
+}
@@ -0,0 +1,287 @@
+package v1
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"ris/internal/app/api/render"
+	"ris/internal/webhook"
+)
+
+// ListWebhooks godoc
+//
+//	@Summary		List webhooks
+//	@Description	Returns all webhook subscriptions without their secrets
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Success		200	{object}	WebhookListResponse
+//	@Failure		401	{object}	ErrorResponse
+//	@Failure		406	{object}	ErrorResponse
+//	@Failure		429	{object}	ErrorResponse
+//	@Failure		500	{object}	ErrorResponse
+//	@Router			/api/v1/webhooks [get]
+//	@security		ApiKeyAuth
+func (h *Handler) ListWebhooks(c *fiber.Ctx) error {
+	webhooks, err := h.webhooks.ListWebhooks(c.UserContext())
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+			Error:   "Internal Server Error",
+			Message: err.Error(),
+		})
+	}
+	return render.Send(c, webhooks)
+}
+
+// GetWebhook godoc
+//
+//	@Summary		Get a webhook by ID
+//	@Description	Returns a webhook subscription without its secret
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		int	true	"Webhook ID"
+//	@Success		200	{object}	WebhookResponse
+//	@Failure		400	{object}	ErrorResponse
+//	@Failure		401	{object}	ErrorResponse
+//	@Failure		404	{object}	ErrorResponse
+//	@Failure		406	{object}	ErrorResponse
+//	@Failure		429	{object}	ErrorResponse
+//	@Failure		500	{object}	ErrorResponse
+//	@Router			/api/v1/webhooks/{id} [get]
+//	@security		ApiKeyAuth
+func (h *Handler) GetWebhook(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid webhook ID",
+		})
+	}
+
+	w, err := h.webhooks.GetWebhook(c.UserContext(), id)
+	if err != nil {
+		return webhookError(c, err)
+	}
+	return render.Send(c, w)
+}
+
+// CreateWebhook godoc
+//
+//	@Summary		Create a webhook
+//	@Description	Subscribes a URL to events. Event types are exact (prize.created), all events of an entity (prize.*) or all events (*).
+//	@Description	Deliveries are signed with the secret, it is returned only in this response.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			webhook	body		CreateWebhookRequest	true	"Webhook data"
+//	@Success		201		{object}	WebhookResponse
+//	@Failure		400		{object}	ErrorResponse
+//	@Failure		401		{object}	ErrorResponse
+//	@Failure		406		{object}	ErrorResponse
+//	@Failure		429		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Router			/api/v1/webhooks [post]
+//	@security		ApiKeyAuth
+func (h *Handler) CreateWebhook(c *fiber.Ctx) error {
+	req, ok := parseBody[CreateWebhookRequest](h, c)
+	if !ok {
+		return nil
+	}
+
+	w, err := h.webhooks.CreateWebhook(c.UserContext(), &req)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+			Error:   "Internal Server Error",
+			Message: err.Error(),
+		})
+	}
+	return render.Send(c.Status(fiber.StatusCreated), w)
+}
+
+// UpdateWebhook godoc
+//
+//	@Summary		Update a webhook
+//	@Description	Updates a webhook subscription. Activating a disabled webhook clears its failures.
+//	@Description	The secret is returned only when it is set or rotated.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id		path		int						true	"Webhook ID"
+//	@Param			webhook	body		UpdateWebhookRequest	true	"Webhook data"
+//	@Success		200		{object}	WebhookResponse
+//	@Failure		400		{object}	ErrorResponse
+//	@Failure		401		{object}	ErrorResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		406		{object}	ErrorResponse
+//	@Failure		429		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Router			/api/v1/webhooks/{id} [put]
+//	@security		ApiKeyAuth
+func (h *Handler) UpdateWebhook(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid webhook ID",
+		})
+	}
+
+	req, ok := parseBody[UpdateWebhookRequest](h, c)
+	if !ok {
+		return nil
+	}
+
+	w, err := h.webhooks.UpdateWebhook(c.UserContext(), id, &req)
+	if err != nil {
+		return webhookError(c, err)
+	}
+	return render.Send(c, w)
+}
+
+// DeleteWebhook godoc
+//
+//	@Summary		Delete a webhook
+//	@Description	Deletes a webhook subscription with its delivery history
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id	path		int	true	"Webhook ID"
+//	@Success		200	{object}	SuccessResponse
+//	@Failure		400	{object}	ErrorResponse
+//	@Failure		401	{object}	ErrorResponse
+//	@Failure		404	{object}	ErrorResponse
+//	@Failure		406	{object}	ErrorResponse
+//	@Failure		429	{object}	ErrorResponse
+//	@Failure		500	{object}	ErrorResponse
+//	@Router			/api/v1/webhooks/{id} [delete]
+//	@security		ApiKeyAuth
+func (h *Handler) DeleteWebhook(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid webhook ID",
+		})
+	}
+
+	if err := h.webhooks.DeleteWebhook(c.UserContext(), id); err != nil {
+		return webhookError(c, err)
+	}
+	return render.Send(c, SuccessResponse{Message: "Webhook deleted successfully"})
+}
+
+// ListWebhookDeliveries godoc
+//
+//	@Summary		List webhook deliveries
+//	@Description	Returns the latest deliveries of a webhook, newest first
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml,text/csv
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id		path		int		true	"Webhook ID"
+//	@Param			status	query		string	false	"Delivery status"	Enums(pending, delivered, failed)
+//	@Param			limit	query		int		false	"Number of deliveries"	default(20)	maximum(100)
+//	@Success		200		{object}	WebhookDeliveryListResponse
+//	@Failure		400		{object}	ErrorResponse
+//	@Failure		401		{object}	ErrorResponse
+//	@Failure		404		{object}	ErrorResponse
+//	@Failure		406		{object}	ErrorResponse
+//	@Failure		429		{object}	ErrorResponse
+//	@Failure		500		{object}	ErrorResponse
+//	@Router			/api/v1/webhooks/{id}/deliveries [get]
+//	@security		ApiKeyAuth
+func (h *Handler) ListWebhookDeliveries(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid webhook ID",
+		})
+	}
+	status := c.Query("status")
+	switch status {
+	case "", webhook.StatusPending, webhook.StatusDelivered, webhook.StatusFailed:
+	default:
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid delivery status",
+		})
+	}
+	limit, _ := strconv.Atoi(c.Query("limit", "20"))
+
+	deliveries, err := h.webhooks.ListWebhookDeliveries(c.UserContext(), id, status, limit)
+	if err != nil {
+		return webhookError(c, err)
+	}
+	return render.Send(c, deliveries)
+}
+
+// RedeliverWebhookDelivery godoc
+//
+//	@Summary		Redeliver an event
+//	@Description	Schedules a delivery to be sent again now, a failed delivery gets a single attempt
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json,application/xml,application/yaml
+//	@Security		BearerAuth
+//	@Security		ApiKeyAuth
+//	@Param			id			path		int	true	"Webhook ID"
+//	@Param			deliveryId	path		int	true	"Delivery ID"
+//	@Success		202			{object}	SuccessResponse
+//	@Failure		400			{object}	ErrorResponse
+//	@Failure		401			{object}	ErrorResponse
+//	@Failure		404			{object}	ErrorResponse
+//	@Failure		406			{object}	ErrorResponse
+//	@Failure		429			{object}	ErrorResponse
+//	@Failure		500			{object}	ErrorResponse
+//	@Router			/api/v1/webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+//	@security		ApiKeyAuth
+func (h *Handler) RedeliverWebhookDelivery(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid webhook ID",
+		})
+	}
+	deliveryID, err := strconv.ParseInt(c.Params("deliveryId"), 10, 64)
+	if err != nil {
+		return render.Send(c.Status(fiber.StatusBadRequest), ErrorResponse{
+			Error:   "Bad Request",
+			Message: "Invalid delivery ID",
+		})
+	}
+
+	if err := h.webhooks.RedeliverWebhookDelivery(c.UserContext(), id, deliveryID); err != nil {
+		return webhookError(c, err)
+	}
+	return render.Send(c.Status(fiber.StatusAccepted), SuccessResponse{Message: "Delivery scheduled"})
+}
+
+// webhookError responds with 404 for unknown webhooks and 500 otherwise
+func webhookError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, ErrWebhookNotFound) {
+		return render.Send(c.Status(fiber.StatusNotFound), ErrorResponse{
+			Error:   "Not Found",
+			Message: err.Error(),
+		})
+	}
+	return render.Send(c.Status(fiber.StatusInternalServerError), ErrorResponse{
+		Error:   "Internal Server Error",
+		Message: err.Error(),
+	})
+}
@@ -0,0 +1,215 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"ris/internal/webhook"
+	"ris/pkg/postgres/queries"
+)
+
+// ErrWebhookNotFound is returned for unknown webhooks and deliveries
+var ErrWebhookNotFound = errors.New("webhook not found")
+
+// WebhookService defines the interface for managing webhook subscriptions
+type WebhookService interface {
+	ListWebhooks(ctx context.Context) (*WebhookListResponse, error)
+	GetWebhook(ctx context.Context, id int64) (*WebhookResponse, error)
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*WebhookResponse, error)
+	UpdateWebhook(ctx context.Context, id int64, req *UpdateWebhookRequest) (*WebhookResponse, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+	ListWebhookDeliveries(ctx context.Context, id int64, status string, limit int) (*WebhookDeliveryListResponse, error)
+	RedeliverWebhookDelivery(ctx context.Context, id, deliveryID int64) error
+}
+
+// WebhookManager implements the WebhookService interface.
+// Deliveries are sent by webhook.Dispatcher.
+type WebhookManager struct {
+	queries *queries.Queries
+}
+
+// NewWebhookManager creates a new WebhookManager instance
+func NewWebhookManager(q *queries.Queries) *WebhookManager {
+	return &WebhookManager{queries: q}
+}
+
+// ListWebhooks returns all webhooks
+func (m *WebhookManager) ListWebhooks(ctx context.Context) (*WebhookListResponse, error) {
+	webhooks, err := m.queries.ListWebhooks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhooks: %w", err)
+	}
+	data := make([]WebhookResponse, len(webhooks))
+	for i, w := range webhooks {
+		data[i] = webhookResponse(w, false)
+	}
+	return &WebhookListResponse{Data: data}, nil
+}
+
+// GetWebhook returns a webhook by ID
+func (m *WebhookManager) GetWebhook(ctx context.Context, id int64) (*WebhookResponse, error) {
+	w, err := m.queries.GetWebhook(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook: %w", err)
+	}
+	resp := webhookResponse(w, false)
+	return &resp, nil
+}
+
+// CreateWebhook creates a webhook, generating its secret when it is not set
+func (m *WebhookManager) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*WebhookResponse, error) {
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+	}
+	w, err := m.queries.CreateWebhook(ctx, queries.CreateWebhookParams{
+		Url:         req.URL,
+		EventTypes:  req.EventTypes,
+		Secret:      secret,
+		Description: req.Description,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook: %w", err)
+	}
+	resp := webhookResponse(w, true)
+	return &resp, nil
+}
+
+// UpdateWebhook updates a webhook. The secret is returned only when it was changed.
+func (m *WebhookManager) UpdateWebhook(ctx context.Context, id int64, req *UpdateWebhookRequest) (*WebhookResponse, error) {
+	secret := req.Secret
+	if req.RotateSecret && secret == "" {
+		var err error
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+	}
+	w, err := m.queries.UpdateWebhook(ctx, queries.UpdateWebhookParams{
+		ID:          id,
+		Url:         req.URL,
+		EventTypes:  req.EventTypes,
+		Description: req.Description,
+		Secret:      secret,
+		Active:      *req.Active,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrWebhookNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update webhook: %w", err)
+	}
+	resp := webhookResponse(w, secret != "")
+	return &resp, nil
+}
+
+// DeleteWebhook deletes a webhook with its deliveries
+func (m *WebhookManager) DeleteWebhook(ctx context.Context, id int64) error {
+	n, err := m.queries.DeleteWebhook(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+	if n == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of a webhook, optionally with the given status
+func (m *WebhookManager) ListWebhookDeliveries(ctx context.Context, id int64, status string, limit int) (*WebhookDeliveryListResponse, error) {
+	if _, err := m.GetWebhook(ctx, id); err != nil {
+		return nil, err
+	}
+	if limit < 1 || limit > 100 {
+		limit = 20
+	}
+	deliveries, err := m.queries.ListWebhookDeliveries(ctx, queries.ListWebhookDeliveriesParams{
+		WebhookID: id,
+		Status:    pgtype.Text{String: status, Valid: status != ""},
+		RowLimit:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list webhook deliveries: %w", err)
+	}
+
+	data := make([]WebhookDeliveryResponse, len(deliveries))
+	for i, d := range deliveries {
+		data[i] = WebhookDeliveryResponse{
+			ID:          d.ID,
+			EventID:     d.EventID,
+			EventType:   d.EventType,
+			Status:      d.Status,
+			Attempts:    d.Attempts,
+			CreatedAt:   d.CreatedAt.Time.Format(time.RFC3339),
+			CompletedAt: formatTimestamp(d.CompletedAt),
+		}
+		if d.Status == webhook.StatusPending {
+			data[i].NextAttemptAt = formatTimestamp(d.NextAttemptAt)
+		}
+		if d.ResponseStatus.Valid {
+			data[i].ResponseStatus = &d.ResponseStatus.Int32
+		}
+		if d.LastError.Valid {
+			data[i].LastError = &d.LastError.String
+		}
+		if d.DurationMs.Valid {
+			data[i].DurationMs = &d.DurationMs.Int32
+		}
+	}
+	return &WebhookDeliveryListResponse{Data: data}, nil
+}
+
+// RedeliverWebhookDelivery schedules a delivery to be sent again now.
+// A failed delivery gets a single attempt.
+func (m *WebhookManager) RedeliverWebhookDelivery(ctx context.Context, id, deliveryID int64) error {
+	n, err := m.queries.RedeliverWebhookDelivery(ctx, queries.RedeliverWebhookDeliveryParams{
+		ID:        deliveryID,
+		WebhookID: id,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to redeliver webhook delivery: %w", err)
+	}
+	if n == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func webhookResponse(w queries.Webhook, withSecret bool) WebhookResponse {
+	resp := WebhookResponse{
+		ID:                  w.ID,
+		URL:                 w.Url,
+		EventTypes:          w.EventTypes,
+		Description:         w.Description,
+		Active:              w.Active,
+		ConsecutiveFailures: w.ConsecutiveFailures,
+		DisabledAt:          formatTimestamp(w.DisabledAt),
+		CreatedAt:           w.CreatedAt.Time.Format(time.RFC3339),
+		UpdatedAt:           w.UpdatedAt.Time.Format(time.RFC3339),
+	}
+	if withSecret {
+		resp.Secret = w.Secret
+	}
+	if w.DisabledReason.Valid {
+		resp.DisabledReason = &w.DisabledReason.String
+	}
+	return resp
+}
+
+func formatTimestamp(t pgtype.Timestamptz) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.Time.Format(time.RFC3339)
+	return &s
+}
@@ -0,0 +1,254 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"go.yaml.in/yaml/v3"
+)
+
+// option is a single configuration value found by walking the Config struct
+type option struct {
+	key    string // dotted key, e.g. "db.url"
+	env    string
+	usage  string
+	secret bool
+	value  reflect.Value
+}
+
+// Load resolves the configuration starting from defaults.
+//
+// It registers a flag for every option (plus -config with the path to the
+// configuration file) on fs and parses args. Flags may be mixed with positional
+// arguments, e.g. "config print -config app.yaml". Positional arguments are returned.
+// The resulting configuration is validated.
+func Load(fs *flag.FlagSet, args []string, defaults Config) (*Config, []string, error) {
+	cfg := defaults
+	options := collect(reflect.ValueOf(&cfg).Elem(), "")
+
+	// Flags are only recorded here, they are applied last to take precedence
+	flagValues := make(map[string]string)
+	configFile := fs.String("config", os.Getenv("CONFIG_FILE"), "Path to the configuration file (YAML or TOML)")
+	for _, opt := range options {
+		fs.Func(opt.key, opt.usage+" (env "+opt.env+")", func(s string) error {
+			if err := set(opt.value, s); err != nil {
+				return err
+			}
+			flagValues[opt.key] = s
+			return nil
+		})
+	}
+
+	positional, err := parseInterspersed(fs, args)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Flags were already set on cfg while parsing, so start over from defaults
+	cfg = defaults
+	if *configFile != "" {
+		if err := loadFile(*configFile, options); err != nil {
+			return nil, nil, err
+		}
+	}
+	for _, opt := range options {
+		if v, ok := os.LookupEnv(opt.env); ok {
+			if err := set(opt.value, v); err != nil {
+				return nil, nil, fmt.Errorf("env %s: %w", opt.env, err)
+			}
+		}
+	}
+	for _, opt := range options {
+		if v, ok := flagValues[opt.key]; ok {
+			_ = set(opt.value, v) // already validated while parsing
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("invalid configuration: %w", err)
+	}
+	return &cfg, positional, nil
+}
+
+// parseInterspersed parses flags which may appear after positional arguments
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// collect walks the struct and returns all options with their keys
+func collect(v reflect.Value, prefix string) []option {
+	var options []option
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key := prefix + f.Tag.Get("key")
+		if f.Type.Kind() == reflect.Struct && f.Type != reflect.TypeOf(time.Duration(0)) {
+			options = append(options, collect(v.Field(i), key+".")...)
+			continue
+		}
+		options = append(options, option{
+			key:    key,
+			env:    f.Tag.Get("env"),
+			usage:  f.Tag.Get("usage"),
+			secret: f.Tag.Get("secret") == "true",
+			value:  v.Field(i),
+		})
+	}
+	return options
+}
+
+// set parses s according to the type of v and stores the result
+func set(v reflect.Value, s string) error {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Map:
+		// key=value pairs separated by commas
+		m := make(map[string]string)
+		for _, pair := range strings.Split(s, ",") {
+			if pair = strings.TrimSpace(pair); pair == "" {
+				continue
+			}
+			k, val, ok := strings.Cut(pair, "=")
+			if !ok {
+				return fmt.Errorf("expected key=value, got %q", pair)
+			}
+			m[k] = val
+		}
+		v.Set(reflect.ValueOf(m))
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// loadFile reads a YAML or TOML file and applies its values.
+// Unknown keys are reported as errors to catch typos.
+func loadFile(path string, options []option) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read config file: %w", err)
+	}
+
+	var raw map[string]any
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &raw)
+	case ".toml":
+		err = toml.Unmarshal(data, &raw)
+	default:
+		return fmt.Errorf("unsupported config file extension %q, use .yaml, .yml or .toml", ext)
+	}
+	if err != nil {
+		return fmt.Errorf("could not parse config file %s: %w", path, err)
+	}
+
+	values := make(map[string]any)
+	flatten(raw, "", values, options)
+
+	known := make(map[string]option, len(options))
+	for _, opt := range options {
+		known[opt.key] = opt
+	}
+	var unknown []string
+	for key, value := range values {
+		opt, ok := known[key]
+		if !ok {
+			unknown = append(unknown, key)
+			continue
+		}
+		if err := setFromFile(opt.value, value); err != nil {
+			return fmt.Errorf("config file %s: %s: %w", path, key, err)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("config file %s: unknown keys: %s", path, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// flatten converts nested maps to dotted keys. Maps which correspond to
+// map options (e.g. auth.users) are kept as a single value.
+func flatten(raw map[string]any, prefix string, out map[string]any, options []option) {
+	for k, v := range raw {
+		key := prefix + k
+		nested, ok := v.(map[string]any)
+		if ok && !isMapOption(key, options) {
+			flatten(nested, key+".", out, options)
+			continue
+		}
+		out[key] = v
+	}
+}
+
+func isMapOption(key string, options []option) bool {
+	for _, opt := range options {
+		if opt.key == key {
+			return opt.value.Kind() == reflect.Map
+		}
+	}
+	return false
+}
+
+func setFromFile(v reflect.Value, value any) error {
+	if v.Kind() == reflect.Map {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("expected a map")
+		}
+		res := make(map[string]string, len(m))
+		for k, val := range m {
+			res[k] = fmt.Sprint(val)
+		}
+		v.Set(reflect.ValueOf(res))
+		return nil
+	}
+	return set(v, fmt.Sprint(value))
+}
@@ -0,0 +1,42 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// SetupLogger configures the default slog logger (and the standard log package,
+// which writes through it) according to the log settings.
+// The returned function closes the log file, if any.
+func SetupLogger(cfg LogConfig) (func() error, error) {
+	var out io.Writer = os.Stdout
+	closeFn := func() error { return nil }
+	if cfg.File != "" {
+		file, err := os.OpenFile(cfg.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+		if err != nil {
+			return nil, fmt.Errorf("could not open log file: %w", err)
+		}
+		out = file
+		closeFn = file.Close
+	}
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(cfg.Level)); err != nil {
+		return nil, fmt.Errorf("invalid log level: %w", err)
+	}
+	opts := &slog.HandlerOptions{
+		AddSource: cfg.AddSource,
+		Level:     level,
+	}
+
+	var handler slog.Handler
+	if cfg.Format == "text" {
+		handler = slog.NewTextHandler(out, opts)
+	} else {
+		handler = slog.NewJSONHandler(out, opts)
+	}
+	slog.SetDefault(slog.New(handler))
+	return closeFn, nil
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.yaml.in/yaml/v3"
+)
+
+const mask = "******"
+
+// IsPrintCommand reports whether positional arguments request "config print"
+func IsPrintCommand(args []string) bool {
+	return len(args) == 2 && args[0] == "config" && args[1] == "print"
+}
+
+// Print writes the configuration as YAML with secrets masked
+func Print(w io.Writer, cfg *Config) error {
+	node := toNode(reflect.ValueOf(*cfg))
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return fmt.Errorf("could not encode config: %w", err)
+	}
+	return enc.Close()
+}
+
+// toNode converts a config struct to a YAML node keeping the field order
+func toNode(v reflect.Value) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: f.Tag.Get("key")}
+
+		var value *yaml.Node
+		fv := v.Field(i)
+		switch {
+		case f.Type.Kind() == reflect.Struct:
+			value = toNode(fv)
+		case f.Type.Kind() == reflect.Map:
+			value = &yaml.Node{Kind: yaml.MappingNode}
+			keys := fv.MapKeys()
+			sort.Slice(keys, func(a, b int) bool { return keys[a].String() < keys[b].String() })
+			for _, k := range keys {
+				s := fv.MapIndex(k).String()
+				if f.Tag.Get("secret") == "true" {
+					s = maskSecret(s)
+				}
+				value.Content = append(value.Content, scalar(k.String()), scalar(s))
+			}
+		default:
+			s := scalarString(fv)
+			if f.Tag.Get("secret") == "true" {
+				s = maskSecret(s)
+			}
+			value = scalar(s)
+		}
+		node.Content = append(node.Content, key, value)
+	}
+	return node
+}
+
+func scalar(s string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Value: s}
+	if s == "" {
+		// Keep empty strings distinguishable from null
+		node.Style = yaml.DoubleQuotedStyle
+	}
+	return node
+}
+
+func scalarString(v reflect.Value) string {
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		return time.Duration(v.Int()).String()
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	default:
+		return v.String()
+	}
+}
+
+// maskSecret hides a secret value. For URLs only the password is hidden,
+// so that the host and database are still visible.
+func maskSecret(s string) string {
+	if s == "" {
+		return ""
+	}
+	if u, err := url.Parse(s); err == nil && u.Host != "" {
+		return u.Redacted()
+	}
+	return mask
+}
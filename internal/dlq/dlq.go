@@ -0,0 +1,171 @@
+// Package dlq keeps events which subscribers failed to handle (dead letters)
+// in the EVENTS_DLQ stream and manages them.
+//
+// A dead letter is the original message published to "dlq.<subject>" with the
+// original headers and the failure details in Ris-Dlq-* headers.
+package dlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ris/internal/stream"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Headers with the failure details
+const (
+	HeaderReason     = "Ris-Dlq-Reason"
+	HeaderError      = "Ris-Dlq-Error"
+	HeaderConsumer   = "Ris-Dlq-Consumer"
+	HeaderSequence   = "Ris-Dlq-Stream-Sequence"
+	HeaderDeliveries = "Ris-Dlq-Deliveries"
+	HeaderFailedAt   = "Ris-Dlq-Failed-At"
+)
+
+// Reasons of dead-lettering
+const (
+	ReasonDecode        = "decode"         // the message is not a valid event
+	ReasonMaxDeliveries = "max_deliveries" // the handler failed on every delivery
+)
+
+// Entry is a dead letter
+type Entry struct {
+	Sequence       uint64 // sequence in the dead letter stream
+	Subject        string // original subject
+	Reason         string
+	Error          string
+	Consumer       string
+	StreamSequence uint64 // sequence in the events stream
+	Deliveries     uint64
+	FailedAt       time.Time
+	Header         nats.Header // original headers
+	Data           []byte
+}
+
+// Store publishes a copy of the JetStream message msg with the failure details to the dead letter stream
+func Store(ctx context.Context, js nats.JetStreamContext, msg *nats.Msg, reason string, cause error) error {
+	meta, err := msg.Metadata()
+	if err != nil {
+		return fmt.Errorf("could not get message metadata: %w", err)
+	}
+
+	dl := nats.NewMsg(stream.DLQPrefix + msg.Subject)
+	dl.Data = msg.Data
+	for k, v := range msg.Header {
+		// Nats-* headers control publishing (message id, expected stream) and belong to the original publish
+		if !strings.HasPrefix(k, "Nats-") {
+			dl.Header[k] = v
+		}
+	}
+	// Every consumer records its own failure, repeated stores of the same failure are dropped
+	dl.Header.Set(nats.MsgIdHdr, meta.Consumer+":"+strconv.FormatUint(meta.Sequence.Stream, 10))
+	dl.Header.Set(HeaderReason, reason)
+	dl.Header.Set(HeaderError, cause.Error())
+	dl.Header.Set(HeaderConsumer, meta.Consumer)
+	dl.Header.Set(HeaderSequence, strconv.FormatUint(meta.Sequence.Stream, 10))
+	dl.Header.Set(HeaderDeliveries, strconv.FormatUint(meta.NumDelivered, 10))
+	dl.Header.Set(HeaderFailedAt, time.Now().UTC().Format(time.RFC3339Nano))
+
+	if _, err := js.PublishMsg(dl, nats.Context(ctx), nats.ExpectStream(stream.DLQName)); err != nil {
+		return fmt.Errorf("could not store dead letter: %w", err)
+	}
+	return nil
+}
+
+// List returns up to limit dead letters starting from the oldest one, 0 for no limit
+func List(js nats.JetStreamContext, limit int) ([]Entry, error) {
+	info, err := js.StreamInfo(stream.DLQName)
+	if err != nil {
+		return nil, fmt.Errorf("could not get stream %s: %w", stream.DLQName, err)
+	}
+	entries := make([]Entry, 0, info.State.Msgs)
+	for seq := info.State.FirstSeq; seq > 0 && seq <= info.State.LastSeq; seq++ {
+		if limit > 0 && len(entries) >= limit {
+			break
+		}
+		entry, err := Get(js, seq)
+		if errors.Is(err, nats.ErrMsgNotFound) {
+			// Deleted after a replay
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, *entry)
+	}
+	return entries, nil
+}
+
+// Get returns the dead letter with the sequence
+func Get(js nats.JetStreamContext, seq uint64) (*Entry, error) {
+	msg, err := js.GetMsg(stream.DLQName, seq)
+	if err != nil {
+		return nil, fmt.Errorf("could not get dead letter %d: %w", seq, err)
+	}
+
+	entry := &Entry{
+		Sequence: msg.Sequence,
+		Subject:  strings.TrimPrefix(msg.Subject, stream.DLQPrefix),
+		Reason:   msg.Header.Get(HeaderReason),
+		Error:    msg.Header.Get(HeaderError),
+		Consumer: msg.Header.Get(HeaderConsumer),
+		Header:   nats.Header{},
+		Data:     msg.Data,
+	}
+	entry.StreamSequence, _ = strconv.ParseUint(msg.Header.Get(HeaderSequence), 10, 64)
+	entry.Deliveries, _ = strconv.ParseUint(msg.Header.Get(HeaderDeliveries), 10, 64)
+	entry.FailedAt, _ = time.Parse(time.RFC3339Nano, msg.Header.Get(HeaderFailedAt))
+	for k, v := range msg.Header {
+		if !strings.HasPrefix(k, "Ris-Dlq-") && !strings.HasPrefix(k, "Nats-") {
+			entry.Header[k] = v
+		}
+	}
+	return entry, nil
+}
+
+// Replay publishes the dead letter to its original subject and removes it from the dead letter stream.
+//
+// All consumers of the subject receive the event again.
+func Replay(ctx context.Context, js nats.JetStreamContext, seq uint64) error {
+	entry, err := Get(js, seq)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(entry.Subject)
+	msg.Data = entry.Data
+	for k, v := range entry.Header {
+		msg.Header[k] = v
+	}
+	// Sequences are not reused, so only a retried replay of this dead letter is dropped as a duplicate
+	msg.Header.Set(nats.MsgIdHdr, "dlq:"+strconv.FormatUint(seq, 10))
+	if _, err := js.PublishMsg(msg, nats.Context(ctx), nats.ExpectStream(stream.Name)); err != nil {
+		return fmt.Errorf("could not replay dead letter %d: %w", seq, err)
+	}
+	if err := js.DeleteMsg(stream.DLQName, seq); err != nil {
+		return fmt.Errorf("could not delete replayed dead letter %d: %w", seq, err)
+	}
+	return nil
+}
+
+// Delete removes the dead letter with the sequence
+func Delete(js nats.JetStreamContext, seq uint64) error {
+	if err := js.DeleteMsg(stream.DLQName, seq); err != nil {
+		return fmt.Errorf("could not delete dead letter %d: %w", seq, err)
+	}
+	return nil
+}
+
+// Purge removes all dead letters
+func Purge(js nats.JetStreamContext) error {
+	if err := js.PurgeStream(stream.DLQName); err != nil {
+		return fmt.Errorf("could not purge stream %s: %w", stream.DLQName, err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package domain
+
+// PrizeFields are the own fields of a prize, without laureates
+type PrizeFields struct {
+	Year     int32  `json:"year"`
+	Category string `json:"category"`
+}
+
+// PrizeUpdated is the payload of prize.updated
+type PrizeUpdated struct {
+	Id       int32       `json:"id"`
+	Current  PrizeFields `json:"current"`
+	Previous PrizeFields `json:"previous"`
+	// Changed lists JSON names of the fields which differ between Previous and Current
+	Changed []string `json:"changed"`
+}
+
+// PrizeDeleted is the payload of prize.deleted
+type PrizeDeleted struct {
+	Id       int32       `json:"id"`
+	Previous PrizeFields `json:"previous"`
+	// LaureateIds are the laureates which were linked to the prize, the links are removed with it
+	LaureateIds []int32 `json:"laureate_ids"`
+}
+
+// LaureateUpdated is the payload of laureate.updated
+type LaureateUpdated struct {
+	Id       int32    `json:"id"`
+	Current  Laureate `json:"current"`
+	Previous Laureate `json:"previous"`
+	// Changed lists JSON names of the fields which differ between Previous and Current
+	Changed []string `json:"changed"`
+}
+
+// LaureateDeleted is the payload of laureate.deleted
+type LaureateDeleted struct {
+	Id       int32    `json:"id"`
+	Previous Laureate `json:"previous"`
+	// PrizeIds are the prizes the laureate was linked to, the links are removed with it
+	PrizeIds []int32 `json:"prize_ids"`
+}
+
+// PrizeLaureateLink is the payload of prize.laureate_linked and prize.laureate_unlinked
+type PrizeLaureateLink struct {
+	PrizeId    int32 `json:"prize_id"`
+	LaureateId int32 `json:"laureate_id"`
+}
+
+// Category is a prize category, the payload of category.created
+type Category struct {
+	Slug            string            `json:"slug"`
+	Name            string            `json:"name"`
+	LocalizedNames  map[string]string `json:"localized_names"`
+	EstablishedYear int32             `json:"established_year"`
+}
+
+// CategoryUpdated is the payload of category.updated
+type CategoryUpdated struct {
+	Slug     string   `json:"slug"`
+	Current  Category `json:"current"`
+	Previous Category `json:"previous"`
+	// Changed lists JSON names of the fields which differ between Previous and Current
+	Changed []string `json:"changed"`
+}
+
+// CategoryDeleted is the payload of category.deleted
+type CategoryDeleted struct {
+	Slug     string   `json:"slug"`
+	Previous Category `json:"previous"`
+}
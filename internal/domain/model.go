@@ -1,6 +1,19 @@
 package domain
 
-import "ris/pkg/utills"
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"ris/pkg/utills"
+)
+
+// Laureate types
+const (
+	LaureatePerson       = "person"
+	LaureateOrganization = "organization"
+)
 
 type RawLaureate struct {
 	Id         string  `json:"id"`
@@ -11,21 +24,132 @@ type RawLaureate struct {
 }
 
 func (r *RawLaureate) ToLaureate() Laureate {
-	return Laureate{
+	l := Laureate{
 		Id:         int32(utills.ParseStringToInt(r.Id)),
 		Firstname:  r.FirstName,
 		Surname:    utills.GetVal(r.Surname),
 		Motivation: r.Motivation,
 		Share:      int32(utills.ParseStringToInt(r.Share)),
+		Type:       LaureatePerson,
 	}
+	// The prize dataset has no types, organizations have no surname there
+	if l.Surname == "" {
+		l.Type = LaureateOrganization
+	}
+	return l
 }
 
+// Laureate is a person or an organization. Dates are YYYY-MM-DD, empty when unknown.
+// Affiliations are the ones of a prize, they are set on laureates of prizes only.
 type Laureate struct {
-	Id         int32  `json:"id"`
-	Firstname  string `json:"firstname"`
-	Surname    string `json:"surname,omitempty"`
-	Motivation string `json:"motivation"`
-	Share      int32  `json:"share"`
+	Id               int32         `json:"id"`
+	Firstname        string        `json:"firstname"`
+	Surname          string        `json:"surname,omitempty"`
+	Motivation       string        `json:"motivation"`
+	Share            int32         `json:"share"`
+	Type             string        `json:"type,omitempty"`
+	Gender           string        `json:"gender,omitempty"`
+	BirthDate        string        `json:"birth_date,omitempty"`
+	BirthCity        string        `json:"birth_city,omitempty"`
+	BirthCountry     string        `json:"birth_country,omitempty"`
+	BirthCountryCode string        `json:"birth_country_code,omitempty"`
+	DeathDate        string        `json:"death_date,omitempty"`
+	DeathCity        string        `json:"death_city,omitempty"`
+	DeathCountry     string        `json:"death_country,omitempty"`
+	DeathCountryCode string        `json:"death_country_code,omitempty"`
+	Affiliations     []Affiliation `json:"affiliations,omitempty"`
+}
+
+// Affiliation is a university or an institute where a laureate did the awarded work
+type Affiliation struct {
+	Name    string `json:"name"`
+	City    string `json:"city,omitempty"`
+	Country string `json:"country,omitempty"`
+}
+
+// RawLaureateDetails is a laureate of the laureate dataset, it has the bio data and affiliations
+// missing in the prize dataset. The birth of an organization is its foundation.
+type RawLaureateDetails struct {
+	Id              string             `json:"id"`
+	Born            string             `json:"born"`
+	BornCity        string             `json:"bornCity"`
+	BornCountry     string             `json:"bornCountry"`
+	BornCountryCode string             `json:"bornCountryCode"`
+	Died            string             `json:"died"`
+	DiedCity        string             `json:"diedCity"`
+	DiedCountry     string             `json:"diedCountry"`
+	DiedCountryCode string             `json:"diedCountryCode"`
+	Gender          string             `json:"gender"`
+	Prizes          []RawLaureatePrize `json:"prizes"`
+}
+
+// Apply sets the type and the bio data of the laureate
+func (r *RawLaureateDetails) Apply(l *Laureate) {
+	l.Type = LaureatePerson
+	switch r.Gender {
+	case "org":
+		l.Type = LaureateOrganization
+	case "male", "female":
+		l.Gender = r.Gender
+	}
+	l.BirthDate = completeDate(r.Born)
+	l.BirthCity = r.BornCity
+	l.BirthCountry = r.BornCountry
+	l.BirthCountryCode = strings.ToUpper(r.BornCountryCode)
+	l.DeathDate = completeDate(r.Died)
+	l.DeathCity = r.DiedCity
+	l.DeathCountry = r.DiedCountry
+	l.DeathCountryCode = strings.ToUpper(r.DiedCountryCode)
+}
+
+// Affiliations returns the affiliations of the laureate for the prize of the year and category
+func (r *RawLaureateDetails) Affiliations(year, category string) []Affiliation {
+	var affiliations []Affiliation
+	for _, prize := range r.Prizes {
+		if prize.Year != year || prize.Category != category {
+			continue
+		}
+		for _, a := range prize.Affiliations {
+			if a.Name != "" {
+				affiliations = append(affiliations, Affiliation(a))
+			}
+		}
+	}
+	return affiliations
+}
+
+type RawLaureatePrize struct {
+	Year         string           `json:"year"`
+	Category     string           `json:"category"`
+	Affiliations []RawAffiliation `json:"affiliations"`
+}
+
+type RawAffiliation struct {
+	Name    string `json:"name"`
+	City    string `json:"city"`
+	Country string `json:"country"`
+}
+
+// UnmarshalJSON decodes an affiliation, the dataset has empty arrays in place of missing ones
+func (a *RawAffiliation) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		*a = RawAffiliation{}
+		return nil
+	}
+	type plain RawAffiliation
+	return json.Unmarshal(data, (*plain)(a))
+}
+
+type LaureatesResponse struct {
+	Laureates []RawLaureateDetails `json:"laureates"`
+}
+
+// completeDate returns the date if it is complete, the dataset has dates like 0000-00-00 and 1863-00-00
+func completeDate(s string) string {
+	if _, err := time.Parse(time.DateOnly, s); err != nil {
+		return ""
+	}
+	return s
 }
 
 type RawPrize struct {
@@ -49,6 +173,7 @@ func (r *RawPrize) ToPrize() Prize {
 }
 
 type Prize struct {
+	Id                int32      `json:"id,omitempty"`
 	Year              string     `json:"year"`
 	Category          string     `json:"category"`
 	Laureates         []Laureate `json:"laureates"`
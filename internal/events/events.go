@@ -0,0 +1,149 @@
+// Package events defines the envelope shared by all domain events.
+//
+// Every event published to NATS is an Envelope with metadata and the JSON payload.
+// The event type equals the NATS subject.
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SchemaVersion is the version of the envelope and payloads produced by this code.
+// Bump it on incompatible payload changes.
+const SchemaVersion = 1
+
+// Event types, they are also used as NATS subjects
+const (
+	TypePrizeCreated          = "prize.created"
+	TypePrizeUpdated          = "prize.updated"
+	TypePrizeDeleted          = "prize.deleted"
+	TypePrizeLaureateLinked   = "prize.laureate_linked"
+	TypePrizeLaureateUnlinked = "prize.laureate_unlinked"
+	TypeLaureateCreated       = "laureate.created"
+	TypeLaureateUpdated       = "laureate.updated"
+	TypeLaureateDeleted       = "laureate.deleted"
+	TypeCategoryCreated       = "category.created"
+	TypeCategoryUpdated       = "category.updated"
+	TypeCategoryDeleted       = "category.deleted"
+)
+
+// Types are all event types
+var Types = []string{
+	TypePrizeCreated, TypePrizeUpdated, TypePrizeDeleted, TypePrizeLaureateLinked, TypePrizeLaureateUnlinked,
+	TypeLaureateCreated, TypeLaureateUpdated, TypeLaureateDeleted,
+	TypeCategoryCreated, TypeCategoryUpdated, TypeCategoryDeleted,
+}
+
+// Subjects are the wildcards matching all event types
+var Subjects = []string{"prize.*", "laureate.*", "category.*"}
+
+// Metadata describes an event
+type Metadata struct {
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	SchemaVersion int       `json:"schema_version"`
+	OccurredAt    time.Time `json:"occurred_at"`
+	Producer      string    `json:"producer"`
+	// CorrelationID is the id of the request which caused the event
+	CorrelationID string `json:"correlation_id,omitempty"`
+	// EntityID is the id of the prize or laureate or the slug of the category the event is about
+	EntityID string `json:"entity_id"`
+}
+
+// Envelope is an event with a raw payload, as it is sent over NATS
+type Envelope struct {
+	Metadata
+	Payload json.RawMessage `json:"payload"`
+}
+
+// Event is an event with a decoded payload
+type Event[T any] struct {
+	Metadata
+	Payload T `json:"payload"`
+}
+
+// New creates an envelope with a new time-ordered id.
+// The correlation id is taken from ctx, see WithCorrelationID.
+func New(ctx context.Context, eventType, producer, entityID string, payload any) (Envelope, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return Envelope{}, fmt.Errorf("could not generate event id: %w", err)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return Envelope{}, fmt.Errorf("could not marshal %s payload: %w", eventType, err)
+	}
+	return Envelope{
+		Metadata: Metadata{
+			ID:            id.String(),
+			Type:          eventType,
+			SchemaVersion: SchemaVersion,
+			OccurredAt:    time.Now().UTC(),
+			Producer:      producer,
+			CorrelationID: CorrelationID(ctx),
+			EntityID:      entityID,
+		},
+		Payload: data,
+	}, nil
+}
+
+// Decode parses an envelope and its payload.
+//
+// Messages published before the envelope was introduced contain only the payload,
+// they are returned with schema version 0 and the subject as type.
+func Decode[T any](subject string, data []byte) (Event[T], error) {
+	var event Event[T]
+	meta, err := DecodeInto(subject, data, &event.Payload)
+	if err != nil {
+		return Event[T]{}, err
+	}
+	event.Metadata = meta
+	return event, nil
+}
+
+// DecodeInto parses an envelope like Decode and unmarshals its payload into the value pointed to by payload
+func DecodeInto(subject string, data []byte, payload any) (Metadata, error) {
+	var probe struct {
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return Metadata{}, fmt.Errorf("could not unmarshal envelope: %w", err)
+	}
+
+	// RawMessage reuses its buffer when unmarshalling, so Payload must not point to data before that
+	env := Envelope{Metadata: Metadata{Type: subject}}
+	if probe.Payload != nil {
+		if err := json.Unmarshal(data, &env); err != nil {
+			return Metadata{}, fmt.Errorf("could not unmarshal envelope: %w", err)
+		}
+	} else {
+		env.Payload = data
+	}
+	if env.SchemaVersion > SchemaVersion {
+		return Metadata{}, fmt.Errorf("unsupported schema version %d of %s, expected at most %d",
+			env.SchemaVersion, env.Type, SchemaVersion)
+	}
+
+	if err := json.Unmarshal(env.Payload, payload); err != nil {
+		return Metadata{}, fmt.Errorf("could not unmarshal %s payload: %w", env.Type, err)
+	}
+	return env.Metadata, nil
+}
+
+type correlationIDKey struct{}
+
+// WithCorrelationID returns a context carrying the correlation id
+func WithCorrelationID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, correlationIDKey{}, id)
+}
+
+// CorrelationID returns the correlation id stored in ctx, or an empty string
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
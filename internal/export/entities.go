@@ -0,0 +1,134 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+
+	"ris/pkg/parquet"
+	"ris/pkg/postgres/queries"
+)
+
+// Filter selects the exported rows, nil fields do not filter.
+// Laureates are filtered by name and share, prizes by category and years, awards by all of them.
+type Filter struct {
+	Category *string
+	YearFrom *int32
+	YearTo   *int32
+	Name     *string // part of the first name or surname, case insensitive
+	Share    *int32
+}
+
+// Entity is an exportable part of the dataset
+type Entity struct {
+	Name    string
+	Columns []parquet.Column
+
+	query string
+	args  func(f Filter) []any // arguments of query in its parameter order
+}
+
+// Entities are the exportable parts of the dataset
+var Entities = []Entity{
+	{
+		Name: "laureates",
+		Columns: []parquet.Column{
+			{Name: "id", Type: parquet.Int32},
+			{Name: "firstname", Type: parquet.String},
+			{Name: "surname", Type: parquet.String, Optional: true},
+			{Name: "motivation", Type: parquet.String},
+			{Name: "share", Type: parquet.Int32},
+			{Name: "updated_at", Type: parquet.Timestamp, Optional: true},
+		},
+		query: queries.ExportLaureates,
+		args: func(f Filter) []any {
+			return []any{f.Name, f.Share}
+		},
+	},
+	{
+		Name: "prizes",
+		Columns: []parquet.Column{
+			{Name: "id", Type: parquet.Int32},
+			{Name: "year", Type: parquet.Int32},
+			{Name: "category", Type: parquet.String},
+			{Name: "updated_at", Type: parquet.Timestamp, Optional: true},
+		},
+		query: queries.ExportPrizes,
+		args: func(f Filter) []any {
+			return []any{f.Category, f.YearFrom, f.YearTo}
+		},
+	},
+	{
+		// A row per laureate of a prize
+		Name: "awards",
+		Columns: []parquet.Column{
+			{Name: "prize_id", Type: parquet.Int32},
+			{Name: "year", Type: parquet.Int32},
+			{Name: "category", Type: parquet.String},
+			{Name: "laureate_id", Type: parquet.Int32},
+			{Name: "firstname", Type: parquet.String},
+			{Name: "surname", Type: parquet.String, Optional: true},
+			{Name: "motivation", Type: parquet.String},
+			{Name: "share", Type: parquet.Int32},
+		},
+		query: queries.ExportAwards,
+		args: func(f Filter) []any {
+			return []any{f.Category, f.YearFrom, f.YearTo, f.Name, f.Share}
+		},
+	},
+}
+
+// LookupEntity returns the entity with the name
+func LookupEntity(name string) (Entity, bool) {
+	for _, e := range Entities {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return Entity{}, false
+}
+
+// FilterParams are the names of the filter parameters of ParseFilter
+var FilterParams = []string{"category", "year", "year_from", "year_to", "name", "share"}
+
+// ParseFilter parses a filter from parameters returned by get, empty values do not filter.
+// year selects a single year, it overrides year_from and year_to.
+func ParseFilter(get func(name string) string) (Filter, error) {
+	var f Filter
+	var err error
+	if v := get("category"); v != "" {
+		f.Category = &v
+	}
+	if v := get("name"); v != "" {
+		f.Name = &v
+	}
+	if f.YearFrom, err = parseInt(get, "year_from"); err != nil {
+		return Filter{}, err
+	}
+	if f.YearTo, err = parseInt(get, "year_to"); err != nil {
+		return Filter{}, err
+	}
+	year, err := parseInt(get, "year")
+	if err != nil {
+		return Filter{}, err
+	}
+	if year != nil {
+		f.YearFrom, f.YearTo = year, year
+	}
+	if f.Share, err = parseInt(get, "share"); err != nil {
+		return Filter{}, err
+	}
+	return f, nil
+}
+
+func parseInt(get func(name string) string, name string) (*int32, error) {
+	v := get(name)
+	if v == "" {
+		return nil, nil
+	}
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %q is not an integer", name, v)
+	}
+	i := int32(n)
+	return &i, nil
+}
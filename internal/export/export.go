@@ -0,0 +1,123 @@
+// Package export streams parts of the dataset from Postgres as CSV, NDJSON, Parquet or XLSX.
+//
+// Rows are fetched in batches from a cursor of a read only transaction, so an export
+// sees a consistent snapshot and its memory use does not depend on the size of the dataset.
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// DB begins the transactions of exports, e.g. a pgxpool.Pool
+type DB interface {
+	BeginTx(ctx context.Context, opts pgx.TxOptions) (pgx.Tx, error)
+}
+
+// Config configures exports
+type Config struct {
+	BatchSize int           // rows fetched from the cursor at once
+	Timeout   time.Duration // maximum duration of an export, it holds a connection meanwhile
+}
+
+// Exporter opens exports
+type Exporter struct {
+	db  DB
+	cfg Config
+}
+
+// New creates an exporter reading from db
+func New(db DB, cfg Config) *Exporter {
+	return &Exporter{db: db, cfg: cfg}
+}
+
+// Cursor is an open export, it must be closed
+type Cursor struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	tx        pgx.Tx
+	entity    Entity
+	batchSize int
+}
+
+// Open declares the cursor of the rows of entity matching filter.
+// Errors of the query are returned here, before anything is written.
+func (e *Exporter) Open(ctx context.Context, entity Entity, filter Filter) (*Cursor, error) {
+	ctx, cancel := context.WithTimeout(ctx, e.cfg.Timeout)
+	tx, err := e.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to begin export transaction: %w", err)
+	}
+	if _, err := tx.Exec(ctx, "DECLARE export NO SCROLL CURSOR FOR "+entity.query, entity.args(filter)...); err != nil {
+		_ = tx.Rollback(context.WithoutCancel(ctx))
+		cancel()
+		return nil, fmt.Errorf("failed to declare export cursor: %w", err)
+	}
+	return &Cursor{ctx: ctx, cancel: cancel, tx: tx, entity: entity, batchSize: e.cfg.BatchSize}, nil
+}
+
+// Copy writes all rows to w in format and returns the number of rows.
+// Each fetched batch is flushed to w if it has a Flush method, e.g. a bufio.Writer.
+func (c *Cursor) Copy(w io.Writer, format Format) (int64, error) {
+	out := format.newWriter(w, c.entity)
+	flusher, _ := w.(interface{ Flush() error })
+	fetch := fmt.Sprintf("FETCH %d FROM export", c.batchSize)
+	var total int64
+	for {
+		n, err := c.fetch(fetch, out)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+		if err := out.Flush(); err != nil {
+			return total, fmt.Errorf("failed to write export: %w", err)
+		}
+		if flusher != nil {
+			if err := flusher.Flush(); err != nil {
+				return total, fmt.Errorf("failed to write export: %w", err)
+			}
+		}
+		if n < c.batchSize {
+			break
+		}
+	}
+	if err := out.Close(); err != nil {
+		return total, fmt.Errorf("failed to write export: %w", err)
+	}
+	return total, nil
+}
+
+// fetch writes the next batch of rows and returns its size
+func (c *Cursor) fetch(sql string, out rowWriter) (int, error) {
+	rows, err := c.tx.Query(c.ctx, sql)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch export rows: %w", err)
+	}
+	defer rows.Close()
+	n := 0
+	for rows.Next() {
+		values, err := rows.Values()
+		if err != nil {
+			return n, fmt.Errorf("failed to read export row: %w", err)
+		}
+		if err := out.Write(values); err != nil {
+			return n, fmt.Errorf("failed to write export: %w", err)
+		}
+		n++
+	}
+	if err := rows.Err(); err != nil {
+		return n, fmt.Errorf("failed to fetch export rows: %w", err)
+	}
+	return n, nil
+}
+
+// Close ends the transaction of the cursor
+func (c *Cursor) Close() error {
+	defer c.cancel()
+	return c.tx.Rollback(context.WithoutCancel(c.ctx))
+}
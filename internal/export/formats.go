@@ -0,0 +1,211 @@
+package export
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+
+	"ris/pkg/parquet"
+)
+
+// Format is an export file format
+type Format struct {
+	Name        string // also the file extension
+	ContentType string
+
+	newWriter func(w io.Writer, e Entity) rowWriter
+}
+
+// rowWriter writes the rows of an entity in a format
+type rowWriter interface {
+	Write(row []any) error
+	// Flush passes buffered rows to the underlying writer, formats with a footer may keep them
+	Flush() error
+	Close() error
+}
+
+// Formats are the supported export formats, CSV is the default
+var Formats = []Format{
+	{Name: "csv", ContentType: "text/csv", newWriter: newCSVWriter},
+	{Name: "ndjson", ContentType: "application/x-ndjson", newWriter: newNDJSONWriter},
+	{Name: "parquet", ContentType: "application/vnd.apache.parquet", newWriter: newParquetWriter},
+	{Name: "xlsx", ContentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", newWriter: newXLSXWriter},
+}
+
+// LookupFormat returns the format with the name
+func LookupFormat(name string) (Format, bool) {
+	for _, f := range Formats {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+// FormatByContentType returns the format with the content type
+func FormatByContentType(contentType string) (Format, bool) {
+	for _, f := range Formats {
+		if f.ContentType == contentType {
+			return f, true
+		}
+	}
+	return Format{}, false
+}
+
+// ContentTypes returns the content types of the formats in the order of Formats
+func ContentTypes() []string {
+	types := make([]string, len(Formats))
+	for i, f := range Formats {
+		types[i] = f.ContentType
+	}
+	return types
+}
+
+// csvWriter writes a header line followed by a line per row, nulls are empty fields
+type csvWriter struct {
+	w      *csv.Writer
+	fields []string
+}
+
+func newCSVWriter(w io.Writer, e Entity) rowWriter {
+	cw := &csvWriter{w: csv.NewWriter(w), fields: make([]string, len(e.Columns))}
+	for i, col := range e.Columns {
+		cw.fields[i] = col.Name
+	}
+	_ = cw.w.Write(cw.fields) // errors are reported by Flush
+	return cw
+}
+
+func (w *csvWriter) Write(row []any) error {
+	for i, v := range row {
+		switch v := v.(type) {
+		case nil:
+			w.fields[i] = ""
+		case string:
+			w.fields[i] = v
+		case int32:
+			w.fields[i] = strconv.FormatInt(int64(v), 10)
+		case int64:
+			w.fields[i] = strconv.FormatInt(v, 10)
+		case time.Time:
+			w.fields[i] = v.Format(time.RFC3339)
+		default:
+			w.fields[i] = fmt.Sprint(v)
+		}
+	}
+	return w.w.Write(w.fields)
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+func (w *csvWriter) Close() error { return w.Flush() }
+
+// ndjsonWriter writes a JSON object per line with the fields in column order
+type ndjsonWriter struct {
+	w    *bufio.Writer
+	keys [][]byte // JSON encoded column names
+	line []byte
+}
+
+func newNDJSONWriter(w io.Writer, e Entity) rowWriter {
+	nw := &ndjsonWriter{w: bufio.NewWriter(w), keys: make([][]byte, len(e.Columns))}
+	for i, col := range e.Columns {
+		nw.keys[i], _ = json.Marshal(col.Name)
+	}
+	return nw
+}
+
+func (w *ndjsonWriter) Write(row []any) error {
+	w.line = append(w.line[:0], '{')
+	for i, v := range row {
+		if i > 0 {
+			w.line = append(w.line, ',')
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", w.keys[i], err)
+		}
+		w.line = append(w.line, w.keys[i]...)
+		w.line = append(w.line, ':')
+		w.line = append(w.line, value...)
+	}
+	w.line = append(w.line, '}', '\n')
+	_, err := w.w.Write(w.line)
+	return err
+}
+
+func (w *ndjsonWriter) Flush() error { return w.w.Flush() }
+
+func (w *ndjsonWriter) Close() error { return w.Flush() }
+
+// parquetWriter writes row groups as they fill up and the footer on Close
+type parquetWriter struct {
+	*parquet.Writer
+}
+
+func newParquetWriter(w io.Writer, e Entity) rowWriter {
+	return parquetWriter{parquet.NewWriter(w, e.Columns, parquet.DefaultRowGroupSize)}
+}
+
+func (parquetWriter) Flush() error { return nil }
+
+// xlsxWriter writes a sheet named after the entity. The workbook is a zip archive
+// written on Close, meanwhile excelize keeps large sheets in a temporary file.
+type xlsxWriter struct {
+	w    io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+	err  error
+}
+
+func newXLSXWriter(w io.Writer, e Entity) rowWriter {
+	xw := &xlsxWriter{w: w, file: excelize.NewFile()}
+	sheet := xw.file.GetSheetName(0)
+	if xw.err = xw.file.SetSheetName(sheet, e.Name); xw.err != nil {
+		return xw
+	}
+	if xw.sw, xw.err = xw.file.NewStreamWriter(e.Name); xw.err != nil {
+		return xw
+	}
+	header := make([]any, len(e.Columns))
+	for i, col := range e.Columns {
+		header[i] = col.Name
+	}
+	xw.err = xw.Write(header)
+	return xw
+}
+
+func (w *xlsxWriter) Write(row []any) error {
+	if w.err != nil {
+		return w.err
+	}
+	w.row++
+	cell, err := excelize.CoordinatesToCellName(1, w.row)
+	if err != nil {
+		return err
+	}
+	return w.sw.SetRow(cell, row)
+}
+
+func (w *xlsxWriter) Flush() error { return w.err }
+
+func (w *xlsxWriter) Close() error {
+	defer w.file.Close()
+	if w.err != nil {
+		return w.err
+	}
+	if err := w.sw.Flush(); err != nil {
+		return err
+	}
+	return w.file.Write(w.w)
+}
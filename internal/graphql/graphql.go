@@ -0,0 +1,159 @@
+// Package graphql serves the Nobel dataset over GraphQL, see schema.graphql.
+//
+// Nested fields (laureates of prizes, prizes of laureates, categories of prizes) are loaded by
+// per-request loaders, so a query costs one database round trip per level of nesting instead of
+// one per item. Mutations go through the API service, so they publish the same events as REST.
+package graphql
+
+import (
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	gql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/trace/otel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	v1 "ris/internal/app/api/v1"
+	"ris/pkg/postgres/queries"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// Service is the subset of the API service used for stats and mutations
+type Service interface {
+	GetStats(ctx context.Context) (*v1.StatsResponse, error)
+	GetLastUpdate(ctx context.Context) (*v1.LastUpdateResponse, error)
+	CreateLaureate(ctx context.Context, req *v1.CreateLaureateRequest) (*v1.LaureateResponse, error)
+	UpdateLaureate(ctx context.Context, id int32, req *v1.UpdateLaureateRequest) (*v1.LaureateResponse, error)
+	DeleteLaureate(ctx context.Context, id int32) error
+	CreatePrize(ctx context.Context, req *v1.CreatePrizeRequest) (*v1.PrizeResponse, error)
+	UpdatePrize(ctx context.Context, id int32, req *v1.UpdatePrizeRequest) (*v1.PrizeResponse, error)
+	DeletePrize(ctx context.Context, id int32) error
+	LinkLaureate(ctx context.Context, prizeID, laureateID int32) (*v1.PrizeResponse, error)
+	UnlinkLaureate(ctx context.Context, prizeID, laureateID int32) (*v1.PrizeResponse, error)
+}
+
+// Config configures the GraphQL server
+type Config struct {
+	MaxDepth  int           // maximum nesting depth of a query
+	BatchWait time.Duration // how long loaders collect keys before fetching them
+}
+
+// Server executes GraphQL requests
+type Server struct {
+	schema    *gql.Schema
+	queries   *queries.Queries
+	batchWait time.Duration
+}
+
+// New parses the schema and creates a server reading the dataset with q
+func New(service Service, q *queries.Queries, cfg Config) (*Server, error) {
+	schema, err := gql.ParseSchema(schemaSDL, newRoot(service),
+		gql.UseStringDescriptions(),
+		gql.MaxDepth(cfg.MaxDepth),
+		gql.Tracer(otel.DefaultTracer()),
+		gql.Logger(panicLogger{}))
+	if err != nil {
+		return nil, err
+	}
+	return &Server{schema: schema, queries: q, batchWait: cfg.BatchWait}, nil
+}
+
+// request is a GraphQL request, sent as a JSON body or as query parameters of GET requests
+type request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Handler serves GraphQL over HTTP. GET requests are read only, mutations require POST.
+// Responses are 200 with errors in the body, like most GraphQL servers.
+func (s *Server) Handler(c *fiber.Ctx) error {
+	var req request
+	switch c.Method() {
+	case fiber.MethodGet:
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if vars := c.Query("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				return badRequest(c, "Invalid variables")
+			}
+		}
+	default:
+		if err := json.Unmarshal(c.Body(), &req); err != nil {
+			return badRequest(c, "Invalid request body")
+		}
+	}
+	if req.Query == "" {
+		return badRequest(c, "Query is required")
+	}
+
+	ctx := withLoaders(c.UserContext(), newLoaders(c.UserContext(), s.queries, s.batchWait))
+	if c.Method() == fiber.MethodGet {
+		ctx = context.WithValue(ctx, readOnlyKey{}, true)
+	}
+	return c.JSON(s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables))
+}
+
+func badRequest(c *fiber.Ctx, message string) error {
+	return c.Status(fiber.StatusBadRequest).JSON(v1.ErrorResponse{
+		Error:   "Bad Request",
+		Message: message,
+	})
+}
+
+// readOnlyKey marks requests which must not run mutations
+type readOnlyKey struct{}
+
+// Error is a GraphQL error with a code in its extensions
+type Error struct {
+	Message string
+	Code    string
+}
+
+// Error codes
+const (
+	CodeBadUserInput = "BAD_USER_INPUT"
+	CodeNotFound     = "NOT_FOUND"
+	CodeForbidden    = "FORBIDDEN"
+	CodeInternal     = "INTERNAL"
+)
+
+func (e *Error) Error() string { return e.Message }
+
+// Extensions is added to the error in the response
+func (e *Error) Extensions() map[string]any {
+	return map[string]any{"code": e.Code}
+}
+
+// resolveError converts an error of the service or the database into an Error.
+// Unexpected errors are logged and hidden from clients.
+func resolveError(ctx context.Context, what string, err error) error {
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return &Error{Message: what + " not found", Code: CodeNotFound}
+	case errors.Is(err, v1.ErrUnknownCategory), errors.Is(err, v1.ErrCategoryNotEstablished):
+		return &Error{Message: err.Error(), Code: CodeBadUserInput}
+	case errors.As(err, &pgErr) && pgErr.Code == "23505":
+		return &Error{Message: what + " already exists", Code: CodeBadUserInput}
+	case errors.As(err, &pgErr) && pgErr.Code == "23503":
+		return &Error{Message: "referenced prize or laureate does not exist", Code: CodeBadUserInput}
+	}
+	slog.ErrorContext(ctx, "GraphQL resolver failed", "error", err)
+	return &Error{Message: "internal error", Code: CodeInternal}
+}
+
+// panicLogger logs panics of resolvers, they are returned to clients as errors
+type panicLogger struct{}
+
+func (panicLogger) LogPanic(ctx context.Context, value any) {
+	slog.ErrorContext(ctx, "GraphQL resolver panicked", "panic", value)
+}
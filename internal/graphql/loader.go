@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Loader batches and caches loads of values by key for a single request.
+//
+// Keys requested within the wait window after the first pending Load are fetched with one call.
+// Resolvers of lists also Hint the keys their items are going to load, so that a list is loaded
+// with one call even when its items are resolved one after another.
+type Loader[K comparable, V any] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, keys []K) (map[K]V, error)
+	wait  time.Duration
+
+	mu      sync.Mutex
+	results map[K]*batch[K, V]
+	pending *batch[K, V]
+}
+
+// batch is a set of keys fetched with one call
+type batch[K comparable, V any] struct {
+	keys      []K
+	scheduled bool
+	done      chan struct{}
+	values    map[K]V
+	err       error
+}
+
+// NewLoader creates a loader which fetches keys with fetch in the context ctx.
+// Keys missing from the map returned by fetch load the zero value.
+func NewLoader[K comparable, V any](ctx context.Context, wait time.Duration, fetch func(ctx context.Context, keys []K) (map[K]V, error)) *Loader[K, V] {
+	return &Loader[K, V]{ctx: ctx, fetch: fetch, wait: wait, results: make(map[K]*batch[K, V])}
+}
+
+// Load returns the value of key, waiting for the batch it is fetched with
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b := l.add(key)
+	if !b.scheduled {
+		b.scheduled = true
+		time.AfterFunc(l.wait, func() { l.dispatch(b) })
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-b.done:
+		return b.values[key], b.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// Hint adds keys to the next batch without waiting for it.
+// The batch is fetched after a Load, hinted keys which are never loaded cost nothing.
+func (l *Loader[K, V]) Hint(keys ...K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, k := range keys {
+		l.add(k)
+	}
+}
+
+// Clear drops all loaded values, e.g. after a mutation changed them
+func (l *Loader[K, V]) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	clear(l.results)
+	l.pending = nil
+}
+
+// add returns the batch of key, adding the key to the pending batch if it was not requested yet
+func (l *Loader[K, V]) add(key K) *batch[K, V] {
+	if b, ok := l.results[key]; ok {
+		return b
+	}
+	if l.pending == nil {
+		l.pending = &batch[K, V]{done: make(chan struct{})}
+	}
+	l.pending.keys = append(l.pending.keys, key)
+	l.results[key] = l.pending
+	return l.pending
+}
+
+// dispatch fetches the keys of b
+func (l *Loader[K, V]) dispatch(b *batch[K, V]) {
+	l.mu.Lock()
+	if l.pending == b {
+		l.pending = nil
+	}
+	l.mu.Unlock()
+
+	b.values, b.err = l.fetch(l.ctx, b.keys)
+	close(b.done)
+}
@@ -0,0 +1,124 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"ris/pkg/postgres/queries"
+)
+
+// loaders load the dataset for one request
+type loaders struct {
+	queries *queries.Queries
+
+	prizes         *Loader[int32, *queries.Prize]
+	laureates      *Loader[int32, *queries.Laureate]
+	prizeLaureates *Loader[int32, []queries.Laureate] // by prize id
+	laureatePrizes *Loader[int32, []queries.Prize]    // by laureate id
+	categories     *Loader[string, *queries.GetCategorySummariesRow]
+}
+
+// newLoaders creates the loaders of a request. Loaded prizes and laureates hint their ids
+// to the loaders of the next level, so that e.g. laureates → prizes → laureates takes three queries.
+func newLoaders(ctx context.Context, q *queries.Queries, wait time.Duration) *loaders {
+	l := &loaders{queries: q}
+	l.prizes = NewLoader(ctx, wait, func(ctx context.Context, ids []int32) (map[int32]*queries.Prize, error) {
+		rows, err := q.GetPrizesByIds(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load prizes: %w", err)
+		}
+		result := make(map[int32]*queries.Prize, len(rows))
+		for i := range rows {
+			result[rows[i].ID] = &rows[i]
+		}
+		l.hintPrizes(rows)
+		return result, nil
+	})
+	l.laureates = NewLoader(ctx, wait, func(ctx context.Context, ids []int32) (map[int32]*queries.Laureate, error) {
+		rows, err := q.GetLaureatesByIds(ctx, ids)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load laureates: %w", err)
+		}
+		result := make(map[int32]*queries.Laureate, len(rows))
+		for i := range rows {
+			result[rows[i].ID] = &rows[i]
+		}
+		l.hintLaureates(rows)
+		return result, nil
+	})
+	l.prizeLaureates = NewLoader(ctx, wait, func(ctx context.Context, prizeIDs []int32) (map[int32][]queries.Laureate, error) {
+		rows, err := q.GetLaureatesByPrizeIds(ctx, prizeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load laureates of prizes: %w", err)
+		}
+		result := make(map[int32][]queries.Laureate, len(prizeIDs))
+		laureates := make([]queries.Laureate, len(rows))
+		for i, r := range rows {
+			result[r.PrizeID] = append(result[r.PrizeID], r.Laureate)
+			laureates[i] = r.Laureate
+		}
+		l.hintLaureates(laureates)
+		return result, nil
+	})
+	l.laureatePrizes = NewLoader(ctx, wait, func(ctx context.Context, laureateIDs []int32) (map[int32][]queries.Prize, error) {
+		rows, err := q.GetPrizesByLaureateIds(ctx, laureateIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load prizes of laureates: %w", err)
+		}
+		result := make(map[int32][]queries.Prize, len(laureateIDs))
+		prizes := make([]queries.Prize, len(rows))
+		for i, r := range rows {
+			result[r.LaureateID] = append(result[r.LaureateID], r.Prize)
+			prizes[i] = r.Prize
+		}
+		l.hintPrizes(prizes)
+		return result, nil
+	})
+	l.categories = NewLoader(ctx, wait, func(ctx context.Context, slugs []string) (map[string]*queries.GetCategorySummariesRow, error) {
+		rows, err := q.GetCategorySummaries(ctx, slugs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load categories: %w", err)
+		}
+		result := make(map[string]*queries.GetCategorySummariesRow, len(rows))
+		for i := range rows {
+			result[rows[i].Slug] = &rows[i]
+		}
+		return result, nil
+	})
+	return l
+}
+
+// hintPrizes hints the keys of the fields of prizes
+func (l *loaders) hintPrizes(prizes []queries.Prize) {
+	for _, p := range prizes {
+		l.prizeLaureates.Hint(p.ID)
+		l.categories.Hint(p.Category)
+	}
+}
+
+// hintLaureates hints the keys of the fields of laureates
+func (l *loaders) hintLaureates(laureates []queries.Laureate) {
+	for _, la := range laureates {
+		l.laureatePrizes.Hint(la.ID)
+	}
+}
+
+// clear drops loaded values after a mutation
+func (l *loaders) clear() {
+	l.prizes.Clear()
+	l.laureates.Clear()
+	l.prizeLaureates.Clear()
+	l.laureatePrizes.Clear()
+	l.categories.Clear()
+}
+
+type loadersKey struct{}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
@@ -0,0 +1,123 @@
+package graphql
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// IsMutation reports whether the request runs a mutation, e.g. to charge it to the write budget
+// of the rate limiter. GET requests never do. A body which cannot be decoded is not a mutation,
+// Handler rejects it.
+func IsMutation(c *fiber.Ctx) bool {
+	if c.Method() == fiber.MethodGet {
+		return false
+	}
+	var req request
+	if err := json.Unmarshal(c.Body(), &req); err != nil {
+		return false
+	}
+	return selectsMutation(req.Query, req.OperationName)
+}
+
+// selectsMutation reports whether operationName is a mutation in the document,
+// or whether the document has any mutation if operationName is empty.
+// It only scans the top level of the document without validating it, validation is left to Exec.
+func selectsMutation(document, operationName string) bool {
+	var (
+		depth     int
+		opType    string // query, mutation, subscription or fragment
+		opName    string
+		namesNext bool // the next name is the name of the operation or fragment
+		skipName  bool // the next name is the name of a directive
+	)
+	for i := 0; i < len(document); {
+		ch := document[i]
+		switch {
+		case ch == '#':
+			for i < len(document) && document[i] != '\n' && document[i] != '\r' {
+				i++
+			}
+		case ch == '"':
+			i = skipString(document, i)
+		case isNameStart(ch):
+			start := i
+			for i < len(document) && isNameContinue(document[i]) {
+				i++
+			}
+			if depth > 0 {
+				continue
+			}
+			switch name := document[start:i]; {
+			case skipName:
+				skipName = false
+			case namesNext:
+				opName, namesNext = name, false
+			case opType == "fragment":
+				// The type condition of a fragment may be named like a keyword
+			case name == "query" || name == "mutation" || name == "subscription" || name == "fragment":
+				opType, opName, namesNext = name, "", true
+			}
+		case ch == '{':
+			// The selection set of a definition, an anonymous query when there is no keyword before it
+			if depth == 0 {
+				if opType == "mutation" && (operationName == "" || opName == operationName) {
+					return true
+				}
+				opType, namesNext = "", false
+			}
+			depth++
+			i++
+		case ch == '(' || ch == '[':
+			if depth == 0 {
+				// Variables of an anonymous operation
+				namesNext = false
+			}
+			depth++
+			i++
+		case ch == '}' || ch == ')' || ch == ']':
+			depth = max(depth-1, 0)
+			i++
+		default:
+			if ch == '@' && depth == 0 {
+				// A directive of an anonymous operation, its name is not a keyword
+				namesNext, skipName = false, true
+			}
+			i++
+		}
+	}
+	return false
+}
+
+// skipString returns the index after the string or block string starting at i
+func skipString(document string, i int) int {
+	if strings.HasPrefix(document[i:], `"""`) {
+		for j := i + 3; j < len(document); j++ {
+			switch {
+			case strings.HasPrefix(document[j:], `\"""`):
+				j += 3
+			case strings.HasPrefix(document[j:], `"""`):
+				return j + 3
+			}
+		}
+		return len(document)
+	}
+	for j := i + 1; j < len(document); j++ {
+		switch document[j] {
+		case '\\':
+			j++
+		case '"', '\n', '\r':
+			return j + 1
+		}
+	}
+	return len(document)
+}
+
+func isNameStart(ch byte) bool {
+	return ch == '_' || 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z'
+}
+
+func isNameContinue(ch byte) bool {
+	return isNameStart(ch) || '0' <= ch && ch <= '9'
+}
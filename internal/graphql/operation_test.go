@@ -0,0 +1,156 @@
+package graphql
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestSelectsMutation(t *testing.T) {
+	tests := []struct {
+		name          string
+		document      string
+		operationName string
+		want          bool
+	}{
+		{name: "anonymous query", document: `{ stats { prizesCount } }`},
+		{name: "named query", document: `query Stats { stats { prizesCount } }`},
+		{name: "anonymous mutation", document: `mutation { deletePrize(id: 1) }`, want: true},
+		{name: "named mutation", document: `mutation DeletePrize { deletePrize(id: 1) }`, want: true},
+		{name: "subscription", document: `subscription { events { type } }`},
+		{
+			name:     "mutation with variables and directives",
+			document: `mutation DeletePrize($id: Int! = 1) @log(level: "info") { deletePrize(id: $id) }`,
+			want:     true,
+		},
+		{
+			name:     "anonymous mutation with a directive",
+			document: `mutation @log { deletePrize(id: 1) }`,
+			want:     true,
+		},
+		{
+			name:     "query with a directive named mutation",
+			document: `query @mutation { stats { prizesCount } }`,
+		},
+		{
+			name:     "query with variables named like keywords",
+			document: `query Prizes($mutation: String = "mutation { x }") { prizes(category: $mutation) { id } }`,
+		},
+		{
+			name:     "query named mutation",
+			document: `query mutation { stats { prizesCount } }`,
+		},
+		{
+			name:     "fields named mutation",
+			document: `{ mutation: stats { mutation: prizesCount } }`,
+		},
+		{
+			name: "keywords in comments",
+			document: `# mutation { deletePrize(id: 1) }
+				query { stats { prizesCount } } # mutation`,
+		},
+		{
+			name:     "keywords in strings",
+			document: `query { laureates(name: "mutation { x }") { id } }`,
+		},
+		{
+			name: "keywords in block strings",
+			document: `query { laureates(name: """
+				\""" mutation { x }
+				""") { id } }`,
+		},
+		{
+			name:     "string with an escaped quote",
+			document: `query { laureates(name: "\" mutation { x }") { id } } mutation { deletePrize(id: 1) }`,
+			want:     true,
+		},
+		{
+			name: "mutation after fragments",
+			document: `fragment PrizeFields on Prize { id year }
+				fragment LaureateFields on Laureate { id firstname }
+				mutation CreatePrize { createPrize(input: {year: 2024, category: "peace"}) { ...PrizeFields } }`,
+			operationName: "CreatePrize",
+			want:          true,
+		},
+		{
+			name:     "fragment on a type named mutation",
+			document: `fragment F on mutation { id } query { stats { ...F } }`,
+		},
+		{
+			name:     "fragment named mutation",
+			document: `fragment mutation on Prize { id } query { prize(id: 1) { ...mutation } }`,
+		},
+		{
+			name:          "selected query of a document with a mutation",
+			document:      `query Stats { stats { prizesCount } } mutation DeletePrize { deletePrize(id: 1) }`,
+			operationName: "Stats",
+		},
+		{
+			name:          "selected mutation of a document with a query",
+			document:      `query Stats { stats { prizesCount } } mutation DeletePrize { deletePrize(id: 1) }`,
+			operationName: "DeletePrize",
+			want:          true,
+		},
+		{
+			name:     "any mutation without an operation name",
+			document: `query Stats { stats { prizesCount } } mutation DeletePrize { deletePrize(id: 1) }`,
+			want:     true,
+		},
+		{
+			name:          "unknown operation name",
+			document:      `mutation DeletePrize { deletePrize(id: 1) }`,
+			operationName: "Other",
+		},
+		{name: "empty document", document: ``},
+		{name: "unterminated string in a mutation", document: `mutation { deletePrize(name: "x`, want: true},
+		{name: "unterminated string before a mutation", document: `query Q($a: String = "x mutation { deletePrize(id: 1) }`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := selectsMutation(tt.document, tt.operationName); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMutation(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		target string
+		body   string
+		want   bool
+	}{
+		{name: "mutation", method: fiber.MethodPost, body: `{"query": "mutation { deletePrize(id: 1) }"}`, want: true},
+		{name: "query", method: fiber.MethodPost, body: `{"query": "{ stats { prizesCount } }"}`},
+		{
+			name:   "operation name",
+			method: fiber.MethodPost,
+			body:   `{"query": "query A { stats { prizesCount } } mutation B { deletePrize(id: 1) }", "operationName": "B"}`,
+			want:   true,
+		},
+		{name: "invalid body", method: fiber.MethodPost, body: `mutation { deletePrize(id: 1) }`},
+		{name: "get", method: fiber.MethodGet, target: "?query=mutation%7BdeletePrize(id:1)%7D"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got bool
+			app := fiber.New()
+			app.All("/graphql", func(c *fiber.Ctx) error {
+				got = IsMutation(c)
+				return nil
+			})
+			req := httptest.NewRequest(tt.method, "/graphql"+tt.target, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", "application/json")
+			if _, err := app.Test(req); err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
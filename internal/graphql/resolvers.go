@@ -0,0 +1,403 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v5/pgtype"
+
+	v1 "ris/internal/app/api/v1"
+	"ris/pkg/postgres/queries"
+)
+
+// root resolves the fields of Query and Mutation
+type root struct {
+	service   Service
+	validator *validator.Validate
+}
+
+func newRoot(service Service) *root {
+	return &root{
+		service:   service,
+		validator: validator.New(validator.WithRequiredStructEnabled()),
+	}
+}
+
+// Queries
+
+type prizeFilter struct {
+	Category *string
+	Year     *int32
+	YearFrom *int32
+	YearTo   *int32
+}
+
+func (r *root) Prizes(ctx context.Context, args struct {
+	Filter  *prizeFilter
+	Page    int32
+	PerPage int32
+}) (*prizePageResolver, error) {
+	var f prizeFilter
+	if args.Filter != nil {
+		f = *args.Filter
+	}
+	return listPrizes(ctx, f, newPagination(args.Page, args.PerPage))
+}
+
+func (r *root) Prize(ctx context.Context, args struct{ ID int32 }) (*prizeResolver, error) {
+	p, err := loadersFrom(ctx).prizes.Load(ctx, args.ID)
+	if err != nil {
+		return nil, resolveError(ctx, "prize", err)
+	}
+	if p == nil {
+		return nil, nil
+	}
+	return &prizeResolver{p: *p}, nil
+}
+
+func (r *root) Laureates(ctx context.Context, args struct {
+	Filter *struct {
+		Name  *string
+		Share *int32
+	}
+	Page    int32
+	PerPage int32
+}) (*laureatePageResolver, error) {
+	var params queries.CountLaureatesFilteredParams
+	if f := args.Filter; f != nil {
+		if f.Name != nil && *f.Name != "" {
+			params.Name = pgtype.Text{String: likeEscaper.Replace(*f.Name), Valid: true}
+		}
+		params.Share = optionalInt(f.Share)
+	}
+	q := loadersFrom(ctx).queries
+	page := newPagination(args.Page, args.PerPage)
+	rows, err := q.ListLaureatesFiltered(ctx, queries.ListLaureatesFilteredParams{
+		Name:       params.Name,
+		Share:      params.Share,
+		PageLimit:  page.perPage,
+		PageOffset: page.offset(),
+	})
+	if err != nil {
+		return nil, resolveError(ctx, "laureates", fmt.Errorf("failed to list laureates: %w", err))
+	}
+	loadersFrom(ctx).hintLaureates(rows)
+	return &laureatePageResolver{
+		items: rows,
+		count: func(ctx context.Context) (int64, error) {
+			total, err := q.CountLaureatesFiltered(ctx, params)
+			if err != nil {
+				return 0, fmt.Errorf("failed to count laureates: %w", err)
+			}
+			return total, nil
+		},
+		page: page,
+	}, nil
+}
+
+func (r *root) Laureate(ctx context.Context, args struct{ ID int32 }) (*laureateResolver, error) {
+	l, err := loadersFrom(ctx).laureates.Load(ctx, args.ID)
+	if err != nil {
+		return nil, resolveError(ctx, "laureate", err)
+	}
+	if l == nil {
+		return nil, nil
+	}
+	return &laureateResolver{l: *l}, nil
+}
+
+func (r *root) Categories(ctx context.Context) ([]*categoryResolver, error) {
+	rows, err := loadersFrom(ctx).queries.GetCategorySummaries(ctx, nil)
+	if err != nil {
+		return nil, resolveError(ctx, "categories", fmt.Errorf("failed to get categories: %w", err))
+	}
+	result := make([]*categoryResolver, len(rows))
+	for i, c := range rows {
+		result[i] = &categoryResolver{c: c}
+	}
+	return result, nil
+}
+
+func (r *root) Category(ctx context.Context, args struct{ Slug string }) (*categoryResolver, error) {
+	c, err := loadersFrom(ctx).categories.Load(ctx, args.Slug)
+	if err != nil {
+		return nil, resolveError(ctx, "category", err)
+	}
+	if c == nil {
+		return nil, nil
+	}
+	return &categoryResolver{c: *c}, nil
+}
+
+func (r *root) Stats(ctx context.Context) (*statsResolver, error) {
+	stats, err := r.service.GetStats(ctx)
+	if err != nil {
+		return nil, resolveError(ctx, "stats", err)
+	}
+	return &statsResolver{service: r.service, stats: *stats}, nil
+}
+
+// listPrizes returns a page of prizes matching f
+func listPrizes(ctx context.Context, f prizeFilter, page pagination) (*prizePageResolver, error) {
+	q := loadersFrom(ctx).queries
+	params := queries.CountPrizesFilteredParams{
+		YearFrom: optionalInt(f.YearFrom),
+		YearTo:   optionalInt(f.YearTo),
+	}
+	if f.Category != nil {
+		params.Category = pgtype.Text{String: *f.Category, Valid: true}
+	}
+	if f.Year != nil {
+		params.YearFrom = optionalInt(f.Year)
+		params.YearTo = optionalInt(f.Year)
+	}
+	rows, err := q.ListPrizesFiltered(ctx, queries.ListPrizesFilteredParams{
+		Category:   params.Category,
+		YearFrom:   params.YearFrom,
+		YearTo:     params.YearTo,
+		PageLimit:  page.perPage,
+		PageOffset: page.offset(),
+	})
+	if err != nil {
+		return nil, resolveError(ctx, "prizes", fmt.Errorf("failed to list prizes: %w", err))
+	}
+	loadersFrom(ctx).hintPrizes(rows)
+	return &prizePageResolver{
+		items: rows,
+		count: func(ctx context.Context) (int64, error) {
+			total, err := q.CountPrizesFiltered(ctx, params)
+			if err != nil {
+				return 0, fmt.Errorf("failed to count prizes: %w", err)
+			}
+			return total, nil
+		},
+		page: page,
+	}, nil
+}
+
+// Mutations
+
+func (r *root) CreateLaureate(ctx context.Context, args struct {
+	Input struct {
+		ID         int32
+		Firstname  string
+		Surname    *string
+		Motivation string
+		Share      int32
+	}
+}) (*laureateResolver, error) {
+	in := args.Input
+	req := &v1.CreateLaureateRequest{
+		ID:         in.ID,
+		Firstname:  in.Firstname,
+		Surname:    deref(in.Surname),
+		Motivation: in.Motivation,
+		Share:      in.Share,
+	}
+	if err := r.mutation(ctx, req); err != nil {
+		return nil, err
+	}
+	resp, err := r.service.CreateLaureate(ctx, req)
+	if err != nil {
+		return nil, resolveError(ctx, "laureate", err)
+	}
+	return r.changedLaureate(ctx, resp.ID)
+}
+
+func (r *root) UpdateLaureate(ctx context.Context, args struct {
+	ID    int32
+	Input struct {
+		Firstname  string
+		Surname    *string
+		Motivation string
+		Share      int32
+	}
+}) (*laureateResolver, error) {
+	in := args.Input
+	req := &v1.UpdateLaureateRequest{
+		Firstname:  in.Firstname,
+		Surname:    deref(in.Surname),
+		Motivation: in.Motivation,
+		Share:      in.Share,
+	}
+	if err := r.mutation(ctx, req); err != nil {
+		return nil, err
+	}
+	if _, err := r.service.UpdateLaureate(ctx, args.ID, req); err != nil {
+		return nil, resolveError(ctx, "laureate", err)
+	}
+	return r.changedLaureate(ctx, args.ID)
+}
+
+func (r *root) DeleteLaureate(ctx context.Context, args struct{ ID int32 }) (bool, error) {
+	if err := r.mutation(ctx, nil); err != nil {
+		return false, err
+	}
+	if err := r.service.DeleteLaureate(ctx, args.ID); err != nil {
+		return false, resolveError(ctx, "laureate", err)
+	}
+	loadersFrom(ctx).clear()
+	return true, nil
+}
+
+func (r *root) CreatePrize(ctx context.Context, args struct {
+	Input struct {
+		Year        int32
+		Category    string
+		LaureateIDs *[]int32
+	}
+}) (*prizeResolver, error) {
+	req := &v1.CreatePrizeRequest{
+		Year:        args.Input.Year,
+		Category:    args.Input.Category,
+		LaureateIDs: deref(args.Input.LaureateIDs),
+	}
+	if err := r.mutation(ctx, req); err != nil {
+		return nil, err
+	}
+	resp, err := r.service.CreatePrize(ctx, req)
+	if err != nil {
+		return nil, resolveError(ctx, "prize", err)
+	}
+	return r.changedPrize(ctx, resp.ID)
+}
+
+func (r *root) UpdatePrize(ctx context.Context, args struct {
+	ID    int32
+	Input struct {
+		Year     int32
+		Category string
+	}
+}) (*prizeResolver, error) {
+	req := &v1.UpdatePrizeRequest{Year: args.Input.Year, Category: args.Input.Category}
+	if err := r.mutation(ctx, req); err != nil {
+		return nil, err
+	}
+	if _, err := r.service.UpdatePrize(ctx, args.ID, req); err != nil {
+		return nil, resolveError(ctx, "prize", err)
+	}
+	return r.changedPrize(ctx, args.ID)
+}
+
+func (r *root) DeletePrize(ctx context.Context, args struct{ ID int32 }) (bool, error) {
+	if err := r.mutation(ctx, nil); err != nil {
+		return false, err
+	}
+	if err := r.service.DeletePrize(ctx, args.ID); err != nil {
+		return false, resolveError(ctx, "prize", err)
+	}
+	loadersFrom(ctx).clear()
+	return true, nil
+}
+
+type linkArgs struct {
+	PrizeID    int32
+	LaureateID int32
+}
+
+func (r *root) LinkLaureate(ctx context.Context, args linkArgs) (*prizeResolver, error) {
+	if err := r.mutation(ctx, nil); err != nil {
+		return nil, err
+	}
+	if _, err := r.service.LinkLaureate(ctx, args.PrizeID, args.LaureateID); err != nil {
+		return nil, resolveError(ctx, "prize", err)
+	}
+	return r.changedPrize(ctx, args.PrizeID)
+}
+
+func (r *root) UnlinkLaureate(ctx context.Context, args linkArgs) (*prizeResolver, error) {
+	if err := r.mutation(ctx, nil); err != nil {
+		return nil, err
+	}
+	if _, err := r.service.UnlinkLaureate(ctx, args.PrizeID, args.LaureateID); err != nil {
+		return nil, resolveError(ctx, "prize", err)
+	}
+	return r.changedPrize(ctx, args.PrizeID)
+}
+
+// mutation checks that the request may run mutations and validates the request of the service, if any
+func (r *root) mutation(ctx context.Context, req any) error {
+	if readOnly, _ := ctx.Value(readOnlyKey{}).(bool); readOnly {
+		return &Error{Message: "mutations require a POST request", Code: CodeForbidden}
+	}
+	if req == nil {
+		return nil
+	}
+	if err := r.validator.Struct(req); err != nil {
+		return &Error{Message: err.Error(), Code: CodeBadUserInput}
+	}
+	return nil
+}
+
+// changedLaureate reads a laureate back after a mutation, so that its fields resolve like in queries
+func (r *root) changedLaureate(ctx context.Context, id int32) (*laureateResolver, error) {
+	loadersFrom(ctx).clear()
+	l, err := loadersFrom(ctx).laureates.Load(ctx, id)
+	if err != nil {
+		return nil, resolveError(ctx, "laureate", err)
+	}
+	if l == nil {
+		return nil, &Error{Message: "laureate not found", Code: CodeNotFound}
+	}
+	return &laureateResolver{l: *l}, nil
+}
+
+// changedPrize reads a prize back after a mutation, so that its fields resolve like in queries
+func (r *root) changedPrize(ctx context.Context, id int32) (*prizeResolver, error) {
+	loadersFrom(ctx).clear()
+	p, err := loadersFrom(ctx).prizes.Load(ctx, id)
+	if err != nil {
+		return nil, resolveError(ctx, "prize", err)
+	}
+	if p == nil {
+		return nil, &Error{Message: "prize not found", Code: CodeNotFound}
+	}
+	return &prizeResolver{p: *p}, nil
+}
+
+// Helpers
+
+// pagination is a page of a list, limited like the REST lists
+type pagination struct {
+	page, perPage int32
+}
+
+func newPagination(page, perPage int32) pagination {
+	if perPage < 1 {
+		perPage = 10
+	}
+	perPage = min(perPage, 100)
+	// The offset must fit into int32
+	page = min(max(page, 1), math.MaxInt32/perPage)
+	return pagination{page: page, perPage: perPage}
+}
+
+func (p pagination) offset() int32 {
+	return (p.page - 1) * p.perPage
+}
+
+// likeEscaper escapes wildcards of ILIKE patterns
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func optionalInt(v *int32) pgtype.Int4 {
+	if v == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *v, Valid: true}
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
+// totalPages returns the number of pages of total items
+func totalPages(total int64, perPage int32) int32 {
+	return int32(math.Ceil(float64(total) / float64(perPage)))
+}
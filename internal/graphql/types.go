@@ -0,0 +1,214 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	v1 "ris/internal/app/api/v1"
+	"ris/pkg/postgres/queries"
+)
+
+type prizeResolver struct {
+	p queries.Prize
+}
+
+func (r *prizeResolver) ID() int32   { return r.p.ID }
+func (r *prizeResolver) Year() int32 { return r.p.Year }
+
+func (r *prizeResolver) Category(ctx context.Context) (*categoryResolver, error) {
+	c, err := loadersFrom(ctx).categories.Load(ctx, r.p.Category)
+	if err != nil {
+		return nil, resolveError(ctx, "category", err)
+	}
+	if c == nil {
+		// The prize was deleted meanwhile
+		return &categoryResolver{c: queries.GetCategorySummariesRow{Slug: r.p.Category, Name: r.p.Category}}, nil
+	}
+	return &categoryResolver{c: *c}, nil
+}
+
+func (r *prizeResolver) Laureates(ctx context.Context) ([]*laureateResolver, error) {
+	laureates, err := loadersFrom(ctx).prizeLaureates.Load(ctx, r.p.ID)
+	if err != nil {
+		return nil, resolveError(ctx, "laureates", err)
+	}
+	result := make([]*laureateResolver, len(laureates))
+	for i, l := range laureates {
+		result[i] = &laureateResolver{l: l}
+	}
+	return result, nil
+}
+
+func (r *prizeResolver) UpdatedAt() *string { return formatTime(r.p.UpdatedAt) }
+
+type laureateResolver struct {
+	l queries.Laureate
+}
+
+func (r *laureateResolver) ID() int32          { return r.l.ID }
+func (r *laureateResolver) Firstname() string  { return r.l.Firstname }
+func (r *laureateResolver) Motivation() string { return r.l.Motivation }
+func (r *laureateResolver) Share() int32       { return r.l.Share }
+
+func (r *laureateResolver) Surname() *string {
+	if !r.l.Surname.Valid {
+		return nil
+	}
+	return &r.l.Surname.String
+}
+
+func (r *laureateResolver) Prizes(ctx context.Context) ([]*prizeResolver, error) {
+	prizes, err := loadersFrom(ctx).laureatePrizes.Load(ctx, r.l.ID)
+	if err != nil {
+		return nil, resolveError(ctx, "prizes", err)
+	}
+	result := make([]*prizeResolver, len(prizes))
+	for i, p := range prizes {
+		result[i] = &prizeResolver{p: p}
+	}
+	return result, nil
+}
+
+func (r *laureateResolver) UpdatedAt() *string { return formatTime(r.l.UpdatedAt) }
+
+type categoryResolver struct {
+	c queries.GetCategorySummariesRow
+}
+
+func (r *categoryResolver) Slug() string           { return r.c.Slug }
+func (r *categoryResolver) Name() string           { return r.c.Name }
+func (r *categoryResolver) EstablishedYear() int32 { return r.c.EstablishedYear }
+func (r *categoryResolver) PrizesCount() int32     { return int32(r.c.PrizesCount) }
+func (r *categoryResolver) LaureatesCount() int32  { return int32(r.c.LaureatesCount) }
+
+func (r *categoryResolver) FirstYear() *int32 {
+	if r.c.PrizesCount == 0 {
+		return nil
+	}
+	return &r.c.FirstYear
+}
+
+func (r *categoryResolver) LastYear() *int32 {
+	if r.c.PrizesCount == 0 {
+		return nil
+	}
+	return &r.c.LastYear
+}
+
+func (r *categoryResolver) LocalizedNames() []*localizedNameResolver {
+	names := map[string]string{}
+	_ = json.Unmarshal(r.c.LocalizedNames, &names)
+	result := make([]*localizedNameResolver, 0, len(names))
+	for _, lang := range slices.Sorted(maps.Keys(names)) {
+		result = append(result, &localizedNameResolver{language: lang, name: names[lang]})
+	}
+	return result
+}
+
+func (r *categoryResolver) Prizes(ctx context.Context, args struct {
+	Filter *struct {
+		Year     *int32
+		YearFrom *int32
+		YearTo   *int32
+	}
+	Page    int32
+	PerPage int32
+}) (*prizePageResolver, error) {
+	f := prizeFilter{Category: &r.c.Slug}
+	if args.Filter != nil {
+		f.Year, f.YearFrom, f.YearTo = args.Filter.Year, args.Filter.YearFrom, args.Filter.YearTo
+	}
+	return listPrizes(ctx, f, newPagination(args.Page, args.PerPage))
+}
+
+type localizedNameResolver struct {
+	language, name string
+}
+
+func (r *localizedNameResolver) Language() string { return r.language }
+func (r *localizedNameResolver) Name() string     { return r.name }
+
+type statsResolver struct {
+	service Service
+	stats   v1.StatsResponse
+}
+
+func (r *statsResolver) LaureatesCount() int32  { return int32(r.stats.LaureatesCount) }
+func (r *statsResolver) PrizesCount() int32     { return int32(r.stats.PrizesCount) }
+func (r *statsResolver) CategoriesCount() int32 { return int32(r.stats.CategoriesCount) }
+
+func (r *statsResolver) LastUpdate(ctx context.Context) (string, error) {
+	resp, err := r.service.GetLastUpdate(ctx)
+	if err != nil {
+		return "", resolveError(ctx, "last update", err)
+	}
+	return resp.LastUpdate.Format(time.RFC3339), nil
+}
+
+type prizePageResolver struct {
+	items []queries.Prize
+	count func(ctx context.Context) (int64, error)
+	page  pagination
+}
+
+func (r *prizePageResolver) Items() []*prizeResolver {
+	result := make([]*prizeResolver, len(r.items))
+	for i, p := range r.items {
+		result[i] = &prizeResolver{p: p}
+	}
+	return result
+}
+
+func (r *prizePageResolver) PageInfo(ctx context.Context) (*pageInfoResolver, error) {
+	return r.page.info(ctx, "prizes", r.count)
+}
+
+type laureatePageResolver struct {
+	items []queries.Laureate
+	count func(ctx context.Context) (int64, error)
+	page  pagination
+}
+
+func (r *laureatePageResolver) Items() []*laureateResolver {
+	result := make([]*laureateResolver, len(r.items))
+	for i, l := range r.items {
+		result[i] = &laureateResolver{l: l}
+	}
+	return result
+}
+
+func (r *laureatePageResolver) PageInfo(ctx context.Context) (*pageInfoResolver, error) {
+	return r.page.info(ctx, "laureates", r.count)
+}
+
+type pageInfoResolver struct {
+	total int64
+	page  pagination
+}
+
+func (r *pageInfoResolver) Total() int32      { return int32(r.total) }
+func (r *pageInfoResolver) Page() int32       { return r.page.page }
+func (r *pageInfoResolver) PerPage() int32    { return r.page.perPage }
+func (r *pageInfoResolver) TotalPages() int32 { return totalPages(r.total, r.page.perPage) }
+
+// info counts the items of a list only when the page info is requested
+func (p pagination) info(ctx context.Context, what string, count func(ctx context.Context) (int64, error)) (*pageInfoResolver, error) {
+	total, err := count(ctx)
+	if err != nil {
+		return nil, resolveError(ctx, what, err)
+	}
+	return &pageInfoResolver{total: total, page: p}, nil
+}
+
+func formatTime(t pgtype.Timestamp) *string {
+	if !t.Valid {
+		return nil
+	}
+	s := t.Time.Format(time.RFC3339)
+	return &s
+}
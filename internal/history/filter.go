@@ -0,0 +1,115 @@
+package history
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Operators of filters
+const (
+	OpEqual    = "="
+	OpNotEqual = "!="
+	OpContains = "~" // case-insensitive substring
+)
+
+// Filter is a condition on a payload field
+type Filter struct {
+	// Path is the dot-separated path of the field, array elements are selected by index,
+	// e.g. "category" or "laureates.0.surname"
+	Path  string
+	Op    string
+	Value string
+}
+
+// ParseFilter parses a filter like "category=physics", "share!=1" or "motivation~quantum"
+func ParseFilter(s string) (Filter, error) {
+	// The first operator separates the path, the value may contain operators
+	i := strings.IndexAny(s, "=!~")
+	if i <= 0 {
+		return Filter{}, fmt.Errorf("invalid filter %q: expected field=value, field!=value or field~value", s)
+	}
+	for _, op := range []string{OpNotEqual, OpEqual, OpContains} {
+		if strings.HasPrefix(s[i:], op) {
+			return Filter{Path: s[:i], Op: op, Value: s[i+len(op):]}, nil
+		}
+	}
+	return Filter{}, fmt.Errorf("invalid filter %q: expected field=value, field!=value or field~value", s)
+}
+
+func (f Filter) String() string {
+	return f.Path + f.Op + f.Value
+}
+
+// Match reports whether the field of the JSON payload satisfies the filter.
+// Fields which do not exist do not match = and ~ and match !=.
+func (f Filter) Match(payload json.RawMessage) bool {
+	value, ok := lookup(payload, f.Path)
+	switch f.Op {
+	case OpEqual:
+		return ok && value == f.Value
+	case OpNotEqual:
+		return !ok || value != f.Value
+	case OpContains:
+		return ok && strings.Contains(strings.ToLower(value), strings.ToLower(f.Value))
+	}
+	return false
+}
+
+// match reports whether the record matches all filters
+func match(record Record, filters []Filter) bool {
+	if len(filters) > 0 && record.Payload == nil {
+		return false
+	}
+	for _, f := range filters {
+		if !f.Match(record.Payload) {
+			return false
+		}
+	}
+	return true
+}
+
+// lookup returns the field of the JSON document at the path as text:
+// strings without quotes, other values as JSON
+func lookup(data json.RawMessage, path string) (string, bool) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return "", false
+	}
+
+	for key := range strings.SplitSeq(path, ".") {
+		switch v := value.(type) {
+		case map[string]any:
+			field, ok := v[key]
+			if !ok {
+				return "", false
+			}
+			value = field
+		case []any:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", false
+			}
+			value = v[i]
+		default:
+			return "", false
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return v.String(), true
+	default:
+		text, err := json.Marshal(v)
+		if err != nil {
+			return "", false
+		}
+		return string(text), true
+	}
+}
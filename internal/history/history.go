@@ -0,0 +1,151 @@
+// Package history reads stored events from the events stream,
+// e.g. to inspect what happened or to check what a subscriber has received.
+//
+// Events are read with an ordered consumer which is removed by the server once reading stops,
+// durable consumers of subscribers are not affected.
+package history
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"ris/internal/events"
+	"ris/internal/stream"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Query selects events. Zero values do not restrict the result.
+type Query struct {
+	Subject string    // subject, may contain wildcards
+	FromSeq uint64    // first stream sequence
+	ToSeq   uint64    // last stream sequence
+	Since   time.Time // events stored at or after
+	Until   time.Time // events stored at or before
+	Where   []Filter  // conditions on payload fields, all must match
+	Limit   int       // maximum number of events
+	// Follow waits for new events after the stored ones until ctx is done or the upper bound is reached
+	Follow bool
+}
+
+// Record is a stored event
+type Record struct {
+	Sequence uint64          `json:"seq"`
+	Subject  string          `json:"subject"`
+	Time     time.Time       `json:"time"`
+	Metadata events.Metadata `json:"metadata"`
+	Payload  json.RawMessage `json:"payload,omitempty"`
+	// Error is set when the message is not a valid event, Payload is empty then
+	Error string `json:"error,omitempty"`
+}
+
+// Read calls fn with the events matching q, ordered by sequence
+func Read(ctx context.Context, js nats.JetStreamContext, q Query, fn func(Record) error) error {
+	opts := []nats.SubOpt{nats.OrderedConsumer(), nats.BindStream(stream.Name)}
+	switch {
+	case q.FromSeq > 0:
+		opts = append(opts, nats.StartSequence(q.FromSeq))
+	case !q.Since.IsZero():
+		opts = append(opts, nats.StartTime(q.Since))
+	default:
+		opts = append(opts, nats.DeliverAll())
+	}
+	sub, err := js.SubscribeSync(q.Subject, opts...)
+	if err != nil {
+		return fmt.Errorf("could not read stream %s: %w", stream.Name, err)
+	}
+	defer sub.Unsubscribe()
+
+	if !q.Follow {
+		info, err := sub.ConsumerInfo()
+		if err != nil {
+			return fmt.Errorf("could not read stream %s: %w", stream.Name, err)
+		}
+		if info.NumPending+info.Delivered.Consumer == 0 {
+			return nil
+		}
+	}
+
+	count := 0
+	for {
+		msg, err := sub.NextMsgWithContext(ctx)
+		if err != nil {
+			if q.Follow && errors.Is(err, ctx.Err()) {
+				// Interrupted by the user
+				return nil
+			}
+			return fmt.Errorf("could not read stream %s: %w", stream.Name, err)
+		}
+		meta, err := msg.Metadata()
+		if err != nil {
+			return fmt.Errorf("could not get message metadata: %w", err)
+		}
+		if (q.ToSeq > 0 && meta.Sequence.Stream > q.ToSeq) || (!q.Until.IsZero() && meta.Timestamp.After(q.Until)) {
+			return nil
+		}
+
+		record := decode(msg, meta)
+		if meta.Sequence.Stream >= q.FromSeq && !record.Time.Before(q.Since) && match(record, q.Where) {
+			if err := fn(record); err != nil {
+				return err
+			}
+			count++
+			if q.Limit > 0 && count >= q.Limit {
+				return nil
+			}
+		}
+		if !q.Follow && meta.NumPending == 0 {
+			return nil
+		}
+	}
+}
+
+// decode returns the record of a stored message
+func decode(msg *nats.Msg, meta *nats.MsgMetadata) Record {
+	record := Record{
+		Sequence: meta.Sequence.Stream,
+		Subject:  msg.Subject,
+		Time:     meta.Timestamp,
+	}
+	var payload json.RawMessage
+	md, err := events.DecodeInto(msg.Subject, msg.Data, &payload)
+	if err != nil {
+		record.Error = err.Error()
+		return record
+	}
+	record.Metadata, record.Payload = md, payload
+	return record
+}
+
+// Info describes the events stream
+type Info struct {
+	Stream    *nats.StreamInfo
+	Consumers []*nats.ConsumerInfo
+	// DeadLetters is the number of events in the dead letter stream, -1 if it does not exist
+	DeadLetters int64
+}
+
+// Stat returns the state of the events stream with the number of events per subject and its consumers
+func Stat(ctx context.Context, js nats.JetStreamContext) (*Info, error) {
+	si, err := js.StreamInfo(stream.Name, &nats.StreamInfoRequest{SubjectsFilter: ">"}, nats.Context(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("could not get stream %s: %w", stream.Name, err)
+	}
+	info := &Info{Stream: si, DeadLetters: -1}
+	for ci := range js.Consumers(stream.Name, nats.Context(ctx)) {
+		info.Consumers = append(info.Consumers, ci)
+	}
+
+	dlq, err := js.StreamInfo(stream.DLQName, nats.Context(ctx))
+	switch {
+	case errors.Is(err, nats.ErrStreamNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("could not get stream %s: %w", stream.DLQName, err)
+	default:
+		info.DeadLetters = int64(dlq.State.Msgs)
+	}
+	return info, nil
+}
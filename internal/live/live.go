@@ -0,0 +1,238 @@
+// Package live forwards events from the events stream to connected clients, e.g. browsers
+// over Server-Sent Events or WebSocket.
+//
+// Every client reads the stream with its own ordered consumer, see internal/history. Events are
+// identified by their stream sequence, so a reconnecting client resumes after the last event it
+// has received. Events removed from the stream by its retention are not sent.
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"ris/internal/events"
+	"ris/internal/history"
+	"ris/internal/stream"
+
+	"github.com/nats-io/nats.go"
+)
+
+// ErrTooManyConnections is returned by Open when Config.MaxConnections streams are open
+var ErrTooManyConnections = errors.New("too many live connections")
+
+// Config configures the streamer
+type Config struct {
+	Heartbeat      time.Duration // a heartbeat is sent when no event was sent for this long
+	MaxConnections int           // maximum number of open streams, 0 for unlimited
+}
+
+// Event is an event sent to a client
+type Event struct {
+	Sequence uint64 // stream sequence, the id to resume after
+	Type     string
+	Data     json.RawMessage // event envelope
+}
+
+// Streamer opens streams of live events
+type Streamer struct {
+	js  nats.JetStreamContext
+	cfg Config
+
+	conns     atomic.Int64
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// New creates a streamer reading events with js
+func New(js nats.JetStreamContext, cfg Config) *Streamer {
+	return &Streamer{js: js, cfg: cfg, done: make(chan struct{})}
+}
+
+// Close ends all open streams, e.g. before the server shuts down, and rejects new ones
+func (s *Streamer) Close() {
+	s.closeOnce.Do(func() { close(s.done) })
+}
+
+// Stream is an open stream of events. Run sends them, Close releases the connection without sending.
+type Stream struct {
+	s      *Streamer
+	from   uint64
+	filter Filter
+	once   sync.Once
+}
+
+// Open opens a stream of events matching f stored after the stream sequence after,
+// 0 streams only new events
+func (s *Streamer) Open(after uint64, f Filter) (*Stream, error) {
+	select {
+	case <-s.done:
+		return nil, errors.New("live streams are closed")
+	default:
+	}
+	if n := s.conns.Add(1); s.cfg.MaxConnections > 0 && n > int64(s.cfg.MaxConnections) {
+		s.conns.Add(-1)
+		return nil, ErrTooManyConnections
+	}
+
+	info, err := s.js.StreamInfo(stream.Name)
+	if err != nil {
+		s.conns.Add(-1)
+		return nil, fmt.Errorf("could not get stream %s: %w", stream.Name, err)
+	}
+	// A sequence after the last one was issued by a stream which was recreated since
+	from := after + 1
+	if after == 0 || after > info.State.LastSeq {
+		from = info.State.LastSeq + 1
+	}
+	return &Stream{s: s, from: from, filter: f}, nil
+}
+
+// Close releases the connection of a stream which is not run
+func (st *Stream) Close() {
+	st.once.Do(func() { st.s.conns.Add(-1) })
+}
+
+// Run calls send with the events of the stream and heartbeat when no event was sent for
+// Config.Heartbeat. It returns when ctx is done, the streamer is closed, or send or heartbeat fail.
+func (st *Stream) Run(ctx context.Context, send func(Event) error, heartbeat func() error) error {
+	defer st.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-st.s.done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	records := make(chan history.Record)
+	errc := make(chan error, 1)
+	go func() {
+		q := history.Query{Subject: st.filter.subject(), FromSeq: st.from, Follow: true}
+		errc <- history.Read(ctx, st.s.js, q, func(r history.Record) error {
+			if r.Error != "" || !st.filter.Match(r) {
+				return nil
+			}
+			select {
+			case records <- r:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		})
+	}()
+
+	ticker := time.NewTicker(st.s.cfg.Heartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case r := <-records:
+			data, err := json.Marshal(events.Envelope{Metadata: r.Metadata, Payload: r.Payload})
+			if err != nil {
+				return fmt.Errorf("could not marshal event %d: %w", r.Sequence, err)
+			}
+			if err := send(Event{Sequence: r.Sequence, Type: r.Metadata.Type, Data: data}); err != nil {
+				return err
+			}
+			ticker.Reset(st.s.cfg.Heartbeat)
+		case <-ticker.C:
+			if err := heartbeat(); err != nil {
+				return err
+			}
+		case err := <-errc:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// Filter selects events of a stream. Zero values do not restrict the result.
+type Filter struct {
+	Types []string // event types, "prize.*", "laureate.*" or "category.*"
+	// Categories are prize categories, events without a category (laureate events and links) do not match
+	Categories []string
+}
+
+// ParseFilter parses comma separated lists of event types and categories
+func ParseFilter(types, categories string) (Filter, error) {
+	var f Filter
+	for t := range strings.SplitSeq(types, ",") {
+		if t = strings.TrimSpace(t); t == "" {
+			continue
+		}
+		if !slices.Contains(events.Types, t) && !slices.Contains(events.Subjects, t) {
+			return Filter{}, fmt.Errorf("unknown event type %q", t)
+		}
+		f.Types = append(f.Types, t)
+	}
+	for c := range strings.SplitSeq(categories, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			f.Categories = append(f.Categories, strings.ToLower(c))
+		}
+	}
+	return f, nil
+}
+
+// Match reports whether the event matches the filter
+func (f Filter) Match(r history.Record) bool {
+	if len(f.Types) > 0 && !slices.ContainsFunc(f.Types, func(t string) bool { return matchType(t, r.Metadata.Type) }) {
+		return false
+	}
+	if len(f.Categories) > 0 {
+		return slices.ContainsFunc(categories(r.Payload), func(c string) bool {
+			return slices.Contains(f.Categories, strings.ToLower(c))
+		})
+	}
+	return true
+}
+
+// subject returns the subject to read, a single type is filtered by the server
+func (f Filter) subject() string {
+	if len(f.Types) == 1 {
+		return f.Types[0]
+	}
+	return ""
+}
+
+// matchType reports whether eventType matches a type or a wildcard like "prize.*"
+func matchType(pattern, eventType string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(eventType, prefix)
+	}
+	return pattern == eventType
+}
+
+// categories returns the prize categories of a payload: the category of a created prize,
+// the current and previous ones of an updated prize and the previous one of a deleted prize
+func categories(payload json.RawMessage) []string {
+	var p struct {
+		Category string `json:"category"`
+		Current  struct {
+			Category string `json:"category"`
+		} `json:"current"`
+		Previous struct {
+			Category string `json:"category"`
+		} `json:"previous"`
+	}
+	if json.Unmarshal(payload, &p) != nil {
+		return nil
+	}
+	var result []string
+	for _, c := range []string{p.Category, p.Current.Category, p.Previous.Category} {
+		if c != "" {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+// Package metrics defines Prometheus metrics of all binaries.
+// Metrics are registered in the default registry.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "ris"
+
+var (
+	// HTTPRequestDuration is the duration of HTTP requests per route template and status
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// EventsPublished counts events successfully published to NATS
+	EventsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "published_total",
+		Help:      "Number of events published to NATS.",
+	}, []string{"subject"})
+
+	// EventsPublishFailed counts events which could not be published
+	EventsPublishFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "publish_failed_total",
+		Help:      "Number of events which could not be published to NATS.",
+	}, []string{"subject"})
+
+	// EventsPublishRetries counts repeated publish attempts after a timeout or a missing stream
+	EventsPublishRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "publish_retries_total",
+		Help:      "Number of repeated attempts to publish events to JetStream.",
+	}, []string{"subject"})
+
+	// EventsConsumed counts events successfully handled by subscribers
+	EventsConsumed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "consumed_total",
+		Help:      "Number of events successfully handled by subscribers.",
+	}, []string{"subject"})
+
+	// EventsConsumeFailed counts events which could not be decoded or handled
+	EventsConsumeFailed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "consume_failed_total",
+		Help:      "Number of events which could not be decoded or handled by subscribers.",
+	}, []string{"subject", "reason"})
+
+	// EventsDeadLettered counts events moved to the dead letter stream
+	EventsDeadLettered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "events",
+		Name:      "dead_lettered_total",
+		Help:      "Number of events moved to the dead letter stream.",
+	}, []string{"subject", "reason"})
+
+	// OutboxPending is the number of stored events not yet published
+	OutboxPending = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "pending",
+		Help:      "Number of outbox events not yet published to NATS.",
+	})
+
+	// ProjectionSequence is the stream sequence of the last event applied to the read model
+	ProjectionSequence = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "projection",
+		Name:      "stream_sequence",
+		Help:      "Stream sequence of the last event applied to the read model.",
+	})
+
+	// ProjectionDuplicates counts events skipped by the projector because they were already applied
+	ProjectionDuplicates = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "projection",
+		Name:      "duplicates_total",
+		Help:      "Number of events skipped because they were already applied to the read model.",
+	})
+
+	// WebhookDeliveries counts webhook delivery attempts per result: delivered, retry or failed
+	WebhookDeliveries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhooks",
+		Name:      "deliveries_total",
+		Help:      "Number of webhook delivery attempts by result.",
+	}, []string{"result"})
+
+	// WebhookDeliveryDuration is the duration of webhook requests
+	WebhookDeliveryDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "webhooks",
+		Name:      "delivery_duration_seconds",
+		Help:      "Duration of webhook requests.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	// WebhooksDisabled counts webhooks disabled after repeated failures
+	WebhooksDisabled = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhooks",
+		Name:      "disabled_total",
+		Help:      "Number of webhooks disabled after repeated delivery failures.",
+	})
+
+	// LiveConnections is the number of open live event streams per transport (sse or websocket)
+	LiveConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "live",
+		Name:      "connections",
+		Help:      "Number of open live event streams.",
+	}, []string{"transport"})
+
+	// LiveEventsSent counts events sent to live streams per transport
+	LiveEventsSent = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "live",
+		Name:      "events_sent_total",
+		Help:      "Number of events sent to live streams.",
+	}, []string{"transport"})
+
+	// Exports counts dataset exports per entity, format and result (ok or error)
+	Exports = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "export",
+		Name:      "total",
+		Help:      "Number of dataset exports.",
+	}, []string{"entity", "format", "result"})
+
+	// ExportRows counts rows written by exports per entity
+	ExportRows = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "export",
+		Name:      "rows_total",
+		Help:      "Number of rows written by dataset exports.",
+	}, []string{"entity"})
+
+	// ImportDuration is the duration of the last dataset import
+	ImportDuration = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "import",
+		Name:      "duration_seconds",
+		Help:      "Duration of the last dataset import.",
+	})
+
+	// ImportRows is the number of rows written by the last import per entity
+	ImportRows = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "import",
+		Name:      "rows",
+		Help:      "Number of rows written by the last dataset import.",
+	}, []string{"entity"})
+
+	// ImportLastSuccess is the time of the last successful import
+	ImportLastSuccess = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "import",
+		Name:      "last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful dataset import.",
+	})
+)
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+// PushImport pushes import metrics to the Pushgateway, since the importer
+// exits before it could be scraped. The last success time is only pushed
+// after a successful import, so a failed run keeps the previous value.
+func PushImport(ctx context.Context, url string, succeeded bool) error {
+	pusher := push.New(url, "ris_import").
+		Collector(ImportDuration).
+		Collector(ImportRows)
+	if succeeded {
+		pusher = pusher.Collector(ImportLastSuccess)
+	}
+	// Add keeps metrics of the group which are not pushed this time
+	if err := pusher.AddContext(ctx); err != nil {
+		return fmt.Errorf("could not push metrics: %w", err)
+	}
+	return nil
+}
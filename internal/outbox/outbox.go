@@ -0,0 +1,280 @@
+// Package outbox implements the transactional outbox: events are stored in the
+// outbox table in the same transaction as the data change, and a relay publishes
+// them to NATS afterwards. Delivery is at-least-once, consumers must tolerate duplicates.
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"ris/internal/events"
+	"ris/internal/metrics"
+	"ris/pkg/postgres/queries"
+)
+
+// Publisher sends a message to the broker
+type Publisher interface {
+	Publish(ctx context.Context, subject string, data []byte, header nats.Header) error
+}
+
+// Config configures the relay
+type Config struct {
+	PollInterval   time.Duration // how often to look for pending events
+	BatchSize      int           // maximum events claimed at once
+	PublishTimeout time.Duration // timeout of a single publish
+	MaxBackoff     time.Duration // maximum delay between retries of an event
+	Retention      time.Duration // how long delivered events are kept
+	Producer       string        // name of the service put into event envelopes
+}
+
+// Outbox stores events and relays them to the broker
+type Outbox struct {
+	pool      *pgxpool.Pool
+	queries   *queries.Queries
+	publisher Publisher
+	cfg       Config
+
+	wake chan struct{}
+}
+
+// New creates a new Outbox. Call Run to start relaying.
+func New(pool *pgxpool.Pool, publisher Publisher, cfg Config) *Outbox {
+	return &Outbox{
+		pool:      pool,
+		queries:   queries.New(pool),
+		publisher: publisher,
+		cfg:       cfg,
+		wake:      make(chan struct{}, 1),
+	}
+}
+
+// Enqueue wraps the payload into an event envelope and stores it using q,
+// which must be bound to the transaction of the data change.
+// The trace context of ctx is stored with the event, so the publish span
+// continues the trace of the request. The event id is sent as the Nats-Msg-Id
+// header, so JetStream drops duplicates caused by retries.
+func (o *Outbox) Enqueue(ctx context.Context, q *queries.Queries, eventType, entityID string, payload any) error {
+	env, err := events.New(ctx, eventType, o.cfg.Producer, entityID, payload)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(env)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s event: %w", eventType, err)
+	}
+
+	carrier := propagation.MapCarrier{nats.MsgIdHdr: env.ID}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	headers, err := json.Marshal(carrier)
+	if err != nil {
+		return fmt.Errorf("could not marshal headers: %w", err)
+	}
+
+	_, err = q.EnqueueOutbox(ctx, queries.EnqueueOutboxParams{
+		Subject: eventType,
+		Payload: data,
+		Headers: headers,
+	})
+	if err != nil {
+		return fmt.Errorf("could not store %s event: %w", eventType, err)
+	}
+	return nil
+}
+
+// InTx runs fn in a transaction and wakes up the relay after commit
+func (o *Outbox) InTx(ctx context.Context, fn func(q *queries.Queries) error) error {
+	err := pgx.BeginFunc(ctx, o.pool, func(tx pgx.Tx) error {
+		return fn(o.queries.WithTx(tx))
+	})
+	if err != nil {
+		return err
+	}
+	o.Notify()
+	return nil
+}
+
+// Notify wakes up the relay, it should be called after the transaction
+// with enqueued events is committed
+func (o *Outbox) Notify() {
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run relays pending events until ctx is canceled
+func (o *Outbox) Run(ctx context.Context) {
+	ticker := time.NewTicker(o.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		// Drain the backlog, a full batch means there may be more
+		for {
+			n, err := o.relayBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("Failed to relay outbox events", "error", err)
+				}
+				break
+			}
+			if n < o.cfg.BatchSize {
+				break
+			}
+		}
+
+		if pending, err := o.queries.CountPendingOutbox(ctx); err == nil {
+			metrics.OutboxPending.Set(float64(pending))
+		}
+
+		if time.Since(lastCleanup) > time.Hour {
+			lastCleanup = time.Now()
+			o.cleanup(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-o.wake:
+		}
+	}
+}
+
+// relayBatch publishes a batch of due events in order. The batch is claimed in a short
+// transaction, which leases the events, and published outside of it, so no row locks are
+// held while NATS is retried. Claims of several API instances are serialized, and a claim
+// stops at events leased by another relay, so events are never published out of order.
+// The batch stops at the first failed event: later events wait for its retry,
+// and the returned count is less than the batch size, which ends draining in Run.
+func (o *Outbox) relayBatch(ctx context.Context) (int, error) {
+	events, err := o.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+	slices.SortFunc(events, func(a, b queries.ClaimPendingOutboxRow) int { return cmp.Compare(a.ID, b.ID) })
+
+	for i, event := range events {
+		if err := o.publish(ctx, event); err != nil {
+			slog.Warn("Failed to publish outbox event, will retry",
+				"id", event.ID, "subject", event.Subject, "attempt", event.Attempts+1, "error", err)
+			return i, o.fail(ctx, event, err, events[i+1:])
+		}
+		if err := o.queries.MarkOutboxDelivered(ctx, event.ID); err != nil {
+			// The event is published again after the lease, JetStream drops the duplicate
+			// if the stream duplicates window covers the lease (nats.stream_duplicates)
+			err = fmt.Errorf("could not mark event %d delivered: %w", event.ID, err)
+			return i, errors.Join(err, o.release(ctx, event.ID, events[i+1:]))
+		}
+	}
+	return len(events), nil
+}
+
+// claim leases a batch of due events. The lease covers publishing every event of the batch
+// with the publish timeout, after a crash the events are claimed again when it ends.
+func (o *Outbox) claim(ctx context.Context) ([]queries.ClaimPendingOutboxRow, error) {
+	var events []queries.ClaimPendingOutboxRow
+	err := pgx.BeginFunc(ctx, o.pool, func(tx pgx.Tx) error {
+		q := o.queries.WithTx(tx)
+		if err := q.LockOutboxRelay(ctx); err != nil {
+			return fmt.Errorf("could not lock relay: %w", err)
+		}
+		var err error
+		events, err = q.ClaimPendingOutbox(ctx, queries.ClaimPendingOutboxParams{
+			BatchSize: int32(o.cfg.BatchSize),
+			LeaseUntil: pgtype.Timestamptz{
+				Time:  time.Now().Add(o.cfg.PublishTimeout * time.Duration(o.cfg.BatchSize)),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("could not claim pending events: %w", err)
+		}
+		return nil
+	})
+	return events, err
+}
+
+// fail schedules the retry of a failed event and releases the events claimed after it
+func (o *Outbox) fail(ctx context.Context, event queries.ClaimPendingOutboxRow, publishErr error, rest []queries.ClaimPendingOutboxRow) error {
+	err := o.queries.MarkOutboxFailed(ctx, queries.MarkOutboxFailedParams{
+		ID:            event.ID,
+		LastError:     pgtype.Text{String: publishErr.Error(), Valid: true},
+		NextAttemptAt: pgtype.Timestamptz{Time: time.Now().Add(o.backoff(event.Attempts)), Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("could not mark event %d failed: %w", event.ID, err)
+	}
+	return o.release(ctx, event.ID, rest)
+}
+
+// release makes the events claimed after the event with id due again, so they are not held until the lease ends
+func (o *Outbox) release(ctx context.Context, id int64, rest []queries.ClaimPendingOutboxRow) error {
+	if len(rest) == 0 {
+		return nil
+	}
+	ids := make([]int64, len(rest))
+	for i, e := range rest {
+		ids[i] = e.ID
+	}
+	if err := o.queries.ReleaseOutbox(ctx, ids); err != nil {
+		return fmt.Errorf("could not release events after %d: %w", id, err)
+	}
+	return nil
+}
+
+func (o *Outbox) publish(ctx context.Context, event queries.ClaimPendingOutboxRow) error {
+	var carrier propagation.MapCarrier
+	if err := json.Unmarshal(event.Headers, &carrier); err != nil {
+		return fmt.Errorf("could not unmarshal headers: %w", err)
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, carrier)
+	ctx, cancel := context.WithTimeout(ctx, o.cfg.PublishTimeout)
+	defer cancel()
+
+	header := nats.Header{}
+	for k, v := range carrier {
+		header.Set(k, v)
+	}
+	return o.publisher.Publish(ctx, event.Subject, event.Payload, header)
+}
+
+// backoff returns the delay before the next attempt: one poll interval,
+// doubled on every failed attempt up to MaxBackoff
+func (o *Outbox) backoff(attempts int32) time.Duration {
+	d := o.cfg.PollInterval
+	for i := int32(0); i < attempts && d < o.cfg.MaxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, o.cfg.MaxBackoff)
+}
+
+// cleanup removes events delivered longer than Retention ago
+func (o *Outbox) cleanup(ctx context.Context) {
+	deleted, err := o.queries.DeleteDeliveredOutbox(ctx, pgtype.Timestamptz{
+		Time:  time.Now().Add(-o.cfg.Retention),
+		Valid: true,
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("Failed to clean up outbox", "error", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		slog.Info("Cleaned up delivered outbox events", "count", deleted)
+	}
+}
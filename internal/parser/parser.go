@@ -7,16 +7,22 @@ import (
 	"log/slog"
 	"net/http"
 	"ris/internal/domain"
+	"ris/internal/metrics"
+	"ris/pkg/utills"
 )
 
 type storage interface {
 	AddLaureates(context.Context, []domain.Laureate) error
 	AddPrizes([]domain.Prize) ([]int32, error)
 	LinkLaureatesToPrizes(ctx context.Context, prizeId int32, laureates []domain.Laureate) error
+	AddAffiliations(ctx context.Context, prizeId int32, laureates []domain.Laureate) error
+	RefreshAnalytics(context.Context) error
 }
 
 type Config struct {
 	Url string
+	// LaureatesUrl is the laureate dataset with bio data and affiliations, empty to skip them
+	LaureatesUrl string
 }
 
 type Parser struct {
@@ -34,34 +40,27 @@ func NewParser(cfg Config, storage storage) *Parser {
 }
 
 func (p *Parser) ParseAndStore(ctx context.Context) error {
-	// Fetch data from the URL
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.cfg.Url, nil)
-	if err != nil {
-		return fmt.Errorf("could not create request: %w", err)
+	var nobelResponse domain.NobelResponse
+	if err := p.fetch(ctx, p.cfg.Url, &nobelResponse); err != nil {
+		return err
 	}
-
-	slog.Info("Request URL: ", req.URL.String())
-
-	resp, err := p.client.Do(req)
+	details, err := p.fetchDetails(ctx)
 	if err != nil {
-		return fmt.Errorf("could not send request: %w", err)
-	}
-	defer resp.Body.Close()
-	slog.Info("Response Status: ", resp.Status)
-
-	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received non-200 response code: %d", resp.StatusCode)
+		return err
 	}
 
-	var nobelResponse domain.NobelResponse
-	if err := json.NewDecoder(resp.Body).Decode(&nobelResponse); err != nil {
-		return fmt.Errorf("could not decode response body: %w", err)
-	}
 	// Transform raw data to domain models
 	prizes := make([]domain.Prize, 0, len(nobelResponse.Prizes))
 	laureateMap := make(map[int32]domain.Laureate)
 	for _, rawPrize := range nobelResponse.Prizes {
 		prize := rawPrize.ToPrize()
+		for i := range prize.Laureates {
+			laureate := &prize.Laureates[i]
+			if d, ok := details[laureate.Id]; ok {
+				d.Apply(laureate)
+				laureate.Affiliations = d.Affiliations(prize.Year, prize.Category)
+			}
+		}
 		prizes = append(prizes, prize)
 		for _, laureate := range prize.Laureates {
 			laureateMap[laureate.Id] = laureate
@@ -77,17 +76,79 @@ func (p *Parser) ParseAndStore(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("could not add laureates: %w", err)
 	}
+	metrics.ImportRows.WithLabelValues("laureates").Set(float64(len(laureates)))
 
 	prizesIds, err := p.storage.AddPrizes(prizes)
 	if err != nil {
 		return fmt.Errorf("could not add prizes: %w", err)
 	}
+	metrics.ImportRows.WithLabelValues("prizes").Set(float64(len(prizesIds)))
 
+	links, affiliations := 0, 0
 	for i, prize := range prizes {
 		err = p.storage.LinkLaureatesToPrizes(ctx, prizesIds[i], prize.Laureates)
 		if err != nil {
 			return fmt.Errorf("could not link laureates to prize: %w", err)
 		}
+		links += len(prize.Laureates)
+
+		err = p.storage.AddAffiliations(ctx, prizesIds[i], prize.Laureates)
+		if err != nil {
+			return fmt.Errorf("could not add affiliations: %w", err)
+		}
+		for _, laureate := range prize.Laureates {
+			affiliations += len(laureate.Affiliations)
+		}
+	}
+	metrics.ImportRows.WithLabelValues("prize_laureates").Set(float64(links))
+	metrics.ImportRows.WithLabelValues("award_affiliations").Set(float64(affiliations))
+
+	// Analytics are materialized views, they see the imported data after the refresh
+	if err := p.storage.RefreshAnalytics(ctx); err != nil {
+		return fmt.Errorf("could not refresh analytics: %w", err)
+	}
+	return nil
+}
+
+// fetch decodes the JSON document at the URL into v
+func (p *Parser) fetch(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	slog.Info("Request", "url", req.URL.String())
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+	slog.Info("Response", "status", resp.Status)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("received non-200 response code: %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("could not decode response body: %w", err)
 	}
 	return nil
 }
+
+// fetchDetails returns the bio data and affiliations of laureates by id,
+// none if the laureate dataset is not configured
+func (p *Parser) fetchDetails(ctx context.Context) (map[int32]domain.RawLaureateDetails, error) {
+	details := make(map[int32]domain.RawLaureateDetails)
+	if p.cfg.LaureatesUrl == "" {
+		return details, nil
+	}
+	var laureatesResponse domain.LaureatesResponse
+	if err := p.fetch(ctx, p.cfg.LaureatesUrl, &laureatesResponse); err != nil {
+		return nil, fmt.Errorf("could not fetch laureates: %w", err)
+	}
+	for _, d := range laureatesResponse.Laureates {
+		details[int32(utills.ParseStringToInt(d.Id))] = d
+	}
+	return details, nil
+}
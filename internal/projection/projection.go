@@ -0,0 +1,331 @@
+// Package projection builds a denormalized read model from domain events:
+// prize and laureate counts per category and decade, and laureate profiles with all their prizes.
+//
+// The projector keeps its own copies of prizes, laureates and their links in projection_* tables
+// and recomputes the affected read model rows after every event. An event is applied in a single
+// transaction together with its id and the checkpoint (the stream sequence of the last applied event),
+// so redelivered and republished events are applied once. Events must be applied in stream order.
+package projection
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"ris/internal/domain"
+	"ris/internal/events"
+	"ris/internal/metrics"
+	"ris/pkg/postgres/queries"
+)
+
+// Name identifies the checkpoint of the read model
+const Name = "read_model"
+
+// ErrInvalidPayload is returned by Apply when the payload does not match the event type
+var ErrInvalidPayload = errors.New("invalid event payload")
+
+// Projector applies events to the read model
+type Projector struct {
+	pool    *pgxpool.Pool
+	queries *queries.Queries
+}
+
+// New creates a projector which stores the read model in pool
+func New(pool *pgxpool.Pool) *Projector {
+	return &Projector{
+		pool:    pool,
+		queries: queries.New(pool),
+	}
+}
+
+// Checkpoint returns the stream sequence of the last applied event, 0 if none was applied
+func (p *Projector) Checkpoint(ctx context.Context) (uint64, error) {
+	seq, err := p.queries.GetProjectionCheckpoint(ctx, Name)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("could not get checkpoint: %w", err)
+	}
+	return uint64(seq), nil
+}
+
+// Reset removes the read model with the applied event ids and the checkpoint,
+// so the events can be applied again from the beginning of the stream
+func (p *Projector) Reset(ctx context.Context) error {
+	if err := p.queries.TruncateProjection(ctx); err != nil {
+		return fmt.Errorf("could not reset read model: %w", err)
+	}
+	metrics.ProjectionSequence.Set(0)
+	return nil
+}
+
+// Apply applies the event stored at the stream sequence seq.
+// Events at or before the checkpoint and events with an already applied id are skipped.
+// Unknown event types only move the checkpoint.
+func (p *Projector) Apply(ctx context.Context, seq uint64, meta events.Metadata, payload json.RawMessage) error {
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		q := p.queries.WithTx(tx)
+
+		checkpoint, err := q.GetProjectionCheckpoint(ctx, Name)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("could not get checkpoint: %w", err)
+		}
+		if seq <= uint64(checkpoint) {
+			metrics.ProjectionDuplicates.Inc()
+			return nil
+		}
+		if err := q.SaveProjectionCheckpoint(ctx, queries.SaveProjectionCheckpointParams{
+			Name:           Name,
+			StreamSequence: int64(seq),
+		}); err != nil {
+			return fmt.Errorf("could not save checkpoint: %w", err)
+		}
+
+		// Events published before the envelope was introduced have no id
+		if meta.ID != "" {
+			applied, err := q.MarkProjectionEventApplied(ctx, queries.MarkProjectionEventAppliedParams{
+				EventID:        meta.ID,
+				StreamSequence: int64(seq),
+			})
+			if err != nil {
+				return fmt.Errorf("could not mark event applied: %w", err)
+			}
+			if applied == 0 {
+				metrics.ProjectionDuplicates.Inc()
+				return nil
+			}
+		}
+
+		a := &applier{q: q, cells: map[cell]struct{}{}, laureates: map[int32]struct{}{}}
+		if err := a.apply(ctx, meta.Type, payload); err != nil {
+			return err
+		}
+		return a.refresh(ctx)
+	})
+	if err != nil {
+		return fmt.Errorf("could not apply %s event %s: %w", meta.Type, meta.ID, err)
+	}
+	metrics.ProjectionSequence.Set(float64(seq))
+	return nil
+}
+
+// cell is a row of category_decade_stats
+type cell struct {
+	category string
+	decade   int32
+}
+
+func cellOf(year int32, category string) cell {
+	return cell{category: category, decade: year / 10 * 10}
+}
+
+// applier applies an event to the projection tables in a transaction
+// and collects the read model rows to recompute
+type applier struct {
+	q         *queries.Queries
+	cells     map[cell]struct{}
+	laureates map[int32]struct{}
+}
+
+func (a *applier) apply(ctx context.Context, eventType string, payload json.RawMessage) error {
+	switch eventType {
+	case events.TypePrizeCreated:
+		return applyPayload(ctx, payload, a.prizeCreated)
+	case events.TypePrizeUpdated:
+		return applyPayload(ctx, payload, a.prizeUpdated)
+	case events.TypePrizeDeleted:
+		return applyPayload(ctx, payload, a.prizeDeleted)
+	case events.TypePrizeLaureateLinked:
+		return applyPayload(ctx, payload, a.laureateLinked)
+	case events.TypePrizeLaureateUnlinked:
+		return applyPayload(ctx, payload, a.laureateUnlinked)
+	case events.TypeLaureateCreated:
+		return applyPayload(ctx, payload, a.laureateCreated)
+	case events.TypeLaureateUpdated:
+		return applyPayload(ctx, payload, a.laureateUpdated)
+	case events.TypeLaureateDeleted:
+		return applyPayload(ctx, payload, a.laureateDeleted)
+	}
+	return nil
+}
+
+// applyPayload unmarshals the payload and passes it to fn
+func applyPayload[T any](ctx context.Context, payload json.RawMessage, fn func(context.Context, T) error) error {
+	var v T
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidPayload, err)
+	}
+	return fn(ctx, v)
+}
+
+func (a *applier) prizeCreated(ctx context.Context, prize domain.Prize) error {
+	year, err := strconv.Atoi(prize.Year)
+	if err != nil {
+		return fmt.Errorf("%w: year %q is not a number", ErrInvalidPayload, prize.Year)
+	}
+	if err := a.upsertPrize(ctx, prize.Id, domain.PrizeFields{Year: int32(year), Category: prize.Category}); err != nil {
+		return err
+	}
+	for _, laureate := range prize.Laureates {
+		if err := a.upsertLaureate(ctx, laureate); err != nil {
+			return err
+		}
+		if err := a.link(ctx, domain.PrizeLaureateLink{PrizeId: prize.Id, LaureateId: laureate.Id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *applier) prizeUpdated(ctx context.Context, event domain.PrizeUpdated) error {
+	if err := a.upsertPrize(ctx, event.Id, event.Current); err != nil {
+		return err
+	}
+	// Profiles list the year and category of the prize
+	ids, err := a.q.ListProjectionPrizeLaureateIds(ctx, event.Id)
+	if err != nil {
+		return fmt.Errorf("could not list laureates of prize %d: %w", event.Id, err)
+	}
+	for _, id := range ids {
+		a.laureates[id] = struct{}{}
+	}
+	return nil
+}
+
+func (a *applier) prizeDeleted(ctx context.Context, event domain.PrizeDeleted) error {
+	a.cells[cellOf(event.Previous.Year, event.Previous.Category)] = struct{}{}
+	if err := a.touchPrize(ctx, event.Id); err != nil {
+		return err
+	}
+	ids, err := a.q.DeleteProjectionPrizeLinks(ctx, event.Id)
+	if err != nil {
+		return fmt.Errorf("could not unlink laureates of prize %d: %w", event.Id, err)
+	}
+	for _, id := range ids {
+		a.laureates[id] = struct{}{}
+	}
+	if err := a.q.DeleteProjectionPrize(ctx, event.Id); err != nil {
+		return fmt.Errorf("could not delete prize %d: %w", event.Id, err)
+	}
+	return nil
+}
+
+func (a *applier) laureateLinked(ctx context.Context, link domain.PrizeLaureateLink) error {
+	return a.link(ctx, link)
+}
+
+func (a *applier) laureateUnlinked(ctx context.Context, link domain.PrizeLaureateLink) error {
+	if err := a.q.UnlinkProjectionLaureate(ctx, queries.UnlinkProjectionLaureateParams{
+		PrizeID:    link.PrizeId,
+		LaureateID: link.LaureateId,
+	}); err != nil {
+		return fmt.Errorf("could not unlink laureate %d from prize %d: %w", link.LaureateId, link.PrizeId, err)
+	}
+	a.laureates[link.LaureateId] = struct{}{}
+	return a.touchPrize(ctx, link.PrizeId)
+}
+
+func (a *applier) laureateCreated(ctx context.Context, laureate domain.Laureate) error {
+	return a.upsertLaureate(ctx, laureate)
+}
+
+func (a *applier) laureateUpdated(ctx context.Context, event domain.LaureateUpdated) error {
+	laureate := event.Current
+	laureate.Id = event.Id
+	return a.upsertLaureate(ctx, laureate)
+}
+
+func (a *applier) laureateDeleted(ctx context.Context, event domain.LaureateDeleted) error {
+	prizeIDs, err := a.q.DeleteProjectionLaureateLinks(ctx, event.Id)
+	if err != nil {
+		return fmt.Errorf("could not unlink prizes of laureate %d: %w", event.Id, err)
+	}
+	for _, id := range prizeIDs {
+		if err := a.touchPrize(ctx, id); err != nil {
+			return err
+		}
+	}
+	if err := a.q.DeleteProjectionLaureate(ctx, event.Id); err != nil {
+		return fmt.Errorf("could not delete laureate %d: %w", event.Id, err)
+	}
+	a.laureates[event.Id] = struct{}{}
+	return nil
+}
+
+// upsertPrize stores the prize and marks its previous and current cells
+func (a *applier) upsertPrize(ctx context.Context, id int32, fields domain.PrizeFields) error {
+	if err := a.touchPrize(ctx, id); err != nil {
+		return err
+	}
+	if err := a.q.UpsertProjectionPrize(ctx, queries.UpsertProjectionPrizeParams{
+		ID:       id,
+		Year:     fields.Year,
+		Category: fields.Category,
+	}); err != nil {
+		return fmt.Errorf("could not store prize %d: %w", id, err)
+	}
+	a.cells[cellOf(fields.Year, fields.Category)] = struct{}{}
+	return nil
+}
+
+func (a *applier) upsertLaureate(ctx context.Context, laureate domain.Laureate) error {
+	if err := a.q.UpsertProjectionLaureate(ctx, queries.UpsertProjectionLaureateParams{
+		ID:         laureate.Id,
+		Firstname:  laureate.Firstname,
+		Surname:    laureate.Surname,
+		Motivation: laureate.Motivation,
+		Share:      laureate.Share,
+	}); err != nil {
+		return fmt.Errorf("could not store laureate %d: %w", laureate.Id, err)
+	}
+	a.laureates[laureate.Id] = struct{}{}
+	return nil
+}
+
+func (a *applier) link(ctx context.Context, link domain.PrizeLaureateLink) error {
+	if err := a.q.LinkProjectionLaureate(ctx, queries.LinkProjectionLaureateParams{
+		PrizeID:    link.PrizeId,
+		LaureateID: link.LaureateId,
+	}); err != nil {
+		return fmt.Errorf("could not link laureate %d to prize %d: %w", link.LaureateId, link.PrizeId, err)
+	}
+	a.laureates[link.LaureateId] = struct{}{}
+	return a.touchPrize(ctx, link.PrizeId)
+}
+
+// touchPrize marks the cell of the stored prize, if it is known
+func (a *applier) touchPrize(ctx context.Context, id int32) error {
+	prize, err := a.q.GetProjectionPrize(ctx, id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("could not get prize %d: %w", id, err)
+	}
+	a.cells[cellOf(prize.Year, prize.Category)] = struct{}{}
+	return nil
+}
+
+// refresh recomputes the marked read model rows
+func (a *applier) refresh(ctx context.Context) error {
+	for c := range a.cells {
+		if err := a.q.RefreshCategoryDecadeStats(ctx, queries.RefreshCategoryDecadeStatsParams{
+			Category: c.category,
+			Decade:   c.decade,
+		}); err != nil {
+			return fmt.Errorf("could not refresh stats of %s in %ds: %w", c.category, c.decade, err)
+		}
+	}
+	for id := range a.laureates {
+		if err := a.q.RefreshLaureateProfile(ctx, id); err != nil {
+			return fmt.Errorf("could not refresh profile of laureate %d: %w", id, err)
+		}
+	}
+	return nil
+}
@@ -1,38 +1,107 @@
 package publisher
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"ris/internal/domain"
+	"log/slog"
+	"ris/internal/metrics"
+	"ris/internal/tracing"
+	"time"
 
 	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 )
 
-const (
-	subjectPrizeCreated    = "prize.created"
-	subjectLaureateCreated = "laureate.created"
-)
+// Config configures publishing to JetStream
+type Config struct {
+	Stream    string        // stream expected to store the messages
+	Timeout   time.Duration // timeout of waiting for a single acknowledgement
+	Retries   int           // number of retries after a timeout or a missing stream
+	RetryWait time.Duration // delay between retries
+}
 
 type Publisher struct {
-	broker *nats.Conn
+	js  nats.JetStreamContext
+	cfg Config
 }
 
-func New(broker *nats.Conn) *Publisher {
-	return &Publisher{broker: broker}
+func New(broker *nats.Conn, cfg Config) (*Publisher, error) {
+	js, err := broker.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("could not create JetStream context: %w", err)
+	}
+	return &Publisher{js: js, cfg: cfg}, nil
 }
 
-func (p *Publisher) PublishPrizeCreated(prize domain.Prize) error {
-	data, err := json.Marshal(prize)
+// Publish sends the message with the trace context of ctx in its headers
+// and waits until the stream acknowledges that it is stored.
+//
+// Retries are safe when the message has a Nats-Msg-Id header: JetStream drops duplicates.
+func (p *Publisher) Publish(ctx context.Context, subject string, data []byte, header nats.Header) error {
+	msg := nats.NewMsg(subject)
+	msg.Data = data
+	for k, v := range header {
+		msg.Header[k] = v
+	}
+	ctx, span := tracing.StartPublish(ctx, msg)
+	defer span.End()
+
+	ack, err := p.publish(ctx, msg)
 	if err != nil {
-		return fmt.Errorf("error marshalling prize %v", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		metrics.EventsPublishFailed.WithLabelValues(subject).Inc()
+		return err
+	}
+	span.SetAttributes(
+		attribute.Int64("messaging.nats.stream.sequence", int64(ack.Sequence)),
+		attribute.Bool("messaging.nats.duplicate", ack.Duplicate),
+	)
+	if ack.Duplicate {
+		slog.DebugContext(ctx, "Event already stored in stream", "subject", subject, "seq", ack.Sequence)
 	}
-	return p.broker.Publish(subjectPrizeCreated, data)
+	metrics.EventsPublished.WithLabelValues(subject).Inc()
+	return nil
 }
 
-func (p *Publisher) PublishLaureateCreated(laureate domain.Laureate) error {
-	data, err := json.Marshal(laureate)
+func (p *Publisher) publish(ctx context.Context, msg *nats.Msg) (*nats.PubAck, error) {
+	for attempt := 0; ; attempt++ {
+		ack, err := p.publishOnce(ctx, msg)
+		if err == nil {
+			return ack, nil
+		}
+		if attempt >= p.cfg.Retries || !retryable(err) || ctx.Err() != nil {
+			return nil, fmt.Errorf("could not publish to %s: %w", msg.Subject, err)
+		}
+		metrics.EventsPublishRetries.WithLabelValues(msg.Subject).Inc()
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("could not publish to %s: %w", msg.Subject, err)
+		case <-time.After(p.cfg.RetryWait):
+		}
+	}
+}
+
+func (p *Publisher) publishOnce(ctx context.Context, msg *nats.Msg) (*nats.PubAck, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.cfg.Timeout)
+	defer cancel()
+	// Retries are done by publish, the library would only retry a missing stream
+	ack, err := p.js.PublishMsg(msg, nats.Context(ctx), nats.ExpectStream(p.cfg.Stream), nats.RetryAttempts(0))
 	if err != nil {
-		return fmt.Errorf("error marshalling laureate %v", err)
+		return nil, err
 	}
-	return p.broker.Publish(subjectLaureateCreated, data)
+	if ack.Stream != p.cfg.Stream {
+		return nil, fmt.Errorf("message stored in stream %s instead of %s", ack.Stream, p.cfg.Stream)
+	}
+	return ack, nil
+}
+
+// retryable reports whether the publish may succeed when repeated
+func retryable(err error) bool {
+	return errors.Is(err, nats.ErrTimeout) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, nats.ErrNoStreamResponse) ||
+		errors.Is(err, nats.ErrNoResponders)
 }
@@ -0,0 +1,99 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+
+	"ris/internal/tracing"
+)
+
+// Client calls the micro service
+type Client struct {
+	nc      *nats.Conn
+	timeout time.Duration
+}
+
+// NewClient creates a client. Requests without a deadline in their context time out after timeout.
+func NewClient(nc *nats.Conn, timeout time.Duration) *Client {
+	return &Client{nc: nc, timeout: timeout}
+}
+
+// GetLaureate returns a laureate by id, the error matches ErrNotFound if it does not exist
+func (c *Client) GetLaureate(ctx context.Context, id int32) (*Laureate, error) {
+	var resp Laureate
+	if err := c.call(ctx, SubjectGetLaureate, GetLaureateRequest{ID: id}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetPrize returns a prize with its laureates by id, the error matches ErrNotFound if it does not exist
+func (c *Client) GetPrize(ctx context.Context, id int32) (*Prize, error) {
+	var resp Prize
+	if err := c.call(ctx, SubjectGetPrize, GetPrizeRequest{ID: id}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// ListPrizesByYear returns the prizes of a year
+func (c *Client) ListPrizesByYear(ctx context.Context, year int32) ([]Prize, error) {
+	var resp []Prize
+	if err := c.call(ctx, SubjectListPrizesByYear, ListPrizesByYearRequest{Year: year}, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListPrizesByCategory returns the prizes of a category, the error matches ErrNotFound if it does not exist
+func (c *Client) ListPrizesByCategory(ctx context.Context, category string) ([]Prize, error) {
+	var resp []Prize
+	if err := c.call(ctx, SubjectListPrizesByCategory, ListPrizesByCategoryRequest{Category: category}, &resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GetStats returns statistics about the dataset
+func (c *Client) GetStats(ctx context.Context) (*Stats, error) {
+	var resp Stats
+	if err := c.call(ctx, SubjectGetStats, struct{}{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// call sends the request and decodes the response into resp.
+// Error responses are returned as *Error.
+func (c *Client) call(ctx context.Context, subject string, req, resp any) error {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("could not marshal %s request: %w", subject, err)
+	}
+
+	msg := &nats.Msg{Subject: subject, Data: data}
+	ctx, span := tracing.StartPublish(ctx, msg)
+	defer span.End()
+	reply, err := c.nc.RequestMsgWithContext(ctx, msg)
+	if err != nil {
+		return fmt.Errorf("%s request failed: %w", subject, err)
+	}
+
+	if code := reply.Header.Get(micro.ErrorCodeHeader); code != "" {
+		return &Error{Code: code, Description: reply.Header.Get(micro.ErrorHeader)}
+	}
+	if err := json.Unmarshal(reply.Data, resp); err != nil {
+		return fmt.Errorf("could not decode %s response: %w", subject, err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "ris/internal/app/api/v1"
+)
+
+// Name and version of the micro service, see "nats micro info nobel"
+const (
+	ServiceName    = "nobel"
+	ServiceVersion = "1.0.0"
+)
+
+// Subjects of the endpoints. Requests and responses are JSON, responses use the schemas of the HTTP API.
+const (
+	SubjectGetLaureate          = "nobel.laureates.get"      // GetLaureateRequest -> Laureate
+	SubjectGetPrize             = "nobel.prizes.get"         // GetPrizeRequest -> Prize
+	SubjectListPrizesByYear     = "nobel.prizes.by_year"     // ListPrizesByYearRequest -> []Prize
+	SubjectListPrizesByCategory = "nobel.prizes.by_category" // ListPrizesByCategoryRequest -> []Prize
+	SubjectGetStats             = "nobel.stats.get"          // empty request -> Stats
+)
+
+// Error codes set in the Nats-Service-Error-Code header, the description is in Nats-Service-Error
+const (
+	CodeBadRequest = "400"
+	CodeNotFound   = "404"
+	CodeInternal   = "500"
+)
+
+// GetLaureateRequest requests a laureate by id
+type GetLaureateRequest struct {
+	ID int32 `json:"id"`
+}
+
+// GetPrizeRequest requests a prize with its laureates by id
+type GetPrizeRequest struct {
+	ID int32 `json:"id"`
+}
+
+// ListPrizesByYearRequest requests the prizes of a year
+type ListPrizesByYearRequest struct {
+	Year int32 `json:"year"`
+}
+
+// ListPrizesByCategoryRequest requests the prizes of a category
+type ListPrizesByCategoryRequest struct {
+	Category string `json:"category"`
+}
+
+// Response schemas
+type (
+	Laureate = v1.LaureateResponse
+	Prize    = v1.PrizeResponse
+	Stats    = v1.StatsResponse
+)
+
+// ErrNotFound matches errors of requests for missing laureates, prizes and categories
+var ErrNotFound = errors.New("not found")
+
+// Error is an error response of the service
+type Error struct {
+	Code        string
+	Description string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("nobel rpc error %s: %s", e.Code, e.Description)
+}
+
+// Is makes errors.Is(err, ErrNotFound) report not found responses
+func (e *Error) Is(target error) bool {
+	return target == ErrNotFound && e.Code == CodeNotFound
+}
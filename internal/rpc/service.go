@@ -0,0 +1,165 @@
+// Package rpc exposes Nobel queries as a NATS request-reply micro service and provides its client,
+// so that services on the NATS bus can look up data without going through the HTTP API.
+//
+// Instances of the service share a queue group, every request is handled by one of them.
+// Errors are returned with the Nats-Service-Error-Code and Nats-Service-Error headers.
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/micro"
+
+	v1 "ris/internal/app/api/v1"
+	"ris/internal/tracing"
+)
+
+// Service is the subset of the API service exposed over NATS
+type Service interface {
+	GetStats(ctx context.Context) (*v1.StatsResponse, error)
+	GetLaureate(ctx context.Context, id int32) (*v1.LaureateResponse, error)
+	GetPrize(ctx context.Context, id int32) (*v1.PrizeResponse, error)
+	GetPrizesByCategory(ctx context.Context, category string) ([]v1.PrizeResponse, error)
+	GetPrizesByYear(ctx context.Context, year int32) ([]v1.PrizeResponse, error)
+}
+
+// Config configures the micro service
+type Config struct {
+	QueueGroup string        // queue group of the instances
+	Timeout    time.Duration // timeout of handling a request
+}
+
+// NewService registers the micro service on nc. Stop it to stop handling requests.
+func NewService(nc *nats.Conn, service Service, cfg Config) (micro.Service, error) {
+	svc, err := micro.AddService(nc, micro.Config{
+		Name:        ServiceName,
+		Version:     ServiceVersion,
+		Description: "Nobel prizes and laureates queries",
+		QueueGroup:  cfg.QueueGroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	h := &handler{service: service, timeout: cfg.Timeout}
+	endpoints := []struct {
+		name, subject, request, response string
+		handle                           func(context.Context, micro.Request) (any, error)
+	}{
+		{"get-laureate", SubjectGetLaureate, "GetLaureateRequest", "Laureate", h.getLaureate},
+		{"get-prize", SubjectGetPrize, "GetPrizeRequest", "Prize", h.getPrize},
+		{"list-prizes-by-year", SubjectListPrizesByYear, "ListPrizesByYearRequest", "[]Prize", h.listPrizesByYear},
+		{"list-prizes-by-category", SubjectListPrizesByCategory, "ListPrizesByCategoryRequest", "[]Prize", h.listPrizesByCategory},
+		{"get-stats", SubjectGetStats, "", "Stats", h.getStats},
+	}
+	for _, e := range endpoints {
+		err := svc.AddEndpoint(e.name, h.wrap(e.handle),
+			micro.WithEndpointSubject(e.subject),
+			micro.WithEndpointMetadata(map[string]string{"request": e.request, "response": e.response}))
+		if err != nil {
+			_ = svc.Stop()
+			return nil, err
+		}
+	}
+	return svc, nil
+}
+
+// badRequest is a request which could not be decoded or is invalid
+type badRequest struct{ msg string }
+
+func (e badRequest) Error() string { return e.msg }
+
+type handler struct {
+	service Service
+	timeout time.Duration
+}
+
+// wrap responds with the result of fn or with an error
+func (h *handler) wrap(fn func(context.Context, micro.Request) (any, error)) micro.HandlerFunc {
+	return func(req micro.Request) {
+		msg := &nats.Msg{Subject: req.Subject(), Data: req.Data(), Header: nats.Header(req.Headers())}
+		ctx, span := tracing.StartProcess(context.Background(), msg)
+		defer span.End()
+		ctx, cancel := context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+
+		result, err := fn(ctx, req)
+		var bad badRequest
+		switch {
+		case err == nil:
+			err = req.RespondJSON(result)
+		case errors.As(err, &bad):
+			err = req.Error(CodeBadRequest, bad.msg, nil)
+		case errors.Is(err, pgx.ErrNoRows), errors.Is(err, v1.ErrCategoryNotFound):
+			err = req.Error(CodeNotFound, "not found", nil)
+		default:
+			slog.ErrorContext(ctx, "Failed to handle request", "subject", req.Subject(), "error", err)
+			err = req.Error(CodeInternal, "internal error", nil)
+		}
+		if err != nil {
+			slog.ErrorContext(ctx, "Failed to respond", "subject", req.Subject(), "error", err)
+		}
+	}
+}
+
+// decode unmarshals the request data into v
+func decode(req micro.Request, v any) error {
+	if err := json.Unmarshal(req.Data(), v); err != nil {
+		return badRequest{"invalid request: " + err.Error()}
+	}
+	return nil
+}
+
+func (h *handler) getLaureate(ctx context.Context, req micro.Request) (any, error) {
+	var r GetLaureateRequest
+	if err := decode(req, &r); err != nil {
+		return nil, err
+	}
+	if r.ID <= 0 {
+		return nil, badRequest{"id must be positive"}
+	}
+	return h.service.GetLaureate(ctx, r.ID)
+}
+
+func (h *handler) getPrize(ctx context.Context, req micro.Request) (any, error) {
+	var r GetPrizeRequest
+	if err := decode(req, &r); err != nil {
+		return nil, err
+	}
+	if r.ID <= 0 {
+		return nil, badRequest{"id must be positive"}
+	}
+	return h.service.GetPrize(ctx, r.ID)
+}
+
+func (h *handler) listPrizesByYear(ctx context.Context, req micro.Request) (any, error) {
+	var r ListPrizesByYearRequest
+	if err := decode(req, &r); err != nil {
+		return nil, err
+	}
+	if r.Year < 1901 {
+		return nil, badRequest{"year must be 1901 or later"}
+	}
+	return h.service.GetPrizesByYear(ctx, r.Year)
+}
+
+func (h *handler) listPrizesByCategory(ctx context.Context, req micro.Request) (any, error) {
+	var r ListPrizesByCategoryRequest
+	if err := decode(req, &r); err != nil {
+		return nil, err
+	}
+	if r.Category == "" {
+		return nil, badRequest{"category is required"}
+	}
+	return h.service.GetPrizesByCategory(ctx, r.Category)
+}
+
+func (h *handler) getStats(ctx context.Context, _ micro.Request) (any, error) {
+	return h.service.GetStats(ctx)
+}
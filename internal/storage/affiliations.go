@@ -0,0 +1,10 @@
+package storage
+
+import (
+	"context"
+	"ris/internal/domain"
+)
+
+func (s *Storage) AddAffiliations(ctx context.Context, prizeId int32, laureates []domain.Laureate) error {
+	return s.postgres.AddAffiliations(ctx, prizeId, laureates)
+}
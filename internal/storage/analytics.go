@@ -0,0 +1,7 @@
+package storage
+
+import "context"
+
+func (s *Storage) RefreshAnalytics(ctx context.Context) error {
+	return s.postgres.RefreshAnalytics(ctx)
+}
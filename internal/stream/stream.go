@@ -0,0 +1,107 @@
+// Package stream provisions the JetStream streams which store domain events
+// and events which subscribers failed to handle (dead letters).
+//
+// Publishers and subscribers both call Provision on startup, whichever runs first creates the streams.
+package stream
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"ris/internal/events"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Name is the name of the stream with prize and laureate events
+const Name = "EVENTS"
+
+// DLQName is the name of the stream with dead-lettered events
+const DLQName = "EVENTS_DLQ"
+
+// DLQPrefix is prepended to the subject of a dead-lettered event
+const DLQPrefix = "dlq."
+
+// Config configures the events and dead letter streams
+type Config struct {
+	MaxAge     time.Duration // how long events are kept
+	DLQMaxAge  time.Duration // how long dead letters are kept, 0 until purged
+	Replicas   int           // number of copies in a cluster
+	Storage    string        // "file" or "memory"
+	Duplicates time.Duration // window in which events with a known Nats-Msg-Id are dropped, 0 for the default
+}
+
+// StreamConfig returns the JetStream configuration of the events stream
+func (c Config) StreamConfig() *nats.StreamConfig {
+	return &nats.StreamConfig{
+		Name:       Name,
+		Subjects:   events.Subjects,
+		Retention:  nats.LimitsPolicy,
+		MaxAge:     c.MaxAge,
+		Replicas:   c.Replicas,
+		Storage:    c.storage(),
+		Duplicates: c.Duplicates,
+	}
+}
+
+// DLQConfig returns the JetStream configuration of the dead letter stream
+func (c Config) DLQConfig() *nats.StreamConfig {
+	subjects := make([]string, len(events.Subjects))
+	for i, subject := range events.Subjects {
+		subjects[i] = DLQPrefix + subject
+	}
+	return &nats.StreamConfig{
+		Name:      DLQName,
+		Subjects:  subjects,
+		Retention: nats.LimitsPolicy,
+		MaxAge:    c.DLQMaxAge,
+		Replicas:  c.Replicas,
+		Storage:   c.storage(),
+	}
+}
+
+func (c Config) storage() nats.StorageType {
+	if c.Storage == "memory" {
+		return nats.MemoryStorage
+	}
+	return nats.FileStorage
+}
+
+// Provision creates the events and dead letter streams or brings existing ones up to date with cfg.
+// It is idempotent: a stream which already matches cfg is left untouched.
+func Provision(js nats.JetStreamContext, cfg Config) (*nats.StreamInfo, error) {
+	if _, err := provision(js, cfg.DLQConfig()); err != nil {
+		return nil, err
+	}
+	return provision(js, cfg.StreamConfig())
+}
+
+func provision(js nats.JetStreamContext, want *nats.StreamConfig) (*nats.StreamInfo, error) {
+	name := want.Name
+	info, err := js.StreamInfo(name)
+	if errors.Is(err, nats.ErrStreamNotFound) {
+		info, err = js.AddStream(want)
+		if err != nil {
+			return nil, fmt.Errorf("could not create stream %s: %w", name, err)
+		}
+		return info, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not get stream %s: %w", name, err)
+	}
+
+	have := info.Config
+	if slices.Equal(have.Subjects, want.Subjects) && have.Retention == want.Retention &&
+		have.MaxAge == want.MaxAge && have.Replicas == want.Replicas && have.Storage == want.Storage &&
+		(want.Duplicates == 0 || have.Duplicates == want.Duplicates) {
+		return info, nil
+	}
+	// The storage type cannot be changed, JetStream rejects the update
+	info, err = js.UpdateStream(want)
+	if err != nil {
+		return nil, fmt.Errorf("could not update stream %s: %w", name, err)
+	}
+	return info, nil
+}
@@ -0,0 +1,46 @@
+package subscriber
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"ris/internal/events"
+)
+
+// Codec decodes message data into a payload
+type Codec interface {
+	// Decode unmarshals data of a message with the subject into the value pointed to by payload
+	Decode(subject string, data []byte, payload any) (events.Metadata, error)
+}
+
+// EnvelopeCodec decodes event envelopes, see events.Decode. It is the default codec.
+type EnvelopeCodec struct{}
+
+func (EnvelopeCodec) Decode(subject string, data []byte, payload any) (events.Metadata, error) {
+	return events.DecodeInto(subject, data, payload)
+}
+
+// JSONCodec decodes messages which contain only a JSON payload.
+// The metadata has only the type, which is the subject.
+type JSONCodec struct{}
+
+func (JSONCodec) Decode(subject string, data []byte, payload any) (events.Metadata, error) {
+	if err := json.Unmarshal(data, payload); err != nil {
+		return events.Metadata{}, fmt.Errorf("could not unmarshal %s payload: %w", subject, err)
+	}
+	return events.Metadata{Type: subject}, nil
+}
+
+// DecodeError is returned by handlers when the message could not be decoded.
+// Such messages are moved to the dead letter stream without redelivery.
+type DecodeError struct {
+	Err error
+}
+
+func (e *DecodeError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,125 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+	"time"
+
+	"ris/internal/events"
+	"ris/internal/metrics"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Message is a message being handled
+type Message struct {
+	Subject string
+	Header  nats.Header
+	Data    []byte
+	// Deliveries is the number of deliveries including this one, 0 for core NATS messages
+	Deliveries uint64
+	// Sequence is the sequence of the message in the stream, 0 for core NATS messages
+	Sequence uint64
+	// Metadata is set once the message is decoded
+	Metadata events.Metadata
+}
+
+type messageKey struct{}
+
+// MessageFromContext returns the message being handled, so typed handlers can read its headers
+// or stream sequence. It returns nil outside of a handler.
+func MessageFromContext(ctx context.Context) *Message {
+	msg, _ := ctx.Value(messageKey{}).(*Message)
+	return msg
+}
+
+// HandlerFunc handles a message
+type HandlerFunc func(ctx context.Context, msg *Message) error
+
+// Middleware wraps a handler, e.g. to log or retry it
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// chain wraps h so that the first middleware is the outermost one
+func chain(h HandlerFunc, middleware []Middleware) HandlerFunc {
+	for i := len(middleware) - 1; i >= 0; i-- {
+		h = middleware[i](h)
+	}
+	return func(ctx context.Context, msg *Message) error {
+		return h(context.WithValue(ctx, messageKey{}, msg), msg)
+	}
+}
+
+// Logging logs failed messages as errors and handled ones at debug level
+func Logging(logger *slog.Logger) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg *Message) error {
+			err := next(ctx, msg)
+			attrs := []any{"subject", msg.Subject, "id", msg.Metadata.ID, "delivery", msg.Deliveries}
+			if err != nil {
+				logger.ErrorContext(ctx, "Failed to handle event", append(attrs, "error", err)...)
+			} else {
+				logger.DebugContext(ctx, "Handled event", attrs...)
+			}
+			return err
+		}
+	}
+}
+
+// Metrics counts handled and failed messages
+func Metrics() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg *Message) error {
+			err := next(ctx, msg)
+			var decodeErr *DecodeError
+			switch {
+			case err == nil:
+				metrics.EventsConsumed.WithLabelValues(msg.Subject).Inc()
+			case errors.As(err, &decodeErr):
+				metrics.EventsConsumeFailed.WithLabelValues(msg.Subject, "decode").Inc()
+			default:
+				metrics.EventsConsumeFailed.WithLabelValues(msg.Subject, "handler").Inc()
+			}
+			return err
+		}
+	}
+}
+
+// Recovery turns panics of the handler into errors
+func Recovery() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg *Message) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					slog.ErrorContext(ctx, "Event handler panicked", "subject", msg.Subject, "panic", r, "stack", string(debug.Stack()))
+					err = fmt.Errorf("handler panicked: %v", r)
+				}
+			}()
+			return next(ctx, msg)
+		}
+	}
+}
+
+// Retry calls the handler up to attempts times, waiting between the attempts,
+// before the message is negatively acknowledged. Decode errors are not retried.
+func Retry(attempts int, wait time.Duration) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, msg *Message) error {
+			var err error
+			for attempt := 1; ; attempt++ {
+				err = next(ctx, msg)
+				var decodeErr *DecodeError
+				if err == nil || errors.As(err, &decodeErr) || attempt >= attempts {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return err
+				case <-time.After(wait):
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package subscriber
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"ris/internal/dlq"
+	"ris/internal/events"
+	"ris/internal/metrics"
+	"ris/internal/stream"
+	"ris/internal/tracing"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// fetchWait is how long a pull waits for new messages
+const fetchWait = 5 * time.Second
+
+// deadLetterTimeout is the timeout of storing a dead letter
+const deadLetterTimeout = 5 * time.Second
+
+// Failed events are delivered again after a delay doubling from minRedeliveryDelay up to maxRedeliveryDelay
+const (
+	minRedeliveryDelay = time.Second
+	maxRedeliveryDelay = time.Minute
+)
+
+// Handler handles the payload of an event
+type Handler[T any] func(ctx context.Context, payload T, meta events.Metadata) error
+
+// Option configures a subscription
+type Option func(*options)
+
+type options struct {
+	codec      Codec
+	middleware []Middleware
+	workers    int
+	durable    string
+}
+
+// WithCodec sets the codec of the payload, EnvelopeCodec by default
+func WithCodec(codec Codec) Option {
+	return func(o *options) { o.codec = codec }
+}
+
+// WithMiddleware wraps the handler of the subscription, inside the middleware of the subscriber
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *options) { o.middleware = append(o.middleware, middleware...) }
+}
+
+// WithWorkers sets the number of messages handled concurrently, 1 by default.
+// With more than one worker events may be handled out of order.
+func WithWorkers(n int) Option {
+	return func(o *options) { o.workers = max(n, 1) }
+}
+
+// WithDurable sets the name of the consumer instead of <Durable>_<subject>
+func WithDurable(name string) Option {
+	return func(o *options) { o.durable = name }
+}
+
+// Subscribe pulls events of the subject, which may contain wildcards, from a durable consumer
+// and passes their decoded payloads to handler.
+//
+// Handled events are acknowledged and failed ones are delivered again. Events which cannot be decoded
+// or were delivered MaxDeliver times are moved to the dead letter stream.
+func Subscribe[T any](s *Subscriber, subject string, handler Handler[T], opts ...Option) error {
+	o := options{codec: EnvelopeCodec{}, workers: 1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.durable == "" {
+		if s.cfg.Durable == "" {
+			return fmt.Errorf("failed to subscribe to %s: durable consumer name is not configured", subject)
+		}
+		o.durable = s.consumerName(subject)
+	}
+
+	if err := s.consumer(o.durable, subject); err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+	}
+	// Bound subscriptions do not delete the consumer on Unsubscribe
+	sub, err := s.js.PullSubscribe(subject, o.durable, nats.Bind(stream.Name, o.durable))
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
+	}
+	s.subs = append(s.subs, sub)
+
+	h := chain(func(ctx context.Context, msg *Message) error {
+		var payload T
+		meta, err := o.codec.Decode(msg.Subject, msg.Data, &payload)
+		if err != nil {
+			return &DecodeError{Err: err}
+		}
+		msg.Metadata = meta
+		trace.SpanFromContext(ctx).SetAttributes(semconv.MessagingMessageID(meta.ID))
+		return handler(ctx, payload, meta)
+	}, slices.Concat(s.middleware, o.middleware, []Middleware{Recovery()}))
+
+	msgs := make(chan *nats.Msg)
+	s.wg.Add(1 + o.workers)
+	go func() {
+		defer s.wg.Done()
+		defer close(msgs)
+		s.fetch(sub, subject, o.workers, msgs)
+	}()
+	for range o.workers {
+		go func() {
+			defer s.wg.Done()
+			for msg := range msgs {
+				s.process(msg, h)
+			}
+		}()
+	}
+	return nil
+}
+
+// fetch pulls up to batch messages at once and sends them to out until the subscriber is closed
+func (s *Subscriber) fetch(sub *nats.Subscription, subject string, batch int, out chan<- *nats.Msg) {
+	for s.fetchCtx.Err() == nil {
+		ctx, cancel := context.WithTimeout(s.fetchCtx, fetchWait)
+		msgs, err := sub.Fetch(batch, nats.Context(ctx))
+		cancel()
+		if err != nil {
+			if s.fetchCtx.Err() != nil || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, nats.ErrTimeout) {
+				continue
+			}
+			slog.Error("failed to fetch events", "subject", subject, "error", err)
+			select {
+			case <-s.fetchCtx.Done():
+			case <-time.After(time.Second):
+			}
+			continue
+		}
+		for _, msg := range msgs {
+			out <- msg
+		}
+	}
+}
+
+// process passes msg to h and acknowledges it, delivers it again or moves it to the dead letter stream
+func (s *Subscriber) process(msg *nats.Msg, h HandlerFunc) {
+	m := &Message{Subject: msg.Subject, Header: msg.Header, Data: msg.Data}
+	if meta, err := msg.Metadata(); err == nil {
+		m.Deliveries = meta.NumDelivered
+		m.Sequence = meta.Sequence.Stream
+	}
+	if s.handleCtx.Err() != nil {
+		// Close gave up waiting, let another instance handle it
+		_ = msg.NakWithDelay(minRedeliveryDelay)
+		return
+	}
+	ctx, span := tracing.StartProcess(s.handleCtx, msg)
+	defer span.End()
+
+	exhausted := s.cfg.MaxDeliver > 0 && m.Deliveries >= uint64(s.cfg.MaxDeliver)
+	if s.cfg.MaxDeliver > 0 && m.Deliveries > uint64(s.cfg.MaxDeliver) {
+		// The previous deliveries were not acknowledged in time, e.g. the process crashed
+		s.deadLetter(ctx, msg, dlq.ReasonMaxDeliveries, fmt.Errorf("not acknowledged after %d deliveries", m.Deliveries-1))
+		return
+	}
+
+	err := h(ctx, m)
+	if err == nil {
+		if err := msg.Ack(); err != nil {
+			slog.Warn("Failed to acknowledge event", "subject", msg.Subject, "id", m.Metadata.ID, "error", err)
+		}
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+
+	var decodeErr *DecodeError
+	switch {
+	case errors.As(err, &decodeErr):
+		// Redelivery would not help
+		s.deadLetter(ctx, msg, dlq.ReasonDecode, err)
+	case exhausted:
+		s.deadLetter(ctx, msg, dlq.ReasonMaxDeliveries, err)
+	default:
+		_ = msg.NakWithDelay(redeliveryDelay(m.Deliveries))
+	}
+}
+
+// redeliveryDelay is the delay before the next delivery of an event failed on its nth delivery
+func redeliveryDelay(n uint64) time.Duration {
+	delay := minRedeliveryDelay
+	for i := uint64(1); i < n && delay < maxRedeliveryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRedeliveryDelay)
+}
+
+// deadLetter moves msg to the dead letter stream, or delivers it again later if that fails
+func (s *Subscriber) deadLetter(ctx context.Context, msg *nats.Msg, reason string, cause error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deadLetterTimeout)
+	defer cancel()
+	if err := dlq.Store(ctx, s.js, msg, reason, cause); err != nil {
+		slog.Error("failed to move event to the dead letter stream", "subject", msg.Subject, "error", err)
+		_ = msg.NakWithDelay(s.cfg.AckWait)
+		return
+	}
+	slog.Warn("Moved event to the dead letter stream", "subject", msg.Subject, "reason", reason, "error", cause)
+	metrics.EventsDeadLettered.WithLabelValues(msg.Subject, reason).Inc()
+	_ = msg.Term()
+}
@@ -1,111 +1,233 @@
 package subscriber
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"log/slog"
 	"ris/internal/domain"
+	"ris/internal/events"
+	"ris/internal/stream"
+	"ris/internal/tracing"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel/codes"
 )
 
-const (
-	subjectPrizeCreated    = "prize.created"
-	subjectLaureateCreated = "laureate.created"
-)
+// defaultDrainTimeout is used when Config.DrainTimeout is not set
+const defaultDrainTimeout = 30 * time.Second
+
+// defaultMaxAckPending is used when Config.MaxAckPending is not set
+const defaultMaxAckPending = 100
+
+// Config configures the durable consumers of Subscribe
+type Config struct {
+	// Durable is the prefix of consumer names, consumers are named <Durable>_<subject>.
+	// Consumers are kept on the server, a restarted subscriber continues where it stopped.
+	Durable    string
+	MaxDeliver int           // deliveries of an event before it is moved to the dead letter stream, -1 for unlimited
+	AckWait    time.Duration // time to handle a message before it is delivered again
+	// MaxAckPending limits the events delivered and not acknowledged yet, including failed ones
+	// waiting for redelivery; the server stops delivering new events of the consumer at the limit
+	MaxAckPending int
+
+	// Start position of a new consumer: nats.DeliverAllPolicy (the beginning of the stream),
+	// nats.DeliverNewPolicy, nats.DeliverByStartSequencePolicy or nats.DeliverByStartTimePolicy
+	Deliver       nats.DeliverPolicy
+	StartSequence uint64
+	StartTime     time.Time
+	// Reset recreates existing consumers, otherwise the start position applies only to new ones
+	Reset bool
+
+	// DrainTimeout is how long Close waits for handlers in progress before canceling their context
+	DrainTimeout time.Duration
+}
 
 type Subscriber struct {
 	conn *nats.Conn
 	js   nats.JetStreamContext
+	cfg  Config
+
+	middleware []Middleware
+
+	// fetchCtx stops pulling new messages, handleCtx is passed to handlers
+	fetchCtx     context.Context
+	stopFetch    context.CancelFunc
+	handleCtx    context.Context
+	stopHandlers context.CancelFunc
+	wg           sync.WaitGroup
 
-	subs []*nats.Subscription
+	subs     []*nats.Subscription
+	coreSubs []*nats.Subscription
 }
 
-func New(conn *nats.Conn) *Subscriber {
+// New creates a subscriber, the events stream must be created with stream.Provision.
+// Handlers are wrapped with the Metrics and Logging middleware, see Use,
+// and Recovery as the innermost one.
+func New(conn *nats.Conn, cfg Config) *Subscriber {
 	js, _ := conn.JetStream()
-	_, _ = js.AddStream(&nats.StreamConfig{
-		Name:     "EVENTS",
-		Subjects: []string{subjectPrizeCreated, subjectLaureateCreated},
-		MaxAge:   time.Hour * 24 * 7, // 7 days
-	})
-
+	if cfg.DrainTimeout <= 0 {
+		cfg.DrainTimeout = defaultDrainTimeout
+	}
+	if cfg.MaxAckPending <= 0 {
+		cfg.MaxAckPending = defaultMaxAckPending
+	}
+	fetchCtx, stopFetch := context.WithCancel(context.Background())
+	handleCtx, stopHandlers := context.WithCancel(context.Background())
 	return &Subscriber{
-		conn: conn,
-		js:   js,
-		subs: make([]*nats.Subscription, 0),
+		conn:         conn,
+		js:           js,
+		cfg:          cfg,
+		middleware:   []Middleware{Metrics(), Logging(slog.Default())},
+		fetchCtx:     fetchCtx,
+		stopFetch:    stopFetch,
+		handleCtx:    handleCtx,
+		stopHandlers: stopHandlers,
+		subs:         make([]*nats.Subscription, 0),
 	}
 }
 
+// Use adds middleware to all subscriptions made afterwards.
+// It runs inside the default middleware and outside the middleware given to Subscribe.
+func (s *Subscriber) Use(middleware ...Middleware) {
+	s.middleware = append(s.middleware, middleware...)
+}
+
+// Close stops receiving new messages and waits up to DrainTimeout for the handlers in progress,
+// then cancels their context. Unhandled messages are delivered again later.
+// Durable consumers are kept on the server.
 func (s *Subscriber) Close() error {
-	errs := make([]error, 0, len(s.subs))
+	s.stopFetch()
+	errs := make([]error, 0, len(s.subs)+len(s.coreSubs))
+	closed := make([]<-chan nats.SubStatus, 0, len(s.coreSubs))
+	for _, sub := range s.coreSubs {
+		closed = append(closed, sub.StatusChanged(nats.SubscriptionClosed))
+		errs = append(errs, sub.Drain())
+	}
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		for _, ch := range closed {
+			<-ch
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(s.cfg.DrainTimeout):
+		slog.Warn("Event handlers did not finish in time, canceling them", "timeout", s.cfg.DrainTimeout)
+		s.stopHandlers()
+		<-done
+	}
+	s.stopHandlers()
+
 	for _, sub := range s.subs {
 		errs = append(errs, sub.Unsubscribe())
 	}
 	return errors.Join(errs...)
 }
 
-func (s *Subscriber) SubscribePrizeCreated(handler func(prize domain.Prize) error) error {
-	sub, err := s.conn.Subscribe(subjectPrizeCreated, func(msg *nats.Msg) {
-		var prize domain.Prize
-		if err := json.Unmarshal(msg.Data, &prize); err != nil {
-			slog.Error("failed to unmarshal prize created event: %w", err)
-			return
+// consumerName returns the default durable name of the consumer of the subject
+func (s *Subscriber) consumerName(subject string) string {
+	return s.cfg.Durable + "_" + strings.NewReplacer(".", "_", "*", "any", ">", "all").Replace(subject)
+}
+
+// consumer creates the durable consumer of the subject or updates its ack wait and ack pending limit
+func (s *Subscriber) consumer(name, subject string) error {
+	want := &nats.ConsumerConfig{
+		Durable:       name,
+		FilterSubject: subject,
+		AckPolicy:     nats.AckExplicitPolicy,
+		// MaxDeliver is checked by process, the server would drop the event silently
+		MaxDeliver:    -1,
+		AckWait:       s.cfg.AckWait,
+		MaxAckPending: s.cfg.MaxAckPending,
+		DeliverPolicy: s.cfg.Deliver,
+	}
+	switch s.cfg.Deliver {
+	case nats.DeliverByStartSequencePolicy:
+		want.OptStartSeq = s.cfg.StartSequence
+	case nats.DeliverByStartTimePolicy:
+		want.OptStartTime = &s.cfg.StartTime
+	}
+
+	info, err := s.js.ConsumerInfo(stream.Name, name)
+	switch {
+	case errors.Is(err, nats.ErrConsumerNotFound):
+	case err != nil:
+		return fmt.Errorf("could not get consumer %s: %w", name, err)
+	case s.cfg.Reset:
+		if err := s.js.DeleteConsumer(stream.Name, name); err != nil {
+			return fmt.Errorf("could not delete consumer %s: %w", name, err)
 		}
-		if err := handler(prize); err != nil {
-			slog.Error("failed to handle prize created event: %w", err)
-			msg.Nak()
-			return
+	default:
+		// The start position of an existing consumer cannot be changed
+		have := info.Config
+		if have.MaxDeliver == want.MaxDeliver && have.AckWait == want.AckWait && have.MaxAckPending == want.MaxAckPending {
+			return nil
 		}
-	})
-	if err != nil {
-		return fmt.Errorf("failed to subscribe to prize created event: %w", err)
+		have.MaxDeliver, have.AckWait, have.MaxAckPending = want.MaxDeliver, want.AckWait, want.MaxAckPending
+		if _, err := s.js.UpdateConsumer(stream.Name, &have); err != nil {
+			return fmt.Errorf("could not update consumer %s: %w", name, err)
+		}
+		return nil
+	}
+	if _, err := s.js.AddConsumer(stream.Name, want); err != nil {
+		return fmt.Errorf("could not create consumer %s: %w", name, err)
 	}
-	s.subs = append(s.subs, sub)
 	return nil
 }
 
-func (s *Subscriber) SubscribeLaureateCreated(handler func(laureate domain.Laureate) error) error {
-	sub, err := s.conn.Subscribe(subjectLaureateCreated, func(msg *nats.Msg) {
-		var laureate domain.Laureate
-		if err := json.Unmarshal(msg.Data, &laureate); err != nil {
-			slog.Error("failed to unmarshal laureate created event: %w", err)
-			return
-		}
-		if err := handler(laureate); err != nil {
-			slog.Error("failed to handle laureate created event: %w", err)
-			msg.Nak()
-			return
+// SubscribeAll calls handler with the subject of every prize, laureate and category event.
+//
+// It uses a core NATS subscription: every instance gets every event while it is running,
+// events published before it started or failed by handler are not delivered again.
+func (s *Subscriber) SubscribeAll(handler func(ctx context.Context, subject string) error) error {
+	h := chain(func(ctx context.Context, msg *Message) error {
+		return handler(ctx, msg.Subject)
+	}, append(slices.Clone(s.middleware), Recovery()))
+	for _, subject := range events.Subjects {
+		sub, err := s.conn.Subscribe(subject, func(msg *nats.Msg) {
+			ctx, span := tracing.StartProcess(s.handleCtx, msg)
+			defer span.End()
+			if err := h(ctx, &Message{Subject: msg.Subject, Header: msg.Header, Data: msg.Data}); err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+		})
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to %s: %w", subject, err)
 		}
-	})
-	if err != nil {
-		return fmt.Errorf("failed to subscribe to laureate created event: %w", err)
+		s.coreSubs = append(s.coreSubs, sub)
 	}
-	s.subs = append(s.subs, sub)
 	return nil
 }
 
 func (s *Subscriber) GetLastPrizeMessage() (*domain.Prize, error) {
-	msg, err := s.js.GetLastMsg("EVENTS", subjectPrizeCreated)
+	msg, err := s.js.GetLastMsg(stream.Name, events.TypePrizeCreated)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get last prize message: %w", err)
 	}
-	var prize domain.Prize
-	if err := json.Unmarshal(msg.Data, &prize); err != nil {
+	event, err := events.Decode[domain.Prize](msg.Subject, msg.Data)
+	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal prize: %w", err)
 	}
-	return &prize, nil
+	return &event.Payload, nil
 }
 
 func (s *Subscriber) GetLastLaureateMessage() (*domain.Laureate, error) {
-	msg, err := s.js.GetLastMsg("EVENTS", subjectLaureateCreated)
+	msg, err := s.js.GetLastMsg(stream.Name, events.TypeLaureateCreated)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get last laureate message: %w", err)
 	}
-	var laureate domain.Laureate
-	if err := json.Unmarshal(msg.Data, &laureate); err != nil {
+	event, err := events.Decode[domain.Laureate](msg.Subject, msg.Data)
+	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal laureate: %w", err)
 	}
-	return &laureate, nil
+	return &event.Payload, nil
 }
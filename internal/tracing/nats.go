@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/nats-io/nats.go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "ris/internal/tracing"
+
+// headerCarrier adapts NATS headers to the propagation.TextMapCarrier.
+// Unlike http.Header, NATS headers are case-sensitive, so keys are used as is.
+type headerCarrier nats.Header
+
+func (c headerCarrier) Get(key string) string {
+	return nats.Header(c).Get(key)
+}
+
+func (c headerCarrier) Set(key, value string) {
+	nats.Header(c).Set(key, value)
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// StartPublish starts a producer span for the message and injects
+// the trace context into its headers
+func StartPublish(ctx context.Context, msg *nats.Msg) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(tracerName).Start(ctx, msg.Subject+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(messagingAttributes(msg, semconv.MessagingOperationTypeSend)...),
+	)
+	if msg.Header == nil {
+		msg.Header = nats.Header{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier(msg.Header))
+	return ctx, span
+}
+
+// StartProcess extracts the trace context from the message headers and
+// starts a consumer span which continues the publisher's trace
+func StartProcess(ctx context.Context, msg *nats.Msg) (context.Context, trace.Span) {
+	if msg.Header != nil {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, headerCarrier(msg.Header))
+	}
+	return otel.Tracer(tracerName).Start(ctx, msg.Subject+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(messagingAttributes(msg, semconv.MessagingOperationTypeProcess)...),
+	)
+}
+
+func messagingAttributes(msg *nats.Msg, operation attribute.KeyValue) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		semconv.MessagingSystemKey.String("nats"),
+		semconv.MessagingDestinationName(msg.Subject),
+		semconv.MessagingMessageBodySize(len(msg.Data)),
+		operation,
+	}
+}
@@ -0,0 +1,362 @@
+// Package webhook delivers domain events to HTTP endpoints of partners.
+//
+// Handle is a subscriber handler which stores a delivery of the event for every active
+// webhook subscribed to its type. Run sends stored deliveries as signed POST requests with
+// the event envelope as the body, retries failed ones with exponential backoff and disables
+// webhooks after repeated failures. Deliveries are kept in the webhook_deliveries table as history.
+//
+// Delivery is at-least-once and events of a webhook may arrive out of order,
+// receivers should deduplicate by the Ris-Event-Id header.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.43.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"ris/internal/events"
+	"ris/internal/metrics"
+	"ris/pkg/postgres/queries"
+)
+
+// Delivery statuses
+const (
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+)
+
+// AllEvents subscribes a webhook to every event type
+const AllEvents = "*"
+
+// leaseMargin extends the lease of claimed deliveries beyond the time needed to send them,
+// so that their results can be recorded
+const leaseMargin = time.Minute
+
+// ValidEventType reports whether a webhook can subscribe to s:
+// an event type, "prize.*", "laureate.*", "category.*" or "*"
+func ValidEventType(s string) bool {
+	return s == AllEvents || slices.Contains(events.Types, s) || slices.Contains(events.Subjects, s)
+}
+
+// Config configures the dispatcher
+type Config struct {
+	Timeout      time.Duration // timeout of a request
+	MaxAttempts  int           // attempts of a delivery before it fails
+	MinBackoff   time.Duration // delay after the first failed attempt, doubled after every next one
+	MaxBackoff   time.Duration // maximum delay between attempts
+	DisableAfter int           // consecutive failed attempts before a webhook is disabled
+	PollInterval time.Duration // how often to look for due deliveries
+	BatchSize    int           // maximum deliveries claimed at once
+	Concurrency  int           // requests sent at once
+	Retention    time.Duration // how long completed deliveries are kept
+
+	// AllowPrivateNetworks allows webhook URLs resolving to loopback, private and link-local addresses,
+	// e.g. for local testing. Such requests are refused by default, see ErrForbiddenAddress.
+	AllowPrivateNetworks bool
+}
+
+// Dispatcher stores and sends webhook deliveries
+type Dispatcher struct {
+	pool    *pgxpool.Pool
+	queries *queries.Queries
+	client  *http.Client
+	cfg     Config
+
+	wake chan struct{}
+}
+
+// New creates a dispatcher. Call Run to start sending deliveries.
+func New(pool *pgxpool.Pool, cfg Config) *Dispatcher {
+	return &Dispatcher{
+		pool:    pool,
+		queries: queries.New(pool),
+		client:  newClient(cfg),
+		cfg:     cfg,
+		wake:    make(chan struct{}, 1),
+	}
+}
+
+// Handle stores deliveries of the event, it is a subscriber.Handler.
+// Handling the same event again does not create new deliveries.
+func (d *Dispatcher) Handle(ctx context.Context, payload json.RawMessage, meta events.Metadata) error {
+	if meta.ID == "" {
+		// Events published before the envelope was introduced cannot be deduplicated by receivers
+		slog.WarnContext(ctx, "Skipping event without id", "type", meta.Type)
+		return nil
+	}
+	body, err := json.Marshal(events.Envelope{Metadata: meta, Payload: payload})
+	if err != nil {
+		return fmt.Errorf("could not marshal event %s: %w", meta.ID, err)
+	}
+	n, err := d.queries.EnqueueWebhookDeliveries(ctx, queries.EnqueueWebhookDeliveriesParams{
+		EventID:   meta.ID,
+		EventType: meta.Type,
+		Payload:   body,
+	})
+	if err != nil {
+		return fmt.Errorf("could not store deliveries of event %s: %w", meta.ID, err)
+	}
+	if n > 0 {
+		d.Notify()
+	}
+	return nil
+}
+
+// Notify wakes up Run, e.g. after deliveries were stored or a webhook was enabled
+func (d *Dispatcher) Notify() {
+	select {
+	case d.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Run sends due deliveries until ctx is canceled
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	var lastCleanup time.Time
+	for {
+		// Drain the backlog, a full batch means there may be more
+		for {
+			n, err := d.deliverBatch(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					slog.Error("Failed to deliver webhooks", "error", err)
+				}
+				break
+			}
+			if n < d.cfg.BatchSize {
+				break
+			}
+		}
+
+		if time.Since(lastCleanup) > time.Hour {
+			lastCleanup = time.Now()
+			d.cleanup(ctx)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-d.wake:
+		}
+	}
+}
+
+// result is the outcome of a delivery attempt
+type result struct {
+	status   int // response status, 0 if there was no response
+	duration time.Duration
+	err      error
+}
+
+// deliverBatch claims a batch of due deliveries, sends them concurrently and records every result
+// in its own transaction. Claimed deliveries are leased, so no transaction is open while requests
+// are sent and several dispatchers can run against the same database.
+func (d *Dispatcher) deliverBatch(ctx context.Context) (int, error) {
+	due, err := d.queries.ClaimDueWebhookDeliveries(ctx, queries.ClaimDueWebhookDeliveriesParams{
+		LeaseUntil: pgtype.Timestamptz{Time: time.Now().Add(d.lease()), Valid: true},
+		BatchSize:  int32(d.cfg.BatchSize),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not claim due deliveries: %w", err)
+	}
+
+	errs := make([]error, len(due))
+	sem := make(chan struct{}, d.cfg.Concurrency)
+	var wg sync.WaitGroup
+	for i, delivery := range due {
+		sem <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-sem }()
+			errs[i] = d.deliver(ctx, delivery)
+		})
+	}
+	wg.Wait()
+	return len(due), errors.Join(errs...)
+}
+
+// lease returns how long claimed deliveries are kept from other dispatchers:
+// the worst case of sending a batch with Concurrency requests at once, plus leaseMargin
+func (d *Dispatcher) lease() time.Duration {
+	rounds := (d.cfg.BatchSize + d.cfg.Concurrency - 1) / d.cfg.Concurrency
+	return time.Duration(rounds)*d.cfg.Timeout + leaseMargin
+}
+
+// deliver sends the delivery and records the result. If recording fails,
+// the delivery is sent again after its lease expires.
+func (d *Dispatcher) deliver(ctx context.Context, delivery queries.ClaimDueWebhookDeliveriesRow) error {
+	res := d.send(ctx, delivery)
+	return pgx.BeginFunc(ctx, d.pool, func(tx pgx.Tx) error {
+		return d.record(ctx, d.queries.WithTx(tx), delivery, res)
+	})
+}
+
+// send posts the delivery to the webhook URL, any 2xx response is a success
+func (d *Dispatcher) send(ctx context.Context, delivery queries.ClaimDueWebhookDeliveriesRow) result {
+	ctx, span := otel.Tracer("ris/internal/webhook").Start(ctx, "POST webhook",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodPost,
+			semconv.URLFull(delivery.Url),
+			semconv.MessagingMessageID(delivery.EventID),
+		))
+	defer span.End()
+
+	start := time.Now()
+	res := d.post(ctx, delivery)
+	res.duration = time.Since(start)
+	metrics.WebhookDeliveryDuration.Observe(res.duration.Seconds())
+
+	if res.status != 0 {
+		span.SetAttributes(semconv.HTTPResponseStatusCode(res.status))
+	}
+	if res.err != nil {
+		span.RecordError(res.err)
+		span.SetStatus(codes.Error, res.err.Error())
+	}
+	return res
+}
+
+func (d *Dispatcher) post(ctx context.Context, delivery queries.ClaimDueWebhookDeliveriesRow) result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return result{err: fmt.Errorf("could not create request: %w", err)}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ris-webhooks/1.0")
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, time.Now(), delivery.Payload))
+	req.Header.Set(HeaderWebhookID, strconv.FormatInt(delivery.WebhookID, 10))
+	req.Header.Set(HeaderDeliveryID, strconv.FormatInt(delivery.ID, 10))
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderEventType, delivery.EventType)
+	req.Header.Set(HeaderAttempt, strconv.Itoa(int(delivery.Attempts)+1))
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return result{err: err}
+	}
+	defer resp.Body.Close()
+	// A short excerpt of the body helps partners to debug failures
+	excerpt, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg := fmt.Sprintf("unexpected status %d", resp.StatusCode)
+		if text := strings.TrimSpace(string(excerpt)); text != "" {
+			msg += ": " + text
+		}
+		return result{status: resp.StatusCode, err: errors.New(msg)}
+	}
+	return result{status: resp.StatusCode}
+}
+
+// record stores the result of an attempt and counts failures of the webhook
+func (d *Dispatcher) record(ctx context.Context, q *queries.Queries, delivery queries.ClaimDueWebhookDeliveriesRow, res result) error {
+	status := pgtype.Int4{Int32: int32(res.status), Valid: res.status != 0}
+	duration := pgtype.Int4{Int32: int32(res.duration.Milliseconds()), Valid: true}
+
+	if res.err == nil {
+		metrics.WebhookDeliveries.WithLabelValues(StatusDelivered).Inc()
+		if err := q.MarkWebhookDeliveryDelivered(ctx, queries.MarkWebhookDeliveryDeliveredParams{
+			ID:             delivery.ID,
+			ResponseStatus: status,
+			DurationMs:     duration,
+		}); err != nil {
+			return fmt.Errorf("could not mark delivery %d delivered: %w", delivery.ID, err)
+		}
+		if err := q.ResetWebhookFailures(ctx, delivery.WebhookID); err != nil {
+			return fmt.Errorf("could not reset failures of webhook %d: %w", delivery.WebhookID, err)
+		}
+		return nil
+	}
+
+	attempts := int(delivery.Attempts) + 1
+	next := StatusPending
+	if attempts >= d.cfg.MaxAttempts {
+		next = StatusFailed
+	}
+	outcome := "retry"
+	if next == StatusFailed {
+		outcome = StatusFailed
+	}
+	metrics.WebhookDeliveries.WithLabelValues(outcome).Inc()
+	slog.Warn("Failed to deliver webhook", "webhook", delivery.WebhookID, "delivery", delivery.ID,
+		"event", delivery.EventID, "attempt", attempts, "status", next, "error", res.err)
+
+	if err := q.MarkWebhookDeliveryFailed(ctx, queries.MarkWebhookDeliveryFailedParams{
+		ID:             delivery.ID,
+		Status:         next,
+		ResponseStatus: status,
+		DurationMs:     duration,
+		LastError:      pgtype.Text{String: res.err.Error(), Valid: true},
+		NextAttemptAt:  pgtype.Timestamptz{Time: time.Now().Add(d.backoff(delivery.Attempts)), Valid: true},
+	}); err != nil {
+		return fmt.Errorf("could not mark delivery %d failed: %w", delivery.ID, err)
+	}
+
+	webhook, err := q.RecordWebhookFailure(ctx, queries.RecordWebhookFailureParams{
+		ID:           delivery.WebhookID,
+		DisableAfter: int32(d.cfg.DisableAfter),
+		Reason:       fmt.Sprintf("%d consecutive failed deliveries, last error: %v", d.cfg.DisableAfter, res.err),
+	})
+	if err != nil {
+		return fmt.Errorf("could not record failure of webhook %d: %w", delivery.WebhookID, err)
+	}
+	if !webhook.Active && int(webhook.ConsecutiveFailures) == d.cfg.DisableAfter {
+		metrics.WebhooksDisabled.Inc()
+		slog.Warn("Disabled webhook after repeated failures", "webhook", delivery.WebhookID, "url", delivery.Url,
+			"failures", webhook.ConsecutiveFailures)
+	}
+	return nil
+}
+
+// backoff returns the delay before the next attempt: MinBackoff,
+// doubled on every failed attempt up to MaxBackoff
+func (d *Dispatcher) backoff(attempts int32) time.Duration {
+	delay := d.cfg.MinBackoff
+	for i := int32(0); i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
+
+// cleanup removes deliveries completed longer than Retention ago
+func (d *Dispatcher) cleanup(ctx context.Context) {
+	deleted, err := d.queries.DeleteCompletedWebhookDeliveries(ctx, pgtype.Timestamptz{
+		Time:  time.Now().Add(-d.cfg.Retention),
+		Valid: true,
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			slog.Error("Failed to clean up webhook deliveries", "error", err)
+		}
+		return
+	}
+	if deleted > 0 {
+		slog.Info("Cleaned up webhook deliveries", "count", deleted)
+	}
+}
@@ -0,0 +1,301 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"ris/internal/events"
+	"ris/pkg/postgres/queries"
+)
+
+func testDelivery(url string) queries.ClaimDueWebhookDeliveriesRow {
+	return queries.ClaimDueWebhookDeliveriesRow{
+		ID:        7,
+		WebhookID: 3,
+		EventID:   "evt-1",
+		EventType: events.TypePrizeCreated,
+		Payload:   []byte(`{"id":"evt-1","type":"prize.created","payload":{}}`),
+		Attempts:  2,
+		Url:       url,
+		Secret:    "whsec_test",
+	}
+}
+
+func TestSendSignsRequest(t *testing.T) {
+	type received struct {
+		header http.Header
+		body   []byte
+	}
+	got := make(chan received, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got <- received{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	d := New(nil, Config{Timeout: time.Second, AllowPrivateNetworks: true})
+	delivery := testDelivery(server.URL)
+	res := d.send(context.Background(), delivery)
+	if res.err != nil || res.status != http.StatusNoContent {
+		t.Fatalf("send: status %d, error %v", res.status, res.err)
+	}
+
+	r := <-got
+	if err := Verify(delivery.Secret, r.header.Get(HeaderSignature), r.body, time.Minute, time.Now()); err != nil {
+		t.Errorf("signature of the received body: %v", err)
+	}
+	if err := Verify("whsec_other", r.header.Get(HeaderSignature), r.body, time.Minute, time.Now()); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("signature with another secret: got %v, want %v", err, ErrInvalidSignature)
+	}
+	for header, want := range map[string]string{
+		HeaderWebhookID:  "3",
+		HeaderDeliveryID: "7",
+		HeaderEventID:    "evt-1",
+		HeaderEventType:  events.TypePrizeCreated,
+		HeaderAttempt:    "3",
+		"Content-Type":   "application/json",
+	} {
+		if v := r.header.Get(header); v != want {
+			t.Errorf("%s: got %q, want %q", header, v, want)
+		}
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"evt-1"}`)
+	now := time.Now()
+	header := Sign("secret", now, body)
+
+	tests := []struct {
+		name   string
+		header string
+		body   []byte
+		now    time.Time
+		want   error
+	}{
+		{name: "valid", header: header, body: body, now: now},
+		{name: "modified body", header: header, body: []byte(`{"id":"evt-2"}`), now: now, want: ErrInvalidSignature},
+		{name: "expired", header: header, body: body, now: now.Add(10 * time.Minute), want: ErrInvalidSignature},
+		{name: "malformed", header: "v1=abc", body: body, now: now, want: ErrInvalidSignature},
+		{name: "rotated secret", header: header + ",v1=" + strings.Repeat("0", 64), body: body, now: now},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify("secret", tt.header, tt.body, 5*time.Minute, tt.now); !errors.Is(err, tt.want) {
+				t.Errorf("got %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSendFailures(t *testing.T) {
+	tests := []struct {
+		name       string
+		handler    http.HandlerFunc
+		wantStatus int
+		wantErr    string
+	}{
+		{
+			name: "server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Error(w, "database is down", http.StatusServiceUnavailable)
+			},
+			wantStatus: http.StatusServiceUnavailable,
+			wantErr:    "unexpected status 503: database is down",
+		},
+		{
+			name: "not modified",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotModified)
+			},
+			wantStatus: http.StatusNotModified,
+			wantErr:    "unexpected status 304",
+		},
+		{
+			name: "timeout",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(500 * time.Millisecond)
+			},
+			wantErr: "Client.Timeout exceeded",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+
+			d := New(nil, Config{Timeout: 200 * time.Millisecond, AllowPrivateNetworks: true})
+			res := d.send(context.Background(), testDelivery(server.URL))
+			if res.status != tt.wantStatus {
+				t.Errorf("status: got %d, want %d", res.status, tt.wantStatus)
+			}
+			if res.err == nil || !strings.Contains(res.err.Error(), tt.wantErr) {
+				t.Errorf("error: got %v, want %q", res.err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSendRefusesPrivateAddresses(t *testing.T) {
+	var hits atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+	}))
+	defer server.Close()
+
+	d := New(nil, Config{Timeout: time.Second})
+	for _, url := range []string{server.URL, strings.Replace(server.URL, "127.0.0.1", "localhost", 1)} {
+		res := d.send(context.Background(), testDelivery(url))
+		if !errors.Is(res.err, ErrForbiddenAddress) {
+			t.Errorf("%s: got %v, want %v", url, res.err, ErrForbiddenAddress)
+		}
+	}
+	if n := hits.Load(); n != 0 {
+		t.Errorf("server received %d requests", n)
+	}
+}
+
+func TestPublicAddress(t *testing.T) {
+	tests := map[string]bool{
+		"93.184.215.14":      true,
+		"2606:4700::6810:1":  true,
+		"127.0.0.1":          false,
+		"::1":                false,
+		"10.1.2.3":           false,
+		"172.16.0.1":         false,
+		"192.168.1.1":        false,
+		"100.64.0.1":         false,
+		"169.254.169.254":    false,
+		"fe80::1":            false,
+		"fd00::1":            false,
+		"0.0.0.0":            false,
+		"::":                 false,
+		"224.0.0.1":          false,
+		"::ffff:127.0.0.1":   false,
+		"::ffff:192.168.0.1": false,
+	}
+	for addr, want := range tests {
+		if got := publicAddress(netip.MustParseAddr(addr)); got != want {
+			t.Errorf("%s: got %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	d := New(nil, Config{MinBackoff: 10 * time.Second, MaxBackoff: time.Minute})
+	want := []time.Duration{10 * time.Second, 20 * time.Second, 40 * time.Second, time.Minute, time.Minute}
+	for attempts, w := range want {
+		if got := d.backoff(int32(attempts)); got != w {
+			t.Errorf("after %d attempts: got %s, want %s", attempts+1, got, w)
+		}
+	}
+}
+
+// TestRetry delivers an event to a receiver which fails twice. It needs a dedicated database,
+// set TEST_DATABASE_URL to run it; the schema is applied to it and deliveries of other webhooks are sent.
+func TestRetry(t *testing.T) {
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Close()
+	schema, err := os.ReadFile("../../pkg/postgres/schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pool.Exec(ctx, string(schema)); err != nil {
+		t.Fatalf("apply schema: %v", err)
+	}
+
+	var mu sync.Mutex
+	var attempts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if err := Verify("whsec_retry", r.Header.Get(HeaderSignature), body, time.Minute, time.Now()); err != nil {
+			t.Errorf("attempt %s: %v", r.Header.Get(HeaderAttempt), err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		attempts = append(attempts, r.Header.Get(HeaderAttempt))
+		if len(attempts) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	q := queries.New(pool)
+	webhook, err := q.CreateWebhook(ctx, queries.CreateWebhookParams{
+		Url:        server.URL,
+		EventTypes: []string{AllEvents},
+		Secret:     "whsec_retry",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer q.DeleteWebhook(ctx, webhook.ID)
+
+	d := New(pool, Config{
+		Timeout:              time.Second,
+		MaxAttempts:          5,
+		MinBackoff:           50 * time.Millisecond,
+		MaxBackoff:           100 * time.Millisecond,
+		DisableAfter:         10,
+		BatchSize:            10,
+		Concurrency:          2,
+		AllowPrivateNetworks: true,
+	})
+	meta := events.Metadata{ID: "evt-retry-" + strconv.FormatInt(time.Now().UnixNano(), 10), Type: events.TypePrizeDeleted}
+	if err := d.Handle(ctx, json.RawMessage(`{"id":1}`), meta); err != nil {
+		t.Fatal(err)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	var delivery queries.ListWebhookDeliveriesRow
+	for {
+		if _, err := d.deliverBatch(ctx); err != nil {
+			t.Fatal(err)
+		}
+		rows, err := q.ListWebhookDeliveries(ctx, queries.ListWebhookDeliveriesParams{WebhookID: webhook.ID, RowLimit: 1})
+		if err != nil || len(rows) != 1 {
+			t.Fatalf("list deliveries: %d rows, error %v", len(rows), err)
+		}
+		delivery = rows[0]
+		if delivery.Status != StatusPending || time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+
+	if delivery.Status != StatusDelivered || delivery.Attempts != 3 {
+		t.Errorf("delivery: status %s after %d attempts, want %s after 3", delivery.Status, delivery.Attempts, StatusDelivered)
+	}
+	if delivery.ResponseStatus != (pgtype.Int4{Int32: http.StatusOK, Valid: true}) {
+		t.Errorf("response status: got %v, want 200", delivery.ResponseStatus)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if got := strings.Join(attempts, ","); got != "1,2,3" {
+		t.Errorf("attempt headers: got %s, want 1,2,3", got)
+	}
+}
@@ -0,0 +1,433 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nobel/v1/laureate_service.proto
+
+package nobelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListLaureatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLaureatesRequest) Reset() {
+	*x = ListLaureatesRequest{}
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLaureatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLaureatesRequest) ProtoMessage() {}
+
+func (x *ListLaureatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLaureatesRequest.ProtoReflect.Descriptor instead.
+func (*ListLaureatesRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_laureate_service_proto_rawDescGZIP(), []int{0}
+}
+
+type GetLaureateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLaureateRequest) Reset() {
+	*x = GetLaureateRequest{}
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLaureateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLaureateRequest) ProtoMessage() {}
+
+func (x *GetLaureateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLaureateRequest.ProtoReflect.Descriptor instead.
+func (*GetLaureateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_laureate_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLaureateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreateLaureateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Firstname     string                 `protobuf:"bytes,2,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	Surname       string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	Motivation    string                 `protobuf:"bytes,4,opt,name=motivation,proto3" json:"motivation,omitempty"`
+	Share         int32                  `protobuf:"varint,5,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateLaureateRequest) Reset() {
+	*x = CreateLaureateRequest{}
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateLaureateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLaureateRequest) ProtoMessage() {}
+
+func (x *CreateLaureateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLaureateRequest.ProtoReflect.Descriptor instead.
+func (*CreateLaureateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_laureate_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateLaureateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateLaureateRequest) GetFirstname() string {
+	if x != nil {
+		return x.Firstname
+	}
+	return ""
+}
+
+func (x *CreateLaureateRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *CreateLaureateRequest) GetMotivation() string {
+	if x != nil {
+		return x.Motivation
+	}
+	return ""
+}
+
+func (x *CreateLaureateRequest) GetShare() int32 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type UpdateLaureateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Firstname     string                 `protobuf:"bytes,2,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	Surname       string                 `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	Motivation    string                 `protobuf:"bytes,4,opt,name=motivation,proto3" json:"motivation,omitempty"`
+	Share         int32                  `protobuf:"varint,5,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLaureateRequest) Reset() {
+	*x = UpdateLaureateRequest{}
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLaureateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLaureateRequest) ProtoMessage() {}
+
+func (x *UpdateLaureateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLaureateRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLaureateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_laureate_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateLaureateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateLaureateRequest) GetFirstname() string {
+	if x != nil {
+		return x.Firstname
+	}
+	return ""
+}
+
+func (x *UpdateLaureateRequest) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *UpdateLaureateRequest) GetMotivation() string {
+	if x != nil {
+		return x.Motivation
+	}
+	return ""
+}
+
+func (x *UpdateLaureateRequest) GetShare() int32 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+type DeleteLaureateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLaureateRequest) Reset() {
+	*x = DeleteLaureateRequest{}
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLaureateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLaureateRequest) ProtoMessage() {}
+
+func (x *DeleteLaureateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLaureateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLaureateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_laureate_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteLaureateRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteLaureateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLaureateResponse) Reset() {
+	*x = DeleteLaureateResponse{}
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLaureateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLaureateResponse) ProtoMessage() {}
+
+func (x *DeleteLaureateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_laureate_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLaureateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLaureateResponse) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_laureate_service_proto_rawDescGZIP(), []int{5}
+}
+
+var File_nobel_v1_laureate_service_proto protoreflect.FileDescriptor
+
+const file_nobel_v1_laureate_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1fnobel/v1/laureate_service.proto\x12\bnobel.v1\x1a\x14nobel/v1/types.proto\"\x16\n" +
+	"\x14ListLaureatesRequest\"$\n" +
+	"\x12GetLaureateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x95\x01\n" +
+	"\x15CreateLaureateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
+	"\tfirstname\x18\x02 \x01(\tR\tfirstname\x12\x18\n" +
+	"\asurname\x18\x03 \x01(\tR\asurname\x12\x1e\n" +
+	"\n" +
+	"motivation\x18\x04 \x01(\tR\n" +
+	"motivation\x12\x14\n" +
+	"\x05share\x18\x05 \x01(\x05R\x05share\"\x95\x01\n" +
+	"\x15UpdateLaureateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
+	"\tfirstname\x18\x02 \x01(\tR\tfirstname\x12\x18\n" +
+	"\asurname\x18\x03 \x01(\tR\asurname\x12\x1e\n" +
+	"\n" +
+	"motivation\x18\x04 \x01(\tR\n" +
+	"motivation\x12\x14\n" +
+	"\x05share\x18\x05 \x01(\x05R\x05share\"'\n" +
+	"\x15DeleteLaureateRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x18\n" +
+	"\x16DeleteLaureateResponse2\x8b\x03\n" +
+	"\x0fLaureateService\x12J\n" +
+	"\rListLaureates\x12\x1e.nobel.v1.ListLaureatesRequest\x1a\x12.nobel.v1.Laureate\"\x03\x90\x02\x010\x01\x12D\n" +
+	"\vGetLaureate\x12\x1c.nobel.v1.GetLaureateRequest\x1a\x12.nobel.v1.Laureate\"\x03\x90\x02\x01\x12E\n" +
+	"\x0eCreateLaureate\x12\x1f.nobel.v1.CreateLaureateRequest\x1a\x12.nobel.v1.Laureate\x12E\n" +
+	"\x0eUpdateLaureate\x12\x1f.nobel.v1.UpdateLaureateRequest\x1a\x12.nobel.v1.Laureate\x12X\n" +
+	"\x0eDeleteLaureate\x12\x1f.nobel.v1.DeleteLaureateRequest\x1a .nobel.v1.DeleteLaureateResponse\"\x03\x90\x02\x02B\x1eZ\x1cris/pkg/api/nobel/v1;nobelv1b\x06proto3"
+
+var (
+	file_nobel_v1_laureate_service_proto_rawDescOnce sync.Once
+	file_nobel_v1_laureate_service_proto_rawDescData []byte
+)
+
+func file_nobel_v1_laureate_service_proto_rawDescGZIP() []byte {
+	file_nobel_v1_laureate_service_proto_rawDescOnce.Do(func() {
+		file_nobel_v1_laureate_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nobel_v1_laureate_service_proto_rawDesc), len(file_nobel_v1_laureate_service_proto_rawDesc)))
+	})
+	return file_nobel_v1_laureate_service_proto_rawDescData
+}
+
+var file_nobel_v1_laureate_service_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_nobel_v1_laureate_service_proto_goTypes = []any{
+	(*ListLaureatesRequest)(nil),   // 0: nobel.v1.ListLaureatesRequest
+	(*GetLaureateRequest)(nil),     // 1: nobel.v1.GetLaureateRequest
+	(*CreateLaureateRequest)(nil),  // 2: nobel.v1.CreateLaureateRequest
+	(*UpdateLaureateRequest)(nil),  // 3: nobel.v1.UpdateLaureateRequest
+	(*DeleteLaureateRequest)(nil),  // 4: nobel.v1.DeleteLaureateRequest
+	(*DeleteLaureateResponse)(nil), // 5: nobel.v1.DeleteLaureateResponse
+	(*Laureate)(nil),               // 6: nobel.v1.Laureate
+}
+var file_nobel_v1_laureate_service_proto_depIdxs = []int32{
+	0, // 0: nobel.v1.LaureateService.ListLaureates:input_type -> nobel.v1.ListLaureatesRequest
+	1, // 1: nobel.v1.LaureateService.GetLaureate:input_type -> nobel.v1.GetLaureateRequest
+	2, // 2: nobel.v1.LaureateService.CreateLaureate:input_type -> nobel.v1.CreateLaureateRequest
+	3, // 3: nobel.v1.LaureateService.UpdateLaureate:input_type -> nobel.v1.UpdateLaureateRequest
+	4, // 4: nobel.v1.LaureateService.DeleteLaureate:input_type -> nobel.v1.DeleteLaureateRequest
+	6, // 5: nobel.v1.LaureateService.ListLaureates:output_type -> nobel.v1.Laureate
+	6, // 6: nobel.v1.LaureateService.GetLaureate:output_type -> nobel.v1.Laureate
+	6, // 7: nobel.v1.LaureateService.CreateLaureate:output_type -> nobel.v1.Laureate
+	6, // 8: nobel.v1.LaureateService.UpdateLaureate:output_type -> nobel.v1.Laureate
+	5, // 9: nobel.v1.LaureateService.DeleteLaureate:output_type -> nobel.v1.DeleteLaureateResponse
+	5, // [5:10] is the sub-list for method output_type
+	0, // [0:5] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_nobel_v1_laureate_service_proto_init() }
+func file_nobel_v1_laureate_service_proto_init() {
+	if File_nobel_v1_laureate_service_proto != nil {
+		return
+	}
+	file_nobel_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nobel_v1_laureate_service_proto_rawDesc), len(file_nobel_v1_laureate_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nobel_v1_laureate_service_proto_goTypes,
+		DependencyIndexes: file_nobel_v1_laureate_service_proto_depIdxs,
+		MessageInfos:      file_nobel_v1_laureate_service_proto_msgTypes,
+	}.Build()
+	File_nobel_v1_laureate_service_proto = out.File
+	file_nobel_v1_laureate_service_proto_goTypes = nil
+	file_nobel_v1_laureate_service_proto_depIdxs = nil
+}
@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: nobel/v1/laureate_service.proto
+
+package nobelv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	v1 "ris/pkg/api/nobel/v1"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// LaureateServiceName is the fully-qualified name of the LaureateService service.
+	LaureateServiceName = "nobel.v1.LaureateService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// LaureateServiceListLaureatesProcedure is the fully-qualified name of the LaureateService's
+	// ListLaureates RPC.
+	LaureateServiceListLaureatesProcedure = "/nobel.v1.LaureateService/ListLaureates"
+	// LaureateServiceGetLaureateProcedure is the fully-qualified name of the LaureateService's
+	// GetLaureate RPC.
+	LaureateServiceGetLaureateProcedure = "/nobel.v1.LaureateService/GetLaureate"
+	// LaureateServiceCreateLaureateProcedure is the fully-qualified name of the LaureateService's
+	// CreateLaureate RPC.
+	LaureateServiceCreateLaureateProcedure = "/nobel.v1.LaureateService/CreateLaureate"
+	// LaureateServiceUpdateLaureateProcedure is the fully-qualified name of the LaureateService's
+	// UpdateLaureate RPC.
+	LaureateServiceUpdateLaureateProcedure = "/nobel.v1.LaureateService/UpdateLaureate"
+	// LaureateServiceDeleteLaureateProcedure is the fully-qualified name of the LaureateService's
+	// DeleteLaureate RPC.
+	LaureateServiceDeleteLaureateProcedure = "/nobel.v1.LaureateService/DeleteLaureate"
+)
+
+// LaureateServiceClient is a client for the nobel.v1.LaureateService service.
+type LaureateServiceClient interface {
+	// ListLaureates streams all laureates ordered by id
+	ListLaureates(context.Context, *connect.Request[v1.ListLaureatesRequest]) (*connect.ServerStreamForClient[v1.Laureate], error)
+	// GetLaureate returns a laureate, NOT_FOUND if it does not exist
+	GetLaureate(context.Context, *connect.Request[v1.GetLaureateRequest]) (*connect.Response[v1.Laureate], error)
+	// CreateLaureate creates a laureate, ALREADY_EXISTS if the id is taken
+	CreateLaureate(context.Context, *connect.Request[v1.CreateLaureateRequest]) (*connect.Response[v1.Laureate], error)
+	// UpdateLaureate replaces the fields of a laureate, NOT_FOUND if it does not exist
+	UpdateLaureate(context.Context, *connect.Request[v1.UpdateLaureateRequest]) (*connect.Response[v1.Laureate], error)
+	// DeleteLaureate deletes a laureate, deleting a missing laureate is not an error
+	DeleteLaureate(context.Context, *connect.Request[v1.DeleteLaureateRequest]) (*connect.Response[v1.DeleteLaureateResponse], error)
+}
+
+// NewLaureateServiceClient constructs a client for the nobel.v1.LaureateService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewLaureateServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) LaureateServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	laureateServiceMethods := v1.File_nobel_v1_laureate_service_proto.Services().ByName("LaureateService").Methods()
+	return &laureateServiceClient{
+		listLaureates: connect.NewClient[v1.ListLaureatesRequest, v1.Laureate](
+			httpClient,
+			baseURL+LaureateServiceListLaureatesProcedure,
+			connect.WithSchema(laureateServiceMethods.ByName("ListLaureates")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getLaureate: connect.NewClient[v1.GetLaureateRequest, v1.Laureate](
+			httpClient,
+			baseURL+LaureateServiceGetLaureateProcedure,
+			connect.WithSchema(laureateServiceMethods.ByName("GetLaureate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createLaureate: connect.NewClient[v1.CreateLaureateRequest, v1.Laureate](
+			httpClient,
+			baseURL+LaureateServiceCreateLaureateProcedure,
+			connect.WithSchema(laureateServiceMethods.ByName("CreateLaureate")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLaureate: connect.NewClient[v1.UpdateLaureateRequest, v1.Laureate](
+			httpClient,
+			baseURL+LaureateServiceUpdateLaureateProcedure,
+			connect.WithSchema(laureateServiceMethods.ByName("UpdateLaureate")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteLaureate: connect.NewClient[v1.DeleteLaureateRequest, v1.DeleteLaureateResponse](
+			httpClient,
+			baseURL+LaureateServiceDeleteLaureateProcedure,
+			connect.WithSchema(laureateServiceMethods.ByName("DeleteLaureate")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// laureateServiceClient implements LaureateServiceClient.
+type laureateServiceClient struct {
+	listLaureates  *connect.Client[v1.ListLaureatesRequest, v1.Laureate]
+	getLaureate    *connect.Client[v1.GetLaureateRequest, v1.Laureate]
+	createLaureate *connect.Client[v1.CreateLaureateRequest, v1.Laureate]
+	updateLaureate *connect.Client[v1.UpdateLaureateRequest, v1.Laureate]
+	deleteLaureate *connect.Client[v1.DeleteLaureateRequest, v1.DeleteLaureateResponse]
+}
+
+// ListLaureates calls nobel.v1.LaureateService.ListLaureates.
+func (c *laureateServiceClient) ListLaureates(ctx context.Context, req *connect.Request[v1.ListLaureatesRequest]) (*connect.ServerStreamForClient[v1.Laureate], error) {
+	return c.listLaureates.CallServerStream(ctx, req)
+}
+
+// GetLaureate calls nobel.v1.LaureateService.GetLaureate.
+func (c *laureateServiceClient) GetLaureate(ctx context.Context, req *connect.Request[v1.GetLaureateRequest]) (*connect.Response[v1.Laureate], error) {
+	return c.getLaureate.CallUnary(ctx, req)
+}
+
+// CreateLaureate calls nobel.v1.LaureateService.CreateLaureate.
+func (c *laureateServiceClient) CreateLaureate(ctx context.Context, req *connect.Request[v1.CreateLaureateRequest]) (*connect.Response[v1.Laureate], error) {
+	return c.createLaureate.CallUnary(ctx, req)
+}
+
+// UpdateLaureate calls nobel.v1.LaureateService.UpdateLaureate.
+func (c *laureateServiceClient) UpdateLaureate(ctx context.Context, req *connect.Request[v1.UpdateLaureateRequest]) (*connect.Response[v1.Laureate], error) {
+	return c.updateLaureate.CallUnary(ctx, req)
+}
+
+// DeleteLaureate calls nobel.v1.LaureateService.DeleteLaureate.
+func (c *laureateServiceClient) DeleteLaureate(ctx context.Context, req *connect.Request[v1.DeleteLaureateRequest]) (*connect.Response[v1.DeleteLaureateResponse], error) {
+	return c.deleteLaureate.CallUnary(ctx, req)
+}
+
+// LaureateServiceHandler is an implementation of the nobel.v1.LaureateService service.
+type LaureateServiceHandler interface {
+	// ListLaureates streams all laureates ordered by id
+	ListLaureates(context.Context, *connect.Request[v1.ListLaureatesRequest], *connect.ServerStream[v1.Laureate]) error
+	// GetLaureate returns a laureate, NOT_FOUND if it does not exist
+	GetLaureate(context.Context, *connect.Request[v1.GetLaureateRequest]) (*connect.Response[v1.Laureate], error)
+	// CreateLaureate creates a laureate, ALREADY_EXISTS if the id is taken
+	CreateLaureate(context.Context, *connect.Request[v1.CreateLaureateRequest]) (*connect.Response[v1.Laureate], error)
+	// UpdateLaureate replaces the fields of a laureate, NOT_FOUND if it does not exist
+	UpdateLaureate(context.Context, *connect.Request[v1.UpdateLaureateRequest]) (*connect.Response[v1.Laureate], error)
+	// DeleteLaureate deletes a laureate, deleting a missing laureate is not an error
+	DeleteLaureate(context.Context, *connect.Request[v1.DeleteLaureateRequest]) (*connect.Response[v1.DeleteLaureateResponse], error)
+}
+
+// NewLaureateServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewLaureateServiceHandler(svc LaureateServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	laureateServiceMethods := v1.File_nobel_v1_laureate_service_proto.Services().ByName("LaureateService").Methods()
+	laureateServiceListLaureatesHandler := connect.NewServerStreamHandler(
+		LaureateServiceListLaureatesProcedure,
+		svc.ListLaureates,
+		connect.WithSchema(laureateServiceMethods.ByName("ListLaureates")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	laureateServiceGetLaureateHandler := connect.NewUnaryHandler(
+		LaureateServiceGetLaureateProcedure,
+		svc.GetLaureate,
+		connect.WithSchema(laureateServiceMethods.ByName("GetLaureate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	laureateServiceCreateLaureateHandler := connect.NewUnaryHandler(
+		LaureateServiceCreateLaureateProcedure,
+		svc.CreateLaureate,
+		connect.WithSchema(laureateServiceMethods.ByName("CreateLaureate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	laureateServiceUpdateLaureateHandler := connect.NewUnaryHandler(
+		LaureateServiceUpdateLaureateProcedure,
+		svc.UpdateLaureate,
+		connect.WithSchema(laureateServiceMethods.ByName("UpdateLaureate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	laureateServiceDeleteLaureateHandler := connect.NewUnaryHandler(
+		LaureateServiceDeleteLaureateProcedure,
+		svc.DeleteLaureate,
+		connect.WithSchema(laureateServiceMethods.ByName("DeleteLaureate")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/nobel.v1.LaureateService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case LaureateServiceListLaureatesProcedure:
+			laureateServiceListLaureatesHandler.ServeHTTP(w, r)
+		case LaureateServiceGetLaureateProcedure:
+			laureateServiceGetLaureateHandler.ServeHTTP(w, r)
+		case LaureateServiceCreateLaureateProcedure:
+			laureateServiceCreateLaureateHandler.ServeHTTP(w, r)
+		case LaureateServiceUpdateLaureateProcedure:
+			laureateServiceUpdateLaureateHandler.ServeHTTP(w, r)
+		case LaureateServiceDeleteLaureateProcedure:
+			laureateServiceDeleteLaureateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedLaureateServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedLaureateServiceHandler struct{}
+
+func (UnimplementedLaureateServiceHandler) ListLaureates(context.Context, *connect.Request[v1.ListLaureatesRequest], *connect.ServerStream[v1.Laureate]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.LaureateService.ListLaureates is not implemented"))
+}
+
+func (UnimplementedLaureateServiceHandler) GetLaureate(context.Context, *connect.Request[v1.GetLaureateRequest]) (*connect.Response[v1.Laureate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.LaureateService.GetLaureate is not implemented"))
+}
+
+func (UnimplementedLaureateServiceHandler) CreateLaureate(context.Context, *connect.Request[v1.CreateLaureateRequest]) (*connect.Response[v1.Laureate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.LaureateService.CreateLaureate is not implemented"))
+}
+
+func (UnimplementedLaureateServiceHandler) UpdateLaureate(context.Context, *connect.Request[v1.UpdateLaureateRequest]) (*connect.Response[v1.Laureate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.LaureateService.UpdateLaureate is not implemented"))
+}
+
+func (UnimplementedLaureateServiceHandler) DeleteLaureate(context.Context, *connect.Request[v1.DeleteLaureateRequest]) (*connect.Response[v1.DeleteLaureateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.LaureateService.DeleteLaureate is not implemented"))
+}
@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: nobel/v1/prize_service.proto
+
+package nobelv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	v1 "ris/pkg/api/nobel/v1"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// PrizeServiceName is the fully-qualified name of the PrizeService service.
+	PrizeServiceName = "nobel.v1.PrizeService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// PrizeServiceListPrizesProcedure is the fully-qualified name of the PrizeService's ListPrizes RPC.
+	PrizeServiceListPrizesProcedure = "/nobel.v1.PrizeService/ListPrizes"
+	// PrizeServiceListPrizesByCategoryProcedure is the fully-qualified name of the PrizeService's
+	// ListPrizesByCategory RPC.
+	PrizeServiceListPrizesByCategoryProcedure = "/nobel.v1.PrizeService/ListPrizesByCategory"
+	// PrizeServiceListPrizesByYearProcedure is the fully-qualified name of the PrizeService's
+	// ListPrizesByYear RPC.
+	PrizeServiceListPrizesByYearProcedure = "/nobel.v1.PrizeService/ListPrizesByYear"
+	// PrizeServiceGetPrizeProcedure is the fully-qualified name of the PrizeService's GetPrize RPC.
+	PrizeServiceGetPrizeProcedure = "/nobel.v1.PrizeService/GetPrize"
+	// PrizeServiceCreatePrizeProcedure is the fully-qualified name of the PrizeService's CreatePrize
+	// RPC.
+	PrizeServiceCreatePrizeProcedure = "/nobel.v1.PrizeService/CreatePrize"
+	// PrizeServiceUpdatePrizeProcedure is the fully-qualified name of the PrizeService's UpdatePrize
+	// RPC.
+	PrizeServiceUpdatePrizeProcedure = "/nobel.v1.PrizeService/UpdatePrize"
+	// PrizeServiceDeletePrizeProcedure is the fully-qualified name of the PrizeService's DeletePrize
+	// RPC.
+	PrizeServiceDeletePrizeProcedure = "/nobel.v1.PrizeService/DeletePrize"
+	// PrizeServiceLinkLaureateProcedure is the fully-qualified name of the PrizeService's LinkLaureate
+	// RPC.
+	PrizeServiceLinkLaureateProcedure = "/nobel.v1.PrizeService/LinkLaureate"
+	// PrizeServiceUnlinkLaureateProcedure is the fully-qualified name of the PrizeService's
+	// UnlinkLaureate RPC.
+	PrizeServiceUnlinkLaureateProcedure = "/nobel.v1.PrizeService/UnlinkLaureate"
+	// PrizeServiceGetCategoriesProcedure is the fully-qualified name of the PrizeService's
+	// GetCategories RPC.
+	PrizeServiceGetCategoriesProcedure = "/nobel.v1.PrizeService/GetCategories"
+)
+
+// PrizeServiceClient is a client for the nobel.v1.PrizeService service.
+type PrizeServiceClient interface {
+	// ListPrizes streams all prizes ordered by id, without laureates
+	ListPrizes(context.Context, *connect.Request[v1.ListPrizesRequest]) (*connect.ServerStreamForClient[v1.Prize], error)
+	// ListPrizesByCategory streams the prizes of a category with their laureates, newest first
+	ListPrizesByCategory(context.Context, *connect.Request[v1.ListPrizesByCategoryRequest]) (*connect.ServerStreamForClient[v1.Prize], error)
+	// ListPrizesByYear streams the prizes of a year ordered by category, without laureates
+	ListPrizesByYear(context.Context, *connect.Request[v1.ListPrizesByYearRequest]) (*connect.ServerStreamForClient[v1.Prize], error)
+	// GetPrize returns a prize with its laureates, NOT_FOUND if it does not exist
+	GetPrize(context.Context, *connect.Request[v1.GetPrizeRequest]) (*connect.Response[v1.Prize], error)
+	// CreatePrize creates a prize and links the given laureates, returns it with its laureates
+	CreatePrize(context.Context, *connect.Request[v1.CreatePrizeRequest]) (*connect.Response[v1.Prize], error)
+	// UpdatePrize replaces the fields of a prize, NOT_FOUND if it does not exist
+	UpdatePrize(context.Context, *connect.Request[v1.UpdatePrizeRequest]) (*connect.Response[v1.Prize], error)
+	// DeletePrize deletes a prize, deleting a missing prize is not an error
+	DeletePrize(context.Context, *connect.Request[v1.DeletePrizeRequest]) (*connect.Response[v1.DeletePrizeResponse], error)
+	// LinkLaureate adds a laureate to a prize and returns the prize with its laureates.
+	// Linking an already linked laureate is not an error.
+	LinkLaureate(context.Context, *connect.Request[v1.LinkLaureateRequest]) (*connect.Response[v1.Prize], error)
+	// UnlinkLaureate removes a laureate from a prize and returns the prize with its laureates.
+	// Unlinking a not linked laureate is not an error.
+	UnlinkLaureate(context.Context, *connect.Request[v1.UnlinkLaureateRequest]) (*connect.Response[v1.Prize], error)
+	// GetCategories returns the prize categories ordered by name
+	GetCategories(context.Context, *connect.Request[v1.GetCategoriesRequest]) (*connect.Response[v1.GetCategoriesResponse], error)
+}
+
+// NewPrizeServiceClient constructs a client for the nobel.v1.PrizeService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPrizeServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PrizeServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	prizeServiceMethods := v1.File_nobel_v1_prize_service_proto.Services().ByName("PrizeService").Methods()
+	return &prizeServiceClient{
+		listPrizes: connect.NewClient[v1.ListPrizesRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceListPrizesProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("ListPrizes")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listPrizesByCategory: connect.NewClient[v1.ListPrizesByCategoryRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceListPrizesByCategoryProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("ListPrizesByCategory")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		listPrizesByYear: connect.NewClient[v1.ListPrizesByYearRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceListPrizesByYearProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("ListPrizesByYear")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getPrize: connect.NewClient[v1.GetPrizeRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceGetPrizeProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("GetPrize")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		createPrize: connect.NewClient[v1.CreatePrizeRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceCreatePrizeProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("CreatePrize")),
+			connect.WithClientOptions(opts...),
+		),
+		updatePrize: connect.NewClient[v1.UpdatePrizeRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceUpdatePrizeProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("UpdatePrize")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePrize: connect.NewClient[v1.DeletePrizeRequest, v1.DeletePrizeResponse](
+			httpClient,
+			baseURL+PrizeServiceDeletePrizeProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("DeletePrize")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		linkLaureate: connect.NewClient[v1.LinkLaureateRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceLinkLaureateProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("LinkLaureate")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		unlinkLaureate: connect.NewClient[v1.UnlinkLaureateRequest, v1.Prize](
+			httpClient,
+			baseURL+PrizeServiceUnlinkLaureateProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("UnlinkLaureate")),
+			connect.WithIdempotency(connect.IdempotencyIdempotent),
+			connect.WithClientOptions(opts...),
+		),
+		getCategories: connect.NewClient[v1.GetCategoriesRequest, v1.GetCategoriesResponse](
+			httpClient,
+			baseURL+PrizeServiceGetCategoriesProcedure,
+			connect.WithSchema(prizeServiceMethods.ByName("GetCategories")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// prizeServiceClient implements PrizeServiceClient.
+type prizeServiceClient struct {
+	listPrizes           *connect.Client[v1.ListPrizesRequest, v1.Prize]
+	listPrizesByCategory *connect.Client[v1.ListPrizesByCategoryRequest, v1.Prize]
+	listPrizesByYear     *connect.Client[v1.ListPrizesByYearRequest, v1.Prize]
+	getPrize             *connect.Client[v1.GetPrizeRequest, v1.Prize]
+	createPrize          *connect.Client[v1.CreatePrizeRequest, v1.Prize]
+	updatePrize          *connect.Client[v1.UpdatePrizeRequest, v1.Prize]
+	deletePrize          *connect.Client[v1.DeletePrizeRequest, v1.DeletePrizeResponse]
+	linkLaureate         *connect.Client[v1.LinkLaureateRequest, v1.Prize]
+	unlinkLaureate       *connect.Client[v1.UnlinkLaureateRequest, v1.Prize]
+	getCategories        *connect.Client[v1.GetCategoriesRequest, v1.GetCategoriesResponse]
+}
+
+// ListPrizes calls nobel.v1.PrizeService.ListPrizes.
+func (c *prizeServiceClient) ListPrizes(ctx context.Context, req *connect.Request[v1.ListPrizesRequest]) (*connect.ServerStreamForClient[v1.Prize], error) {
+	return c.listPrizes.CallServerStream(ctx, req)
+}
+
+// ListPrizesByCategory calls nobel.v1.PrizeService.ListPrizesByCategory.
+func (c *prizeServiceClient) ListPrizesByCategory(ctx context.Context, req *connect.Request[v1.ListPrizesByCategoryRequest]) (*connect.ServerStreamForClient[v1.Prize], error) {
+	return c.listPrizesByCategory.CallServerStream(ctx, req)
+}
+
+// ListPrizesByYear calls nobel.v1.PrizeService.ListPrizesByYear.
+func (c *prizeServiceClient) ListPrizesByYear(ctx context.Context, req *connect.Request[v1.ListPrizesByYearRequest]) (*connect.ServerStreamForClient[v1.Prize], error) {
+	return c.listPrizesByYear.CallServerStream(ctx, req)
+}
+
+// GetPrize calls nobel.v1.PrizeService.GetPrize.
+func (c *prizeServiceClient) GetPrize(ctx context.Context, req *connect.Request[v1.GetPrizeRequest]) (*connect.Response[v1.Prize], error) {
+	return c.getPrize.CallUnary(ctx, req)
+}
+
+// CreatePrize calls nobel.v1.PrizeService.CreatePrize.
+func (c *prizeServiceClient) CreatePrize(ctx context.Context, req *connect.Request[v1.CreatePrizeRequest]) (*connect.Response[v1.Prize], error) {
+	return c.createPrize.CallUnary(ctx, req)
+}
+
+// UpdatePrize calls nobel.v1.PrizeService.UpdatePrize.
+func (c *prizeServiceClient) UpdatePrize(ctx context.Context, req *connect.Request[v1.UpdatePrizeRequest]) (*connect.Response[v1.Prize], error) {
+	return c.updatePrize.CallUnary(ctx, req)
+}
+
+// DeletePrize calls nobel.v1.PrizeService.DeletePrize.
+func (c *prizeServiceClient) DeletePrize(ctx context.Context, req *connect.Request[v1.DeletePrizeRequest]) (*connect.Response[v1.DeletePrizeResponse], error) {
+	return c.deletePrize.CallUnary(ctx, req)
+}
+
+// LinkLaureate calls nobel.v1.PrizeService.LinkLaureate.
+func (c *prizeServiceClient) LinkLaureate(ctx context.Context, req *connect.Request[v1.LinkLaureateRequest]) (*connect.Response[v1.Prize], error) {
+	return c.linkLaureate.CallUnary(ctx, req)
+}
+
+// UnlinkLaureate calls nobel.v1.PrizeService.UnlinkLaureate.
+func (c *prizeServiceClient) UnlinkLaureate(ctx context.Context, req *connect.Request[v1.UnlinkLaureateRequest]) (*connect.Response[v1.Prize], error) {
+	return c.unlinkLaureate.CallUnary(ctx, req)
+}
+
+// GetCategories calls nobel.v1.PrizeService.GetCategories.
+func (c *prizeServiceClient) GetCategories(ctx context.Context, req *connect.Request[v1.GetCategoriesRequest]) (*connect.Response[v1.GetCategoriesResponse], error) {
+	return c.getCategories.CallUnary(ctx, req)
+}
+
+// PrizeServiceHandler is an implementation of the nobel.v1.PrizeService service.
+type PrizeServiceHandler interface {
+	// ListPrizes streams all prizes ordered by id, without laureates
+	ListPrizes(context.Context, *connect.Request[v1.ListPrizesRequest], *connect.ServerStream[v1.Prize]) error
+	// ListPrizesByCategory streams the prizes of a category with their laureates, newest first
+	ListPrizesByCategory(context.Context, *connect.Request[v1.ListPrizesByCategoryRequest], *connect.ServerStream[v1.Prize]) error
+	// ListPrizesByYear streams the prizes of a year ordered by category, without laureates
+	ListPrizesByYear(context.Context, *connect.Request[v1.ListPrizesByYearRequest], *connect.ServerStream[v1.Prize]) error
+	// GetPrize returns a prize with its laureates, NOT_FOUND if it does not exist
+	GetPrize(context.Context, *connect.Request[v1.GetPrizeRequest]) (*connect.Response[v1.Prize], error)
+	// CreatePrize creates a prize and links the given laureates, returns it with its laureates
+	CreatePrize(context.Context, *connect.Request[v1.CreatePrizeRequest]) (*connect.Response[v1.Prize], error)
+	// UpdatePrize replaces the fields of a prize, NOT_FOUND if it does not exist
+	UpdatePrize(context.Context, *connect.Request[v1.UpdatePrizeRequest]) (*connect.Response[v1.Prize], error)
+	// DeletePrize deletes a prize, deleting a missing prize is not an error
+	DeletePrize(context.Context, *connect.Request[v1.DeletePrizeRequest]) (*connect.Response[v1.DeletePrizeResponse], error)
+	// LinkLaureate adds a laureate to a prize and returns the prize with its laureates.
+	// Linking an already linked laureate is not an error.
+	LinkLaureate(context.Context, *connect.Request[v1.LinkLaureateRequest]) (*connect.Response[v1.Prize], error)
+	// UnlinkLaureate removes a laureate from a prize and returns the prize with its laureates.
+	// Unlinking a not linked laureate is not an error.
+	UnlinkLaureate(context.Context, *connect.Request[v1.UnlinkLaureateRequest]) (*connect.Response[v1.Prize], error)
+	// GetCategories returns the prize categories ordered by name
+	GetCategories(context.Context, *connect.Request[v1.GetCategoriesRequest]) (*connect.Response[v1.GetCategoriesResponse], error)
+}
+
+// NewPrizeServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPrizeServiceHandler(svc PrizeServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	prizeServiceMethods := v1.File_nobel_v1_prize_service_proto.Services().ByName("PrizeService").Methods()
+	prizeServiceListPrizesHandler := connect.NewServerStreamHandler(
+		PrizeServiceListPrizesProcedure,
+		svc.ListPrizes,
+		connect.WithSchema(prizeServiceMethods.ByName("ListPrizes")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceListPrizesByCategoryHandler := connect.NewServerStreamHandler(
+		PrizeServiceListPrizesByCategoryProcedure,
+		svc.ListPrizesByCategory,
+		connect.WithSchema(prizeServiceMethods.ByName("ListPrizesByCategory")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceListPrizesByYearHandler := connect.NewServerStreamHandler(
+		PrizeServiceListPrizesByYearProcedure,
+		svc.ListPrizesByYear,
+		connect.WithSchema(prizeServiceMethods.ByName("ListPrizesByYear")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceGetPrizeHandler := connect.NewUnaryHandler(
+		PrizeServiceGetPrizeProcedure,
+		svc.GetPrize,
+		connect.WithSchema(prizeServiceMethods.ByName("GetPrize")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceCreatePrizeHandler := connect.NewUnaryHandler(
+		PrizeServiceCreatePrizeProcedure,
+		svc.CreatePrize,
+		connect.WithSchema(prizeServiceMethods.ByName("CreatePrize")),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceUpdatePrizeHandler := connect.NewUnaryHandler(
+		PrizeServiceUpdatePrizeProcedure,
+		svc.UpdatePrize,
+		connect.WithSchema(prizeServiceMethods.ByName("UpdatePrize")),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceDeletePrizeHandler := connect.NewUnaryHandler(
+		PrizeServiceDeletePrizeProcedure,
+		svc.DeletePrize,
+		connect.WithSchema(prizeServiceMethods.ByName("DeletePrize")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceLinkLaureateHandler := connect.NewUnaryHandler(
+		PrizeServiceLinkLaureateProcedure,
+		svc.LinkLaureate,
+		connect.WithSchema(prizeServiceMethods.ByName("LinkLaureate")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceUnlinkLaureateHandler := connect.NewUnaryHandler(
+		PrizeServiceUnlinkLaureateProcedure,
+		svc.UnlinkLaureate,
+		connect.WithSchema(prizeServiceMethods.ByName("UnlinkLaureate")),
+		connect.WithIdempotency(connect.IdempotencyIdempotent),
+		connect.WithHandlerOptions(opts...),
+	)
+	prizeServiceGetCategoriesHandler := connect.NewUnaryHandler(
+		PrizeServiceGetCategoriesProcedure,
+		svc.GetCategories,
+		connect.WithSchema(prizeServiceMethods.ByName("GetCategories")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/nobel.v1.PrizeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PrizeServiceListPrizesProcedure:
+			prizeServiceListPrizesHandler.ServeHTTP(w, r)
+		case PrizeServiceListPrizesByCategoryProcedure:
+			prizeServiceListPrizesByCategoryHandler.ServeHTTP(w, r)
+		case PrizeServiceListPrizesByYearProcedure:
+			prizeServiceListPrizesByYearHandler.ServeHTTP(w, r)
+		case PrizeServiceGetPrizeProcedure:
+			prizeServiceGetPrizeHandler.ServeHTTP(w, r)
+		case PrizeServiceCreatePrizeProcedure:
+			prizeServiceCreatePrizeHandler.ServeHTTP(w, r)
+		case PrizeServiceUpdatePrizeProcedure:
+			prizeServiceUpdatePrizeHandler.ServeHTTP(w, r)
+		case PrizeServiceDeletePrizeProcedure:
+			prizeServiceDeletePrizeHandler.ServeHTTP(w, r)
+		case PrizeServiceLinkLaureateProcedure:
+			prizeServiceLinkLaureateHandler.ServeHTTP(w, r)
+		case PrizeServiceUnlinkLaureateProcedure:
+			prizeServiceUnlinkLaureateHandler.ServeHTTP(w, r)
+		case PrizeServiceGetCategoriesProcedure:
+			prizeServiceGetCategoriesHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPrizeServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPrizeServiceHandler struct{}
+
+func (UnimplementedPrizeServiceHandler) ListPrizes(context.Context, *connect.Request[v1.ListPrizesRequest], *connect.ServerStream[v1.Prize]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.ListPrizes is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) ListPrizesByCategory(context.Context, *connect.Request[v1.ListPrizesByCategoryRequest], *connect.ServerStream[v1.Prize]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.ListPrizesByCategory is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) ListPrizesByYear(context.Context, *connect.Request[v1.ListPrizesByYearRequest], *connect.ServerStream[v1.Prize]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.ListPrizesByYear is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) GetPrize(context.Context, *connect.Request[v1.GetPrizeRequest]) (*connect.Response[v1.Prize], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.GetPrize is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) CreatePrize(context.Context, *connect.Request[v1.CreatePrizeRequest]) (*connect.Response[v1.Prize], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.CreatePrize is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) UpdatePrize(context.Context, *connect.Request[v1.UpdatePrizeRequest]) (*connect.Response[v1.Prize], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.UpdatePrize is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) DeletePrize(context.Context, *connect.Request[v1.DeletePrizeRequest]) (*connect.Response[v1.DeletePrizeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.DeletePrize is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) LinkLaureate(context.Context, *connect.Request[v1.LinkLaureateRequest]) (*connect.Response[v1.Prize], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.LinkLaureate is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) UnlinkLaureate(context.Context, *connect.Request[v1.UnlinkLaureateRequest]) (*connect.Response[v1.Prize], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.UnlinkLaureate is not implemented"))
+}
+
+func (UnimplementedPrizeServiceHandler) GetCategories(context.Context, *connect.Request[v1.GetCategoriesRequest]) (*connect.Response[v1.GetCategoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.PrizeService.GetCategories is not implemented"))
+}
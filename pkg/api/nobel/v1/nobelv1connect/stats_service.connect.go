@@ -0,0 +1,145 @@
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: nobel/v1/stats_service.proto
+
+package nobelv1connect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	errors "errors"
+	http "net/http"
+	v1 "ris/pkg/api/nobel/v1"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// StatsServiceName is the fully-qualified name of the StatsService service.
+	StatsServiceName = "nobel.v1.StatsService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// StatsServiceGetStatsProcedure is the fully-qualified name of the StatsService's GetStats RPC.
+	StatsServiceGetStatsProcedure = "/nobel.v1.StatsService/GetStats"
+	// StatsServiceGetLastUpdateProcedure is the fully-qualified name of the StatsService's
+	// GetLastUpdate RPC.
+	StatsServiceGetLastUpdateProcedure = "/nobel.v1.StatsService/GetLastUpdate"
+)
+
+// StatsServiceClient is a client for the nobel.v1.StatsService service.
+type StatsServiceClient interface {
+	// GetStats returns the number of laureates, prizes and categories
+	GetStats(context.Context, *connect.Request[v1.GetStatsRequest]) (*connect.Response[v1.Stats], error)
+	// GetLastUpdate returns the time of the last change of the dataset
+	GetLastUpdate(context.Context, *connect.Request[v1.GetLastUpdateRequest]) (*connect.Response[v1.GetLastUpdateResponse], error)
+}
+
+// NewStatsServiceClient constructs a client for the nobel.v1.StatsService service. By default, it
+// uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewStatsServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) StatsServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	statsServiceMethods := v1.File_nobel_v1_stats_service_proto.Services().ByName("StatsService").Methods()
+	return &statsServiceClient{
+		getStats: connect.NewClient[v1.GetStatsRequest, v1.Stats](
+			httpClient,
+			baseURL+StatsServiceGetStatsProcedure,
+			connect.WithSchema(statsServiceMethods.ByName("GetStats")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getLastUpdate: connect.NewClient[v1.GetLastUpdateRequest, v1.GetLastUpdateResponse](
+			httpClient,
+			baseURL+StatsServiceGetLastUpdateProcedure,
+			connect.WithSchema(statsServiceMethods.ByName("GetLastUpdate")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// statsServiceClient implements StatsServiceClient.
+type statsServiceClient struct {
+	getStats      *connect.Client[v1.GetStatsRequest, v1.Stats]
+	getLastUpdate *connect.Client[v1.GetLastUpdateRequest, v1.GetLastUpdateResponse]
+}
+
+// GetStats calls nobel.v1.StatsService.GetStats.
+func (c *statsServiceClient) GetStats(ctx context.Context, req *connect.Request[v1.GetStatsRequest]) (*connect.Response[v1.Stats], error) {
+	return c.getStats.CallUnary(ctx, req)
+}
+
+// GetLastUpdate calls nobel.v1.StatsService.GetLastUpdate.
+func (c *statsServiceClient) GetLastUpdate(ctx context.Context, req *connect.Request[v1.GetLastUpdateRequest]) (*connect.Response[v1.GetLastUpdateResponse], error) {
+	return c.getLastUpdate.CallUnary(ctx, req)
+}
+
+// StatsServiceHandler is an implementation of the nobel.v1.StatsService service.
+type StatsServiceHandler interface {
+	// GetStats returns the number of laureates, prizes and categories
+	GetStats(context.Context, *connect.Request[v1.GetStatsRequest]) (*connect.Response[v1.Stats], error)
+	// GetLastUpdate returns the time of the last change of the dataset
+	GetLastUpdate(context.Context, *connect.Request[v1.GetLastUpdateRequest]) (*connect.Response[v1.GetLastUpdateResponse], error)
+}
+
+// NewStatsServiceHandler builds an HTTP handler from the service implementation. It returns the
+// path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewStatsServiceHandler(svc StatsServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	statsServiceMethods := v1.File_nobel_v1_stats_service_proto.Services().ByName("StatsService").Methods()
+	statsServiceGetStatsHandler := connect.NewUnaryHandler(
+		StatsServiceGetStatsProcedure,
+		svc.GetStats,
+		connect.WithSchema(statsServiceMethods.ByName("GetStats")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	statsServiceGetLastUpdateHandler := connect.NewUnaryHandler(
+		StatsServiceGetLastUpdateProcedure,
+		svc.GetLastUpdate,
+		connect.WithSchema(statsServiceMethods.ByName("GetLastUpdate")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/nobel.v1.StatsService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case StatsServiceGetStatsProcedure:
+			statsServiceGetStatsHandler.ServeHTTP(w, r)
+		case StatsServiceGetLastUpdateProcedure:
+			statsServiceGetLastUpdateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedStatsServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedStatsServiceHandler struct{}
+
+func (UnimplementedStatsServiceHandler) GetStats(context.Context, *connect.Request[v1.GetStatsRequest]) (*connect.Response[v1.Stats], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.StatsService.GetStats is not implemented"))
+}
+
+func (UnimplementedStatsServiceHandler) GetLastUpdate(context.Context, *connect.Request[v1.GetLastUpdateRequest]) (*connect.Response[v1.GetLastUpdateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("nobel.v1.StatsService.GetLastUpdate is not implemented"))
+}
@@ -0,0 +1,704 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nobel/v1/prize_service.proto
+
+package nobelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPrizesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrizesRequest) Reset() {
+	*x = ListPrizesRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrizesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrizesRequest) ProtoMessage() {}
+
+func (x *ListPrizesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrizesRequest.ProtoReflect.Descriptor instead.
+func (*ListPrizesRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{0}
+}
+
+type ListPrizesByCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      string                 `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrizesByCategoryRequest) Reset() {
+	*x = ListPrizesByCategoryRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrizesByCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrizesByCategoryRequest) ProtoMessage() {}
+
+func (x *ListPrizesByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrizesByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListPrizesByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPrizesByCategoryRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListPrizesByYearRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPrizesByYearRequest) Reset() {
+	*x = ListPrizesByYearRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPrizesByYearRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrizesByYearRequest) ProtoMessage() {}
+
+func (x *ListPrizesByYearRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrizesByYearRequest.ProtoReflect.Descriptor instead.
+func (*ListPrizesByYearRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPrizesByYearRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type GetPrizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPrizeRequest) Reset() {
+	*x = GetPrizeRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPrizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPrizeRequest) ProtoMessage() {}
+
+func (x *GetPrizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPrizeRequest.ProtoReflect.Descriptor instead.
+func (*GetPrizeRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPrizeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type CreatePrizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Year          int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Category      string                 `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	LaureateIds   []int32                `protobuf:"varint,3,rep,packed,name=laureate_ids,json=laureateIds,proto3" json:"laureate_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePrizeRequest) Reset() {
+	*x = CreatePrizeRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePrizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrizeRequest) ProtoMessage() {}
+
+func (x *CreatePrizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrizeRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrizeRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreatePrizeRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *CreatePrizeRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *CreatePrizeRequest) GetLaureateIds() []int32 {
+	if x != nil {
+		return x.LaureateIds
+	}
+	return nil
+}
+
+type UpdatePrizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Year          int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Category      string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePrizeRequest) Reset() {
+	*x = UpdatePrizeRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePrizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePrizeRequest) ProtoMessage() {}
+
+func (x *UpdatePrizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePrizeRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePrizeRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdatePrizeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdatePrizeRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *UpdatePrizeRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type DeletePrizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePrizeRequest) Reset() {
+	*x = DeletePrizeRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePrizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrizeRequest) ProtoMessage() {}
+
+func (x *DeletePrizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrizeRequest.ProtoReflect.Descriptor instead.
+func (*DeletePrizeRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeletePrizeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeletePrizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePrizeResponse) Reset() {
+	*x = DeletePrizeResponse{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePrizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrizeResponse) ProtoMessage() {}
+
+func (x *DeletePrizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrizeResponse.ProtoReflect.Descriptor instead.
+func (*DeletePrizeResponse) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{7}
+}
+
+type LinkLaureateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrizeId       int32                  `protobuf:"varint,1,opt,name=prize_id,json=prizeId,proto3" json:"prize_id,omitempty"`
+	LaureateId    int32                  `protobuf:"varint,2,opt,name=laureate_id,json=laureateId,proto3" json:"laureate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkLaureateRequest) Reset() {
+	*x = LinkLaureateRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkLaureateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkLaureateRequest) ProtoMessage() {}
+
+func (x *LinkLaureateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkLaureateRequest.ProtoReflect.Descriptor instead.
+func (*LinkLaureateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LinkLaureateRequest) GetPrizeId() int32 {
+	if x != nil {
+		return x.PrizeId
+	}
+	return 0
+}
+
+func (x *LinkLaureateRequest) GetLaureateId() int32 {
+	if x != nil {
+		return x.LaureateId
+	}
+	return 0
+}
+
+type UnlinkLaureateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PrizeId       int32                  `protobuf:"varint,1,opt,name=prize_id,json=prizeId,proto3" json:"prize_id,omitempty"`
+	LaureateId    int32                  `protobuf:"varint,2,opt,name=laureate_id,json=laureateId,proto3" json:"laureate_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlinkLaureateRequest) Reset() {
+	*x = UnlinkLaureateRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlinkLaureateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkLaureateRequest) ProtoMessage() {}
+
+func (x *UnlinkLaureateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkLaureateRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkLaureateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UnlinkLaureateRequest) GetPrizeId() int32 {
+	if x != nil {
+		return x.PrizeId
+	}
+	return 0
+}
+
+func (x *UnlinkLaureateRequest) GetLaureateId() int32 {
+	if x != nil {
+		return x.LaureateId
+	}
+	return 0
+}
+
+type GetCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{10}
+}
+
+type GetCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []string               `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_prize_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_prize_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetCategoriesResponse) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+var File_nobel_v1_prize_service_proto protoreflect.FileDescriptor
+
+const file_nobel_v1_prize_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1cnobel/v1/prize_service.proto\x12\bnobel.v1\x1a\x14nobel/v1/types.proto\"\x13\n" +
+	"\x11ListPrizesRequest\"9\n" +
+	"\x1bListPrizesByCategoryRequest\x12\x1a\n" +
+	"\bcategory\x18\x01 \x01(\tR\bcategory\"-\n" +
+	"\x17ListPrizesByYearRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\"!\n" +
+	"\x0fGetPrizeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"g\n" +
+	"\x12CreatePrizeRequest\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12!\n" +
+	"\flaureate_ids\x18\x03 \x03(\x05R\vlaureateIds\"T\n" +
+	"\x12UpdatePrizeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\"$\n" +
+	"\x12DeletePrizeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"\x15\n" +
+	"\x13DeletePrizeResponse\"Q\n" +
+	"\x13LinkLaureateRequest\x12\x19\n" +
+	"\bprize_id\x18\x01 \x01(\x05R\aprizeId\x12\x1f\n" +
+	"\vlaureate_id\x18\x02 \x01(\x05R\n" +
+	"laureateId\"S\n" +
+	"\x15UnlinkLaureateRequest\x12\x19\n" +
+	"\bprize_id\x18\x01 \x01(\x05R\aprizeId\x12\x1f\n" +
+	"\vlaureate_id\x18\x02 \x01(\x05R\n" +
+	"laureateId\"\x16\n" +
+	"\x14GetCategoriesRequest\"7\n" +
+	"\x15GetCategoriesResponse\x12\x1e\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\tR\n" +
+	"categories2\xe6\x05\n" +
+	"\fPrizeService\x12A\n" +
+	"\n" +
+	"ListPrizes\x12\x1b.nobel.v1.ListPrizesRequest\x1a\x0f.nobel.v1.Prize\"\x03\x90\x02\x010\x01\x12U\n" +
+	"\x14ListPrizesByCategory\x12%.nobel.v1.ListPrizesByCategoryRequest\x1a\x0f.nobel.v1.Prize\"\x03\x90\x02\x010\x01\x12M\n" +
+	"\x10ListPrizesByYear\x12!.nobel.v1.ListPrizesByYearRequest\x1a\x0f.nobel.v1.Prize\"\x03\x90\x02\x010\x01\x12;\n" +
+	"\bGetPrize\x12\x19.nobel.v1.GetPrizeRequest\x1a\x0f.nobel.v1.Prize\"\x03\x90\x02\x01\x12<\n" +
+	"\vCreatePrize\x12\x1c.nobel.v1.CreatePrizeRequest\x1a\x0f.nobel.v1.Prize\x12<\n" +
+	"\vUpdatePrize\x12\x1c.nobel.v1.UpdatePrizeRequest\x1a\x0f.nobel.v1.Prize\x12O\n" +
+	"\vDeletePrize\x12\x1c.nobel.v1.DeletePrizeRequest\x1a\x1d.nobel.v1.DeletePrizeResponse\"\x03\x90\x02\x02\x12C\n" +
+	"\fLinkLaureate\x12\x1d.nobel.v1.LinkLaureateRequest\x1a\x0f.nobel.v1.Prize\"\x03\x90\x02\x02\x12G\n" +
+	"\x0eUnlinkLaureate\x12\x1f.nobel.v1.UnlinkLaureateRequest\x1a\x0f.nobel.v1.Prize\"\x03\x90\x02\x02\x12U\n" +
+	"\rGetCategories\x12\x1e.nobel.v1.GetCategoriesRequest\x1a\x1f.nobel.v1.GetCategoriesResponse\"\x03\x90\x02\x01B\x1eZ\x1cris/pkg/api/nobel/v1;nobelv1b\x06proto3"
+
+var (
+	file_nobel_v1_prize_service_proto_rawDescOnce sync.Once
+	file_nobel_v1_prize_service_proto_rawDescData []byte
+)
+
+func file_nobel_v1_prize_service_proto_rawDescGZIP() []byte {
+	file_nobel_v1_prize_service_proto_rawDescOnce.Do(func() {
+		file_nobel_v1_prize_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nobel_v1_prize_service_proto_rawDesc), len(file_nobel_v1_prize_service_proto_rawDesc)))
+	})
+	return file_nobel_v1_prize_service_proto_rawDescData
+}
+
+var file_nobel_v1_prize_service_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_nobel_v1_prize_service_proto_goTypes = []any{
+	(*ListPrizesRequest)(nil),           // 0: nobel.v1.ListPrizesRequest
+	(*ListPrizesByCategoryRequest)(nil), // 1: nobel.v1.ListPrizesByCategoryRequest
+	(*ListPrizesByYearRequest)(nil),     // 2: nobel.v1.ListPrizesByYearRequest
+	(*GetPrizeRequest)(nil),             // 3: nobel.v1.GetPrizeRequest
+	(*CreatePrizeRequest)(nil),          // 4: nobel.v1.CreatePrizeRequest
+	(*UpdatePrizeRequest)(nil),          // 5: nobel.v1.UpdatePrizeRequest
+	(*DeletePrizeRequest)(nil),          // 6: nobel.v1.DeletePrizeRequest
+	(*DeletePrizeResponse)(nil),         // 7: nobel.v1.DeletePrizeResponse
+	(*LinkLaureateRequest)(nil),         // 8: nobel.v1.LinkLaureateRequest
+	(*UnlinkLaureateRequest)(nil),       // 9: nobel.v1.UnlinkLaureateRequest
+	(*GetCategoriesRequest)(nil),        // 10: nobel.v1.GetCategoriesRequest
+	(*GetCategoriesResponse)(nil),       // 11: nobel.v1.GetCategoriesResponse
+	(*Prize)(nil),                       // 12: nobel.v1.Prize
+}
+var file_nobel_v1_prize_service_proto_depIdxs = []int32{
+	0,  // 0: nobel.v1.PrizeService.ListPrizes:input_type -> nobel.v1.ListPrizesRequest
+	1,  // 1: nobel.v1.PrizeService.ListPrizesByCategory:input_type -> nobel.v1.ListPrizesByCategoryRequest
+	2,  // 2: nobel.v1.PrizeService.ListPrizesByYear:input_type -> nobel.v1.ListPrizesByYearRequest
+	3,  // 3: nobel.v1.PrizeService.GetPrize:input_type -> nobel.v1.GetPrizeRequest
+	4,  // 4: nobel.v1.PrizeService.CreatePrize:input_type -> nobel.v1.CreatePrizeRequest
+	5,  // 5: nobel.v1.PrizeService.UpdatePrize:input_type -> nobel.v1.UpdatePrizeRequest
+	6,  // 6: nobel.v1.PrizeService.DeletePrize:input_type -> nobel.v1.DeletePrizeRequest
+	8,  // 7: nobel.v1.PrizeService.LinkLaureate:input_type -> nobel.v1.LinkLaureateRequest
+	9,  // 8: nobel.v1.PrizeService.UnlinkLaureate:input_type -> nobel.v1.UnlinkLaureateRequest
+	10, // 9: nobel.v1.PrizeService.GetCategories:input_type -> nobel.v1.GetCategoriesRequest
+	12, // 10: nobel.v1.PrizeService.ListPrizes:output_type -> nobel.v1.Prize
+	12, // 11: nobel.v1.PrizeService.ListPrizesByCategory:output_type -> nobel.v1.Prize
+	12, // 12: nobel.v1.PrizeService.ListPrizesByYear:output_type -> nobel.v1.Prize
+	12, // 13: nobel.v1.PrizeService.GetPrize:output_type -> nobel.v1.Prize
+	12, // 14: nobel.v1.PrizeService.CreatePrize:output_type -> nobel.v1.Prize
+	12, // 15: nobel.v1.PrizeService.UpdatePrize:output_type -> nobel.v1.Prize
+	7,  // 16: nobel.v1.PrizeService.DeletePrize:output_type -> nobel.v1.DeletePrizeResponse
+	12, // 17: nobel.v1.PrizeService.LinkLaureate:output_type -> nobel.v1.Prize
+	12, // 18: nobel.v1.PrizeService.UnlinkLaureate:output_type -> nobel.v1.Prize
+	11, // 19: nobel.v1.PrizeService.GetCategories:output_type -> nobel.v1.GetCategoriesResponse
+	10, // [10:20] is the sub-list for method output_type
+	0,  // [0:10] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_nobel_v1_prize_service_proto_init() }
+func file_nobel_v1_prize_service_proto_init() {
+	if File_nobel_v1_prize_service_proto != nil {
+		return
+	}
+	file_nobel_v1_types_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nobel_v1_prize_service_proto_rawDesc), len(file_nobel_v1_prize_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   12,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nobel_v1_prize_service_proto_goTypes,
+		DependencyIndexes: file_nobel_v1_prize_service_proto_depIdxs,
+		MessageInfos:      file_nobel_v1_prize_service_proto_msgTypes,
+	}.Build()
+	File_nobel_v1_prize_service_proto = out.File
+	file_nobel_v1_prize_service_proto_goTypes = nil
+	file_nobel_v1_prize_service_proto_depIdxs = nil
+}
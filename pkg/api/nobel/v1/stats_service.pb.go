@@ -0,0 +1,274 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nobel/v1/stats_service.proto
+
+package nobelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_stats_service_proto_rawDescGZIP(), []int{0}
+}
+
+type Stats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	LaureatesCount  int64                  `protobuf:"varint,1,opt,name=laureates_count,json=laureatesCount,proto3" json:"laureates_count,omitempty"`
+	PrizesCount     int64                  `protobuf:"varint,2,opt,name=prizes_count,json=prizesCount,proto3" json:"prizes_count,omitempty"`
+	CategoriesCount int64                  `protobuf:"varint,3,opt,name=categories_count,json=categoriesCount,proto3" json:"categories_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Stats) Reset() {
+	*x = Stats{}
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stats) ProtoMessage() {}
+
+func (x *Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stats.ProtoReflect.Descriptor instead.
+func (*Stats) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_stats_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Stats) GetLaureatesCount() int64 {
+	if x != nil {
+		return x.LaureatesCount
+	}
+	return 0
+}
+
+func (x *Stats) GetPrizesCount() int64 {
+	if x != nil {
+		return x.PrizesCount
+	}
+	return 0
+}
+
+func (x *Stats) GetCategoriesCount() int64 {
+	if x != nil {
+		return x.CategoriesCount
+	}
+	return 0
+}
+
+type GetLastUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLastUpdateRequest) Reset() {
+	*x = GetLastUpdateRequest{}
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastUpdateRequest) ProtoMessage() {}
+
+func (x *GetLastUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastUpdateRequest.ProtoReflect.Descriptor instead.
+func (*GetLastUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_stats_service_proto_rawDescGZIP(), []int{2}
+}
+
+type GetLastUpdateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LastUpdate    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=last_update,json=lastUpdate,proto3" json:"last_update,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLastUpdateResponse) Reset() {
+	*x = GetLastUpdateResponse{}
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLastUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastUpdateResponse) ProtoMessage() {}
+
+func (x *GetLastUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_stats_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastUpdateResponse.ProtoReflect.Descriptor instead.
+func (*GetLastUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_stats_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLastUpdateResponse) GetLastUpdate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdate
+	}
+	return nil
+}
+
+var File_nobel_v1_stats_service_proto protoreflect.FileDescriptor
+
+const file_nobel_v1_stats_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1cnobel/v1/stats_service.proto\x12\bnobel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x11\n" +
+	"\x0fGetStatsRequest\"~\n" +
+	"\x05Stats\x12'\n" +
+	"\x0flaureates_count\x18\x01 \x01(\x03R\x0elaureatesCount\x12!\n" +
+	"\fprizes_count\x18\x02 \x01(\x03R\vprizesCount\x12)\n" +
+	"\x10categories_count\x18\x03 \x01(\x03R\x0fcategoriesCount\"\x16\n" +
+	"\x14GetLastUpdateRequest\"T\n" +
+	"\x15GetLastUpdateResponse\x12;\n" +
+	"\vlast_update\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUpdate2\xa2\x01\n" +
+	"\fStatsService\x12;\n" +
+	"\bGetStats\x12\x19.nobel.v1.GetStatsRequest\x1a\x0f.nobel.v1.Stats\"\x03\x90\x02\x01\x12U\n" +
+	"\rGetLastUpdate\x12\x1e.nobel.v1.GetLastUpdateRequest\x1a\x1f.nobel.v1.GetLastUpdateResponse\"\x03\x90\x02\x01B\x1eZ\x1cris/pkg/api/nobel/v1;nobelv1b\x06proto3"
+
+var (
+	file_nobel_v1_stats_service_proto_rawDescOnce sync.Once
+	file_nobel_v1_stats_service_proto_rawDescData []byte
+)
+
+func file_nobel_v1_stats_service_proto_rawDescGZIP() []byte {
+	file_nobel_v1_stats_service_proto_rawDescOnce.Do(func() {
+		file_nobel_v1_stats_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nobel_v1_stats_service_proto_rawDesc), len(file_nobel_v1_stats_service_proto_rawDesc)))
+	})
+	return file_nobel_v1_stats_service_proto_rawDescData
+}
+
+var file_nobel_v1_stats_service_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_nobel_v1_stats_service_proto_goTypes = []any{
+	(*GetStatsRequest)(nil),       // 0: nobel.v1.GetStatsRequest
+	(*Stats)(nil),                 // 1: nobel.v1.Stats
+	(*GetLastUpdateRequest)(nil),  // 2: nobel.v1.GetLastUpdateRequest
+	(*GetLastUpdateResponse)(nil), // 3: nobel.v1.GetLastUpdateResponse
+	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+}
+var file_nobel_v1_stats_service_proto_depIdxs = []int32{
+	4, // 0: nobel.v1.GetLastUpdateResponse.last_update:type_name -> google.protobuf.Timestamp
+	0, // 1: nobel.v1.StatsService.GetStats:input_type -> nobel.v1.GetStatsRequest
+	2, // 2: nobel.v1.StatsService.GetLastUpdate:input_type -> nobel.v1.GetLastUpdateRequest
+	1, // 3: nobel.v1.StatsService.GetStats:output_type -> nobel.v1.Stats
+	3, // 4: nobel.v1.StatsService.GetLastUpdate:output_type -> nobel.v1.GetLastUpdateResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_nobel_v1_stats_service_proto_init() }
+func file_nobel_v1_stats_service_proto_init() {
+	if File_nobel_v1_stats_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nobel_v1_stats_service_proto_rawDesc), len(file_nobel_v1_stats_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_nobel_v1_stats_service_proto_goTypes,
+		DependencyIndexes: file_nobel_v1_stats_service_proto_depIdxs,
+		MessageInfos:      file_nobel_v1_stats_service_proto_msgTypes,
+	}.Build()
+	File_nobel_v1_stats_service_proto = out.File
+	file_nobel_v1_stats_service_proto_goTypes = nil
+	file_nobel_v1_stats_service_proto_depIdxs = nil
+}
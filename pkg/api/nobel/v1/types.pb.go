@@ -0,0 +1,264 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.12
+// 	protoc        (unknown)
+// source: nobel/v1/types.proto
+
+package nobelv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Laureate is a Nobel laureate
+type Laureate struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Firstname string                 `protobuf:"bytes,2,opt,name=firstname,proto3" json:"firstname,omitempty"`
+	// Empty for organizations
+	Surname    string `protobuf:"bytes,3,opt,name=surname,proto3" json:"surname,omitempty"`
+	Motivation string `protobuf:"bytes,4,opt,name=motivation,proto3" json:"motivation,omitempty"`
+	// Share of the prize, 1 to 4
+	Share         int32                  `protobuf:"varint,5,opt,name=share,proto3" json:"share,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Laureate) Reset() {
+	*x = Laureate{}
+	mi := &file_nobel_v1_types_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Laureate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Laureate) ProtoMessage() {}
+
+func (x *Laureate) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_types_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Laureate.ProtoReflect.Descriptor instead.
+func (*Laureate) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Laureate) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Laureate) GetFirstname() string {
+	if x != nil {
+		return x.Firstname
+	}
+	return ""
+}
+
+func (x *Laureate) GetSurname() string {
+	if x != nil {
+		return x.Surname
+	}
+	return ""
+}
+
+func (x *Laureate) GetMotivation() string {
+	if x != nil {
+		return x.Motivation
+	}
+	return ""
+}
+
+func (x *Laureate) GetShare() int32 {
+	if x != nil {
+		return x.Share
+	}
+	return 0
+}
+
+func (x *Laureate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Prize is a Nobel prize
+type Prize struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Year     int32                  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Category string                 `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	// Set by the methods documented to return laureates
+	Laureates     []*Laureate            `protobuf:"bytes,4,rep,name=laureates,proto3" json:"laureates,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Prize) Reset() {
+	*x = Prize{}
+	mi := &file_nobel_v1_types_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Prize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Prize) ProtoMessage() {}
+
+func (x *Prize) ProtoReflect() protoreflect.Message {
+	mi := &file_nobel_v1_types_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Prize.ProtoReflect.Descriptor instead.
+func (*Prize) Descriptor() ([]byte, []int) {
+	return file_nobel_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Prize) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Prize) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *Prize) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Prize) GetLaureates() []*Laureate {
+	if x != nil {
+		return x.Laureates
+	}
+	return nil
+}
+
+func (x *Prize) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_nobel_v1_types_proto protoreflect.FileDescriptor
+
+const file_nobel_v1_types_proto_rawDesc = "" +
+	"\n" +
+	"\x14nobel/v1/types.proto\x12\bnobel.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc3\x01\n" +
+	"\bLaureate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1c\n" +
+	"\tfirstname\x18\x02 \x01(\tR\tfirstname\x12\x18\n" +
+	"\asurname\x18\x03 \x01(\tR\asurname\x12\x1e\n" +
+	"\n" +
+	"motivation\x18\x04 \x01(\tR\n" +
+	"motivation\x12\x14\n" +
+	"\x05share\x18\x05 \x01(\x05R\x05share\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb4\x01\n" +
+	"\x05Prize\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\x12\x1a\n" +
+	"\bcategory\x18\x03 \x01(\tR\bcategory\x120\n" +
+	"\tlaureates\x18\x04 \x03(\v2\x12.nobel.v1.LaureateR\tlaureates\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x1eZ\x1cris/pkg/api/nobel/v1;nobelv1b\x06proto3"
+
+var (
+	file_nobel_v1_types_proto_rawDescOnce sync.Once
+	file_nobel_v1_types_proto_rawDescData []byte
+)
+
+func file_nobel_v1_types_proto_rawDescGZIP() []byte {
+	file_nobel_v1_types_proto_rawDescOnce.Do(func() {
+		file_nobel_v1_types_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_nobel_v1_types_proto_rawDesc), len(file_nobel_v1_types_proto_rawDesc)))
+	})
+	return file_nobel_v1_types_proto_rawDescData
+}
+
+var file_nobel_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_nobel_v1_types_proto_goTypes = []any{
+	(*Laureate)(nil),              // 0: nobel.v1.Laureate
+	(*Prize)(nil),                 // 1: nobel.v1.Prize
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_nobel_v1_types_proto_depIdxs = []int32{
+	2, // 0: nobel.v1.Laureate.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: nobel.v1.Prize.laureates:type_name -> nobel.v1.Laureate
+	2, // 2: nobel.v1.Prize.updated_at:type_name -> google.protobuf.Timestamp
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_nobel_v1_types_proto_init() }
+func file_nobel_v1_types_proto_init() {
+	if File_nobel_v1_types_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_nobel_v1_types_proto_rawDesc), len(file_nobel_v1_types_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_nobel_v1_types_proto_goTypes,
+		DependencyIndexes: file_nobel_v1_types_proto_depIdxs,
+		MessageInfos:      file_nobel_v1_types_proto_msgTypes,
+	}.Build()
+	File_nobel_v1_types_proto = out.File
+	file_nobel_v1_types_proto_goTypes = nil
+	file_nobel_v1_types_proto_depIdxs = nil
+}
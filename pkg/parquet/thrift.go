@@ -0,0 +1,86 @@
+package parquet
+
+import "encoding/binary"
+
+// Types of the Thrift compact protocol
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// encoder writes Thrift structs with the compact protocol, the encoding of the Parquet metadata
+type encoder struct {
+	buf    []byte
+	lastID int16   // id of the last field of the current struct
+	stack  []int16 // last field ids of the enclosing structs
+}
+
+// field writes a field header, ids of consecutive fields are delta encoded
+func (e *encoder) field(id int16, typ byte) {
+	if delta := id - e.lastID; delta > 0 && delta <= 15 {
+		e.buf = append(e.buf, byte(delta)<<4|typ)
+	} else {
+		e.buf = append(e.buf, typ)
+		e.buf = binary.AppendVarint(e.buf, int64(id))
+	}
+	e.lastID = id
+}
+
+func (e *encoder) i32(id int16, v int32) {
+	e.field(id, thriftI32)
+	e.buf = binary.AppendVarint(e.buf, int64(v))
+}
+
+func (e *encoder) i64(id int16, v int64) {
+	e.field(id, thriftI64)
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+func (e *encoder) string(id int16, s string) {
+	e.field(id, thriftBinary)
+	e.appendString(s)
+}
+
+// list writes the header of a list, elements are appended without field headers
+func (e *encoder) list(id int16, elemType byte, size int) {
+	e.field(id, thriftList)
+	if size < 15 {
+		e.buf = append(e.buf, byte(size)<<4|elemType)
+		return
+	}
+	e.buf = append(e.buf, 0xf0|elemType)
+	e.buf = binary.AppendUvarint(e.buf, uint64(size))
+}
+
+func (e *encoder) appendI32(v int32) {
+	e.buf = binary.AppendVarint(e.buf, int64(v))
+}
+
+func (e *encoder) appendString(s string) {
+	e.buf = binary.AppendUvarint(e.buf, uint64(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+// structField starts a struct field, it is ended by end
+func (e *encoder) structField(id int16) {
+	e.field(id, thriftStruct)
+	e.begin()
+}
+
+// begin starts a nested struct, e.g. an element of a list
+func (e *encoder) begin() {
+	e.stack = append(e.stack, e.lastID)
+	e.lastID = 0
+}
+
+// end writes the stop field of the current struct
+func (e *encoder) end() {
+	e.buf = append(e.buf, 0)
+	if n := len(e.stack); n > 0 {
+		e.lastID = e.stack[n-1]
+		e.stack = e.stack[:n-1]
+	}
+}
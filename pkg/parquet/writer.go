@@ -0,0 +1,332 @@
+// Package parquet writes Parquet files with flat schemas.
+//
+// It covers what the dataset exports need: INT32, INT64, UTF-8 string and timestamp columns,
+// required or optional, PLAIN encoded and uncompressed. Rows are buffered per row group,
+// so memory use depends on the row group size and not on the size of the file.
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Type is the type of the values of a column
+type Type int
+
+// Column types
+const (
+	Int32     Type = iota // int32 values
+	Int64                 // int64 values
+	String                // string values
+	Timestamp             // time.Time values, stored as UTC microseconds
+)
+
+// Column describes a column of a file, values of optional columns may be nil
+type Column struct {
+	Name     string
+	Type     Type
+	Optional bool
+}
+
+// DefaultRowGroupSize is the number of rows of a row group if NewWriter gets 0
+const DefaultRowGroupSize = 10000
+
+// Values of the Parquet format
+const (
+	physicalInt32     = 1
+	physicalInt64     = 2
+	physicalByteArray = 6
+
+	convertedUTF8            = 0
+	convertedTimestampMicros = 10
+
+	repetitionRequired = 0
+	repetitionOptional = 1
+
+	encodingPlain = 0
+	encodingRLE   = 3
+
+	pageTypeData      = 0
+	codecUncompressed = 0
+)
+
+var magic = []byte("PAR1")
+
+// physical returns the physical and the converted type of a column type, -1 if there is no converted type
+func (t Type) physical() (int32, int32) {
+	switch t {
+	case Int32:
+		return physicalInt32, -1
+	case Int64:
+		return physicalInt64, -1
+	case String:
+		return physicalByteArray, convertedUTF8
+	default:
+		return physicalInt64, convertedTimestampMicros
+	}
+}
+
+// Writer writes rows to a Parquet file. The file is complete after Close.
+type Writer struct {
+	w            io.Writer
+	columns      []Column
+	rowGroupSize int
+	err          error
+
+	offset    int64         // bytes written so far
+	chunks    []columnChunk // columns of the current row group
+	rows      int           // rows of the current row group
+	rowGroups []rowGroup    // written row groups
+	numRows   int64
+}
+
+// columnChunk buffers the values of a column of the current row group
+type columnChunk struct {
+	values  []byte // PLAIN encoded non-null values
+	defined []bool // definition levels of optional columns
+}
+
+type rowGroup struct {
+	chunks []chunkInfo
+	size   int64
+	rows   int64
+}
+
+type chunkInfo struct {
+	offset int64
+	size   int64
+}
+
+// NewWriter creates a writer of a file with the columns
+func NewWriter(w io.Writer, columns []Column, rowGroupSize int) *Writer {
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+	return &Writer{
+		w:            w,
+		columns:      columns,
+		rowGroupSize: rowGroupSize,
+		chunks:       make([]columnChunk, len(columns)),
+	}
+}
+
+// Write adds a row with a value for each column. After an error the writer is unusable.
+func (w *Writer) Write(row []any) error {
+	if w.err != nil {
+		return w.err
+	}
+	if len(row) != len(w.columns) {
+		w.err = fmt.Errorf("row has %d values, expected %d", len(row), len(w.columns))
+		return w.err
+	}
+	for i, v := range row {
+		if err := w.chunks[i].append(w.columns[i], v); err != nil {
+			w.err = err
+			return err
+		}
+	}
+	w.rows++
+	if w.rows >= w.rowGroupSize {
+		return w.flush()
+	}
+	return nil
+}
+
+// Close writes the buffered rows and the footer, it does not close the underlying writer
+func (w *Writer) Close() error {
+	if err := w.flush(); err != nil {
+		return err
+	}
+	if w.offset == 0 {
+		w.write(magic)
+	}
+	footer := w.footer()
+	w.write(footer)
+	w.write(binary.LittleEndian.AppendUint32(nil, uint32(len(footer))))
+	w.write(magic)
+	return w.err
+}
+
+func (c *columnChunk) append(col Column, v any) error {
+	if v == nil {
+		if !col.Optional {
+			return fmt.Errorf("column %s: null value in a required column", col.Name)
+		}
+		c.defined = append(c.defined, false)
+		return nil
+	}
+	ok := false
+	switch col.Type {
+	case Int32:
+		var x int32
+		if x, ok = v.(int32); ok {
+			c.values = binary.LittleEndian.AppendUint32(c.values, uint32(x))
+		}
+	case Int64:
+		var x int64
+		if x, ok = v.(int64); ok {
+			c.values = binary.LittleEndian.AppendUint64(c.values, uint64(x))
+		}
+	case String:
+		var x string
+		if x, ok = v.(string); ok {
+			c.values = binary.LittleEndian.AppendUint32(c.values, uint32(len(x)))
+			c.values = append(c.values, x...)
+		}
+	case Timestamp:
+		var x time.Time
+		if x, ok = v.(time.Time); ok {
+			c.values = binary.LittleEndian.AppendUint64(c.values, uint64(x.UnixMicro()))
+		}
+	}
+	if !ok {
+		return fmt.Errorf("column %s: unexpected value of type %T", col.Name, v)
+	}
+	if col.Optional {
+		c.defined = append(c.defined, true)
+	}
+	return nil
+}
+
+// flush writes the current row group, each column as a single data page
+func (w *Writer) flush() error {
+	if w.err != nil || w.rows == 0 {
+		return w.err
+	}
+	if w.offset == 0 {
+		w.write(magic)
+	}
+	group := rowGroup{rows: int64(w.rows)}
+	for i, col := range w.columns {
+		chunk := &w.chunks[i]
+		var page []byte
+		if col.Optional {
+			page = appendLevels(page, chunk.defined)
+		}
+		page = append(page, chunk.values...)
+
+		var header encoder
+		header.i32(1, pageTypeData)
+		header.i32(2, int32(len(page))) // uncompressed size
+		header.i32(3, int32(len(page))) // compressed size
+		header.structField(5)           // data page header
+		header.i32(1, int32(w.rows))
+		header.i32(2, encodingPlain)
+		header.i32(3, encodingRLE) // definition levels
+		header.i32(4, encodingRLE) // repetition levels
+		header.end()
+		header.end()
+
+		info := chunkInfo{offset: w.offset, size: int64(len(header.buf) + len(page))}
+		w.write(header.buf)
+		w.write(page)
+		group.chunks = append(group.chunks, info)
+		group.size += info.size
+		chunk.values, chunk.defined = chunk.values[:0], chunk.defined[:0]
+	}
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += group.rows
+	w.rows = 0
+	return w.err
+}
+
+// appendLevels appends the definition levels of an optional column:
+// the length of the levels followed by RLE runs of bit width 1
+func appendLevels(buf []byte, defined []bool) []byte {
+	start := len(buf)
+	buf = append(buf, 0, 0, 0, 0)
+	for i := 0; i < len(defined); {
+		j := i + 1
+		for j < len(defined) && defined[j] == defined[i] {
+			j++
+		}
+		buf = binary.AppendUvarint(buf, uint64(j-i)<<1)
+		if defined[i] {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+		i = j
+	}
+	binary.LittleEndian.PutUint32(buf[start:], uint32(len(buf)-start-4))
+	return buf
+}
+
+// footer encodes the file metadata
+func (w *Writer) footer() []byte {
+	var e encoder
+	e.i32(1, 1) // version
+
+	// The schema is a root element followed by the columns
+	e.list(2, thriftStruct, len(w.columns)+1)
+	e.begin()
+	e.string(4, "schema")
+	e.i32(5, int32(len(w.columns)))
+	e.end()
+	for _, col := range w.columns {
+		physical, converted := col.Type.physical()
+		e.begin()
+		e.i32(1, physical)
+		if col.Optional {
+			e.i32(3, repetitionOptional)
+		} else {
+			e.i32(3, repetitionRequired)
+		}
+		e.string(4, col.Name)
+		if converted >= 0 {
+			e.i32(6, converted)
+		}
+		e.end()
+	}
+
+	e.i64(3, w.numRows)
+	e.list(4, thriftStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		e.begin()
+		e.list(1, thriftStruct, len(group.chunks))
+		for i, chunk := range group.chunks {
+			col := w.columns[i]
+			physical, _ := col.Type.physical()
+			e.begin()
+			e.i64(2, chunk.offset)
+			e.structField(3) // column metadata
+			e.i32(1, physical)
+			if col.Optional {
+				e.list(2, thriftI32, 2)
+				e.appendI32(encodingPlain)
+				e.appendI32(encodingRLE)
+			} else {
+				e.list(2, thriftI32, 1)
+				e.appendI32(encodingPlain)
+			}
+			e.list(3, thriftBinary, 1)
+			e.appendString(col.Name)
+			e.i32(4, codecUncompressed)
+			e.i64(5, group.rows)
+			e.i64(6, chunk.size) // uncompressed size
+			e.i64(7, chunk.size) // compressed size
+			e.i64(9, chunk.offset)
+			e.end()
+			e.end()
+		}
+		e.i64(2, group.size)
+		e.i64(3, group.rows)
+		e.end()
+	}
+	e.string(6, "ris parquet writer") // created by
+	e.end()
+	return e.buf
+}
+
+func (w *Writer) write(p []byte) {
+	if w.err != nil {
+		return
+	}
+	n, err := w.w.Write(p)
+	w.offset += int64(n)
+	if err != nil {
+		w.err = fmt.Errorf("failed to write parquet file: %w", err)
+	}
+}
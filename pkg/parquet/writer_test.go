@@ -0,0 +1,436 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// The tests read written files back with a decoder written from the format specification
+// (https://github.com/apache/parquet-format), it shares no code with Writer.
+
+var testColumns = []Column{
+	{Name: "id", Type: Int32},
+	{Name: "views", Type: Int64},
+	{Name: "name", Type: String, Optional: true},
+	{Name: "updated_at", Type: Timestamp, Optional: true},
+}
+
+func TestRoundTrip(t *testing.T) {
+	at := time.Date(2026, 10, 19, 12, 30, 45, 123456000, time.UTC)
+	rows := [][]any{
+		{int32(1), int64(10), "Marie Curie", at},
+		{int32(2), int64(-20), nil, nil},
+		{int32(3), int64(1) << 40, "", at.Add(time.Hour)},
+		{int32(math.MinInt32), int64(math.MaxInt64), "Дмитрий Менделеев", nil},
+		{int32(5), int64(50), "Albert Einstein", at.Add(-time.Hour)},
+	}
+
+	f := readFile(t, writeFile(t, testColumns, 2, rows))
+	if got := len(f.rowGroups); got != 3 {
+		t.Errorf("row groups: got %d, want 3", got)
+	}
+	if !reflect.DeepEqual(f.columns, []schemaColumn{
+		{name: "id", physical: 1, converted: -1, repetition: 0},
+		{name: "views", physical: 2, converted: -1, repetition: 0},
+		{name: "name", physical: 6, converted: 0, repetition: 1},
+		{name: "updated_at", physical: 2, converted: 10, repetition: 1},
+	}) {
+		t.Errorf("schema: got %+v", f.columns)
+	}
+	if !reflect.DeepEqual(f.rows(), rows) {
+		t.Errorf("rows:\ngot  %v\nwant %v", f.rows(), rows)
+	}
+}
+
+func TestRoundTripLongRuns(t *testing.T) {
+	// Runs longer than 63 values have multi-byte RLE headers, more than 14 columns a long list header
+	columns := make([]Column, 20)
+	for i := range columns {
+		columns[i] = Column{Name: fmt.Sprintf("c%d", i), Type: Int32, Optional: i%2 == 1}
+	}
+	rows := make([][]any, 1000)
+	for i := range rows {
+		rows[i] = make([]any, len(columns))
+		for j := range columns {
+			rows[i][j] = int32(i * j)
+			if columns[j].Optional && (i/100)%2 == 1 {
+				rows[i][j] = nil
+			}
+		}
+	}
+
+	f := readFile(t, writeFile(t, columns, 0, rows))
+	if got := len(f.rowGroups); got != 1 {
+		t.Errorf("row groups: got %d, want 1", got)
+	}
+	if got := len(f.columns); got != len(columns) {
+		t.Fatalf("columns: got %d, want %d", got, len(columns))
+	}
+	if !reflect.DeepEqual(f.rows(), rows) {
+		t.Error("rows differ")
+	}
+}
+
+func TestEmptyFile(t *testing.T) {
+	f := readFile(t, writeFile(t, testColumns, 0, nil))
+	if f.numRows != 0 || len(f.rowGroups) != 0 {
+		t.Errorf("got %d rows in %d row groups, want none", f.numRows, len(f.rowGroups))
+	}
+	if len(f.columns) != len(testColumns) {
+		t.Errorf("columns: got %d, want %d", len(f.columns), len(testColumns))
+	}
+}
+
+func TestWriteErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		row  []any
+		want string
+	}{
+		{name: "missing value", row: []any{int32(1), int64(1), "x"}, want: "row has 3 values, expected 4"},
+		{name: "null in required column", row: []any{nil, int64(1), "x", nil}, want: "column id: null value in a required column"},
+		{name: "unexpected type", row: []any{int32(1), 1, "x", nil}, want: "column views: unexpected value of type int"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWriter(&bytes.Buffer{}, testColumns, 0)
+			err := w.Write(tt.row)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("got %v, want %q", err, tt.want)
+			}
+			// The writer is unusable after an error
+			if err := w.Write([]any{int32(1), int64(1), "x", nil}); err == nil {
+				t.Error("write after an error succeeded")
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, columns []Column, rowGroupSize int, rows [][]any) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := NewWriter(&buf, columns, rowGroupSize)
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// schemaColumn is a leaf of the schema, converted is -1 when it is not set
+type schemaColumn struct {
+	name                            string
+	physical, converted, repetition int64
+}
+
+// file is a decoded Parquet file, row groups hold the values of every column
+type file struct {
+	numRows   int64
+	columns   []schemaColumn
+	rowGroups [][][]any
+}
+
+func (f *file) rows() [][]any {
+	var rows [][]any
+	for _, group := range f.rowGroups {
+		for i := range group[0] {
+			row := make([]any, len(group))
+			for j := range group {
+				row[j] = group[j][i]
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// readFile decodes a file with a flat schema of uncompressed PLAIN encoded columns
+// and checks the sizes and offsets recorded in the metadata
+func readFile(t *testing.T, data []byte) *file {
+	t.Helper()
+	if len(data) < 12 || string(data[:4]) != "PAR1" || string(data[len(data)-4:]) != "PAR1" {
+		t.Fatal("missing magic bytes")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	footerStart := len(data) - 8 - footerLen
+	if footerStart < 4 {
+		t.Fatalf("footer length %d exceeds the file", footerLen)
+	}
+	r := &thriftReader{t: t, buf: data[:len(data)-8], pos: footerStart}
+	meta := r.readStruct()
+	if r.pos != len(r.buf) {
+		t.Fatalf("footer has %d bytes after the metadata", len(r.buf)-r.pos)
+	}
+
+	f := &file{numRows: field[int64](t, meta, 3)}
+	schema := field[[]any](t, meta, 2)
+	root := schema[0].(map[int16]any)
+	if n := field[int64](t, root, 5); n != int64(len(schema)-1) {
+		t.Fatalf("root has %d children, schema has %d columns", n, len(schema)-1)
+	}
+	for _, el := range schema[1:] {
+		el := el.(map[int16]any)
+		col := schemaColumn{
+			name:       field[string](t, el, 4),
+			physical:   field[int64](t, el, 1),
+			converted:  -1,
+			repetition: field[int64](t, el, 3),
+		}
+		if v, ok := el[6]; ok {
+			col.converted = v.(int64)
+		}
+		f.columns = append(f.columns, col)
+	}
+
+	var rows int64
+	end := int64(4) // the first chunk starts after the magic bytes
+	for _, group := range field[[]any](t, meta, 4) {
+		group := group.(map[int16]any)
+		groupRows := field[int64](t, group, 3)
+		chunks := field[[]any](t, group, 1)
+		if len(chunks) != len(f.columns) {
+			t.Fatalf("row group has %d chunks, want %d", len(chunks), len(f.columns))
+		}
+		var size int64
+		values := make([][]any, len(chunks))
+		for i, chunk := range chunks {
+			chunk := chunk.(map[int16]any)
+			cm := field[map[int16]any](t, chunk, 3)
+			col := f.columns[i]
+			offset := field[int64](t, cm, 9)
+			chunkSize := field[int64](t, cm, 7)
+			switch {
+			case field[int64](t, chunk, 2) != offset:
+				t.Fatalf("column %s: file offset differs from the data page offset", col.name)
+			case offset != end:
+				t.Fatalf("column %s: chunk starts at %d, the previous one ends at %d", col.name, offset, end)
+			case field[int64](t, cm, 1) != col.physical:
+				t.Fatalf("column %s: chunk type differs from the schema", col.name)
+			case !reflect.DeepEqual(field[[]any](t, cm, 3), []any{col.name}):
+				t.Fatalf("column %s: path in schema %v", col.name, cm[3])
+			case field[int64](t, cm, 4) != 0:
+				t.Fatalf("column %s: compressed with codec %d", col.name, cm[4])
+			case field[int64](t, cm, 5) != groupRows:
+				t.Fatalf("column %s: %d values in a row group of %d rows", col.name, cm[5], groupRows)
+			case field[int64](t, cm, 6) != chunkSize:
+				t.Fatalf("column %s: uncompressed size differs from the compressed one", col.name)
+			}
+			values[i] = readPage(t, data[offset:offset+chunkSize], col, groupRows)
+			end = offset + chunkSize
+			size += chunkSize
+		}
+		if got := field[int64](t, group, 2); got != size {
+			t.Fatalf("row group size: got %d, chunks take %d bytes", got, size)
+		}
+		rows += groupRows
+		f.rowGroups = append(f.rowGroups, values)
+	}
+	if end != int64(footerStart) {
+		t.Fatalf("footer starts at %d, the last chunk ends at %d", footerStart, end)
+	}
+	if rows != f.numRows {
+		t.Fatalf("file has %d rows, row groups have %d", f.numRows, rows)
+	}
+	return f
+}
+
+// readPage decodes a column chunk of a single data page
+func readPage(t *testing.T, chunk []byte, col schemaColumn, rows int64) []any {
+	t.Helper()
+	r := &thriftReader{t: t, buf: chunk}
+	header := r.readStruct()
+	page := chunk[r.pos:]
+	dataHeader := field[map[int16]any](t, header, 5)
+	switch {
+	case field[int64](t, header, 1) != 0:
+		t.Fatalf("column %s: page type %d, want a data page", col.name, header[1])
+	case field[int64](t, header, 2) != int64(len(page)) || field[int64](t, header, 3) != int64(len(page)):
+		t.Fatalf("column %s: page sizes %v and %v, the chunk has %d bytes", col.name, header[2], header[3], len(page))
+	case field[int64](t, dataHeader, 1) != rows:
+		t.Fatalf("column %s: page has %d values, want %d", col.name, dataHeader[1], rows)
+	case field[int64](t, dataHeader, 2) != 0:
+		t.Fatalf("column %s: values are not PLAIN encoded", col.name)
+	}
+
+	defined := make([]bool, rows)
+	for i := range defined {
+		defined[i] = true
+	}
+	if col.repetition == 1 {
+		n := int(binary.LittleEndian.Uint32(page))
+		defined = readLevels(t, page[4:4+n], int(rows))
+		page = page[4+n:]
+	}
+
+	values := make([]any, rows)
+	for i := range values {
+		if !defined[i] {
+			continue
+		}
+		switch {
+		case col.physical == 1:
+			values[i] = int32(binary.LittleEndian.Uint32(page))
+			page = page[4:]
+		case col.physical == 2 && col.converted == 10:
+			values[i] = time.UnixMicro(int64(binary.LittleEndian.Uint64(page))).UTC()
+			page = page[8:]
+		case col.physical == 2:
+			values[i] = int64(binary.LittleEndian.Uint64(page))
+			page = page[8:]
+		case col.physical == 6:
+			n := binary.LittleEndian.Uint32(page)
+			values[i] = string(page[4 : 4+n])
+			page = page[4+n:]
+		default:
+			t.Fatalf("column %s: unexpected physical type %d", col.name, col.physical)
+		}
+	}
+	if len(page) != 0 {
+		t.Fatalf("column %s: %d bytes after the values", col.name, len(page))
+	}
+	return values
+}
+
+// readLevels decodes definition levels of bit width 1 in the RLE / bit-packing hybrid encoding
+func readLevels(t *testing.T, buf []byte, n int) []bool {
+	t.Helper()
+	var levels []bool
+	for len(buf) > 0 {
+		header, k := binary.Uvarint(buf)
+		if k <= 0 {
+			t.Fatal("malformed run header")
+		}
+		buf = buf[k:]
+		count := int(header >> 1)
+		if header&1 == 1 && len(buf) < count || len(buf) < 1 {
+			t.Fatal("run exceeds the definition levels")
+		}
+		if header&1 == 0 {
+			// RLE run, the value takes one byte
+			for range count {
+				levels = append(levels, buf[0] == 1)
+			}
+			buf = buf[1:]
+			continue
+		}
+		// Bit-packed run of count groups of 8 values
+		for _, b := range buf[:count] {
+			for bit := range 8 {
+				levels = append(levels, b>>bit&1 == 1)
+			}
+		}
+		buf = buf[count:]
+	}
+	if len(levels) < n {
+		t.Fatalf("got %d definition levels, want %d", len(levels), n)
+	}
+	return levels[:n]
+}
+
+// thriftReader decodes structs of the Thrift compact protocol into maps by field id.
+// Integers are int64, binary values are strings and lists are []any.
+type thriftReader struct {
+	t   *testing.T
+	buf []byte
+	pos int
+}
+
+func field[T any](t *testing.T, s map[int16]any, id int16) T {
+	t.Helper()
+	v, ok := s[id].(T)
+	if !ok {
+		t.Fatalf("field %d: got %T, want %T", id, s[id], *new(T))
+	}
+	return v
+}
+
+func (r *thriftReader) byte() byte {
+	if r.pos >= len(r.buf) {
+		r.t.Fatal("unexpected end of thrift data")
+	}
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	if n <= 0 {
+		r.t.Fatal("malformed varint")
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) varint() int64 {
+	v, n := binary.Varint(r.buf[r.pos:])
+	if n <= 0 {
+		r.t.Fatal("malformed varint")
+	}
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) readStruct() map[int16]any {
+	fields := map[int16]any{}
+	var last int16
+	for {
+		b := r.byte()
+		if b == 0 {
+			return fields
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			id = int16(r.varint())
+		}
+		if _, ok := fields[id]; ok {
+			r.t.Fatalf("duplicate field %d", id)
+		}
+		fields[id] = r.value(b & 0x0f)
+		last = id
+	}
+}
+
+func (r *thriftReader) value(typ byte) any {
+	switch typ {
+	case 1, 2: // booleans are encoded in the type
+		return typ == 1
+	case 3: // i8
+		return int64(int8(r.byte()))
+	case 4, 5, 6: // i16, i32, i64
+		return r.varint()
+	case 8: // binary
+		n := int(r.uvarint())
+		if r.pos+n > len(r.buf) {
+			r.t.Fatal("binary value exceeds the data")
+		}
+		s := string(r.buf[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case 9, 10: // list, set
+		header := r.byte()
+		size, elemType := int(header>>4), header&0x0f
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		elems := make([]any, size)
+		for i := range elems {
+			elems[i] = r.value(elemType)
+		}
+		return elems
+	case 12:
+		return r.readStruct()
+	}
+	r.t.Fatalf("unsupported thrift type %d", typ)
+	return nil
+}
@@ -0,0 +1,41 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"ris/internal/domain"
+	"ris/pkg/postgres/queries"
+)
+
+// AddAffiliations stores the affiliations of the laureates of a prize,
+// the laureates must be linked to the prize
+func (p *Postgres) AddAffiliations(ctx context.Context, prizeId int32, laureates []domain.Laureate) error {
+	tx, err := p.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("could not start transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	q := p.q.WithTx(tx)
+	for _, laureate := range laureates {
+		for _, a := range laureate.Affiliations {
+			id, err := q.UpsertAffiliation(ctx, queries.UpsertAffiliationParams{
+				Name:    a.Name,
+				City:    a.City,
+				Country: a.Country,
+			})
+			if err != nil {
+				return fmt.Errorf("could not add affiliation %q: %w", a.Name, err)
+			}
+			err = q.LinkAwardAffiliation(ctx, queries.LinkAwardAffiliationParams{
+				PrizeID:       prizeId,
+				LaureateID:    laureate.Id,
+				AffiliationID: id,
+			})
+			if err != nil {
+				return fmt.Errorf("could not link affiliation %q: %w", a.Name, err)
+			}
+		}
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+)
+
+// RefreshAnalytics recomputes the materialized views of the analytics.
+// The views are refreshed concurrently, the API reads the previous data meanwhile.
+func (p *Postgres) RefreshAnalytics(ctx context.Context) error {
+	if err := p.q.RefreshAnalyticsPrizes(ctx); err != nil {
+		return fmt.Errorf("could not refresh analytics_prizes: %w", err)
+	}
+	if err := p.q.RefreshAnalyticsLaureates(ctx); err != nil {
+		return fmt.Errorf("could not refresh analytics_laureates: %w", err)
+	}
+	if err := p.q.RefreshAnalyticsAwards(ctx); err != nil {
+		return fmt.Errorf("could not refresh analytics_awards: %w", err)
+	}
+	return nil
+}
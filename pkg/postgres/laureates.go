@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"ris/internal/domain"
 	"ris/pkg/postgres/queries"
+	"ris/pkg/utills"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -21,11 +22,21 @@ func (p *Postgres) AddLaureates(ctx context.Context, laureates []domain.Laureate
 		surname := pgtype.Text{}
 		_ = surname.Scan(laureate.Surname)
 		params = append(params, queries.CreateLaureateParams{
-			ID:         laureate.Id,
-			Firstname:  laureate.Firstname,
-			Surname:    surname,
-			Motivation: laureate.Motivation,
-			Share:      laureate.Share,
+			ID:               laureate.Id,
+			Firstname:        laureate.Firstname,
+			Surname:          surname,
+			Motivation:       laureate.Motivation,
+			Share:            laureate.Share,
+			Type:             laureate.Type,
+			Gender:           utills.OptionalPgText(laureate.Gender),
+			BirthDate:        utills.PgDate(laureate.BirthDate),
+			BirthCity:        utills.OptionalPgText(laureate.BirthCity),
+			BirthCountry:     utills.OptionalPgText(laureate.BirthCountry),
+			BirthCountryCode: utills.OptionalPgText(laureate.BirthCountryCode),
+			DeathDate:        utills.PgDate(laureate.DeathDate),
+			DeathCity:        utills.OptionalPgText(laureate.DeathCity),
+			DeathCountry:     utills.OptionalPgText(laureate.DeathCountry),
+			DeathCountryCode: utills.OptionalPgText(laureate.DeathCountryCode),
 		})
 	}
 	res := p.q.WithTx(tx).CreateLaureate(ctx, params)
@@ -1,11 +1,48 @@
 package postgres
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/exaring/otelpgx"
 	"github.com/jackc/pgx/v5/pgxpool"
 
 	"ris/pkg/postgres/queries"
 )
 
+// SchemaVersion is the version of schema.sql the code is written for.
+// It must match the version stored in the schema_version table.
+const SchemaVersion = 9
+
+// NewPool creates a connection pool which records a tracing span for every query
+func NewPool(ctx context.Context, url string) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(url)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse database url: %w", err)
+	}
+	cfg.ConnConfig.Tracer = otelpgx.NewTracer(otelpgx.WithSpanNameFunc(spanName))
+	pool, err := pgxpool.NewWithConfig(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("could not create pool: %w", err)
+	}
+	return pool, nil
+}
+
+// spanName names query spans after the sqlc query ("-- name: GetStats :one"),
+// falling back to the SQL operation
+func spanName(stmt string) string {
+	if rest, ok := strings.CutPrefix(stmt, "-- name: "); ok {
+		if name, _, ok := strings.Cut(rest, " "); ok {
+			return name
+		}
+	}
+	if fields := strings.Fields(stmt); len(fields) > 0 {
+		return strings.ToUpper(fields[0])
+	}
+	return "query"
+}
+
 type Postgres struct {
 	pool *pgxpool.Pool
 	q    *queries.Queries
@@ -17,3 +54,15 @@ func NewPostgres(pool *pgxpool.Pool) *Postgres {
 		q:    queries.New(pool),
 	}
 }
+
+// CheckSchemaVersion verifies that the database schema has the expected version
+func CheckSchemaVersion(ctx context.Context, db queries.DBTX) error {
+	version, err := queries.New(db).GetSchemaVersion(ctx)
+	if err != nil {
+		return fmt.Errorf("could not get schema version: %w", err)
+	}
+	if version != SchemaVersion {
+		return fmt.Errorf("schema version mismatch: database has %d, expected %d", version, SchemaVersion)
+	}
+	return nil
+}
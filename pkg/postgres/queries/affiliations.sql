@@ -0,0 +1,24 @@
+-- name: UpsertAffiliation :one
+INSERT INTO affiliations (name, city, country)
+VALUES ($1, $2, $3)
+ON CONFLICT (name, city, country) DO UPDATE SET name = EXCLUDED.name
+RETURNING id;
+
+-- name: LinkAwardAffiliation :exec
+INSERT INTO award_affiliations (prize_id, laureate_id, affiliation_id)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: GetAffiliationsByPrizeIds :many
+SELECT aa.prize_id, aa.laureate_id, a.name, a.city, a.country
+FROM award_affiliations aa
+INNER JOIN affiliations a ON a.id = aa.affiliation_id
+WHERE aa.prize_id = ANY(@prize_ids::int[])
+ORDER BY aa.prize_id, aa.laureate_id, a.name;
+
+-- name: GetAffiliationsByLaureateIds :many
+SELECT aa.prize_id, aa.laureate_id, a.name, a.city, a.country
+FROM award_affiliations aa
+INNER JOIN affiliations a ON a.id = aa.affiliation_id
+WHERE aa.laureate_id = ANY(@laureate_ids::int[])
+ORDER BY aa.laureate_id, aa.prize_id, a.name;
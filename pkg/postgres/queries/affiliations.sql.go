@@ -0,0 +1,131 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: affiliations.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const GetAffiliationsByLaureateIds = `-- name: GetAffiliationsByLaureateIds :many
+SELECT aa.prize_id, aa.laureate_id, a.name, a.city, a.country
+FROM award_affiliations aa
+INNER JOIN affiliations a ON a.id = aa.affiliation_id
+WHERE aa.laureate_id = ANY($1::int[])
+ORDER BY aa.laureate_id, aa.prize_id, a.name
+`
+
+type GetAffiliationsByLaureateIdsRow struct {
+	PrizeID    int32
+	LaureateID int32
+	Name       string
+	City       string
+	Country    string
+}
+
+func (q *Queries) GetAffiliationsByLaureateIds(ctx context.Context, laureateIds []int32) ([]GetAffiliationsByLaureateIdsRow, error) {
+	rows, err := q.db.Query(ctx, GetAffiliationsByLaureateIds, laureateIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAffiliationsByLaureateIdsRow
+	for rows.Next() {
+		var i GetAffiliationsByLaureateIdsRow
+		if err := rows.Scan(
+			&i.PrizeID,
+			&i.LaureateID,
+			&i.Name,
+			&i.City,
+			&i.Country,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetAffiliationsByPrizeIds = `-- name: GetAffiliationsByPrizeIds :many
+SELECT aa.prize_id, aa.laureate_id, a.name, a.city, a.country
+FROM award_affiliations aa
+INNER JOIN affiliations a ON a.id = aa.affiliation_id
+WHERE aa.prize_id = ANY($1::int[])
+ORDER BY aa.prize_id, aa.laureate_id, a.name
+`
+
+type GetAffiliationsByPrizeIdsRow struct {
+	PrizeID    int32
+	LaureateID int32
+	Name       string
+	City       string
+	Country    string
+}
+
+func (q *Queries) GetAffiliationsByPrizeIds(ctx context.Context, prizeIds []int32) ([]GetAffiliationsByPrizeIdsRow, error) {
+	rows, err := q.db.Query(ctx, GetAffiliationsByPrizeIds, prizeIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAffiliationsByPrizeIdsRow
+	for rows.Next() {
+		var i GetAffiliationsByPrizeIdsRow
+		if err := rows.Scan(
+			&i.PrizeID,
+			&i.LaureateID,
+			&i.Name,
+			&i.City,
+			&i.Country,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const LinkAwardAffiliation = `-- name: LinkAwardAffiliation :exec
+INSERT INTO award_affiliations (prize_id, laureate_id, affiliation_id)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type LinkAwardAffiliationParams struct {
+	PrizeID       int32
+	LaureateID    int32
+	AffiliationID int32
+}
+
+func (q *Queries) LinkAwardAffiliation(ctx context.Context, arg LinkAwardAffiliationParams) error {
+	_, err := q.db.Exec(ctx, LinkAwardAffiliation, arg.PrizeID, arg.LaureateID, arg.AffiliationID)
+	return err
+}
+
+const UpsertAffiliation = `-- name: UpsertAffiliation :one
+INSERT INTO affiliations (name, city, country)
+VALUES ($1, $2, $3)
+ON CONFLICT (name, city, country) DO UPDATE SET name = EXCLUDED.name
+RETURNING id
+`
+
+type UpsertAffiliationParams struct {
+	Name    string
+	City    string
+	Country string
+}
+
+func (q *Queries) UpsertAffiliation(ctx context.Context, arg UpsertAffiliationParams) (int32, error) {
+	row := q.db.QueryRow(ctx, UpsertAffiliation, arg.Name, arg.City, arg.Country)
+	var id int32
+	err := row.Scan(&id)
+	return id, err
+}
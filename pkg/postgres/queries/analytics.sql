@@ -0,0 +1,124 @@
+-- Analytics read materialized views, see schema.sql
+
+-- name: RefreshAnalyticsPrizes :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY analytics_prizes;
+
+-- name: RefreshAnalyticsLaureates :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY analytics_laureates;
+
+-- name: RefreshAnalyticsAwards :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY analytics_awards;
+
+-- name: AnalyticsPrizesByYear :many
+SELECT year,
+       COUNT(*) FILTER (WHERE laureates_count > 0)::INT AS awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 0)::INT AS not_awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 1)::INT AS solo_count,
+       COUNT(*) FILTER (WHERE laureates_count > 1)::INT AS shared_count,
+       COALESCE(SUM(laureates_count), 0)::INT AS laureates_count
+FROM analytics_prizes
+WHERE sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category)
+GROUP BY year
+ORDER BY year;
+
+-- name: AnalyticsPrizesByDecade :many
+SELECT decade,
+       COUNT(*) FILTER (WHERE laureates_count > 0)::INT AS awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 0)::INT AS not_awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 1)::INT AS solo_count,
+       COUNT(*) FILTER (WHERE laureates_count > 1)::INT AS shared_count,
+       COALESCE(SUM(laureates_count), 0)::INT AS laureates_count
+FROM analytics_prizes
+WHERE sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category)
+GROUP BY decade
+ORDER BY decade;
+
+-- name: AnalyticsPrizesByCategory :many
+SELECT category,
+       COUNT(*) FILTER (WHERE laureates_count > 0)::INT AS awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 0)::INT AS not_awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 1)::INT AS solo_count,
+       COUNT(*) FILTER (WHERE laureates_count > 1)::INT AS shared_count,
+       COALESCE(SUM(laureates_count), 0)::INT AS laureates_count
+FROM analytics_prizes
+GROUP BY category
+ORDER BY category;
+
+-- name: AnalyticsLaureatesPerPrize :many
+SELECT laureates_count, COUNT(*)::INT AS prizes_count
+FROM analytics_prizes
+GROUP BY laureates_count
+ORDER BY laureates_count;
+
+-- name: AnalyticsShares :many
+SELECT share, COUNT(*)::INT AS laureates_count
+FROM analytics_laureates
+GROUP BY share
+ORDER BY share;
+
+-- name: AnalyticsPrizesPerLaureate :many
+SELECT prizes_count, COUNT(*)::INT AS laureates_count
+FROM analytics_laureates
+GROUP BY prizes_count
+ORDER BY prizes_count;
+
+-- name: AnalyticsMultipleWinners :many
+SELECT * FROM analytics_laureates
+WHERE prizes_count > 1
+ORDER BY prizes_count DESC, first_year, laureate_id;
+
+-- name: AnalyticsNotAwardedYears :many
+-- Years from the first prize of a category to the last year of the dataset
+-- in which the category has no awarded prize, whether there is a prize row or not
+WITH categories AS (
+    SELECT category, MIN(year) AS first_year
+    FROM analytics_prizes
+    GROUP BY category
+)
+SELECT c.category, y.year::INT AS year
+FROM categories c
+CROSS JOIN LATERAL generate_series(c.first_year, (SELECT MAX(year) FROM analytics_prizes)) AS y(year)
+WHERE (sqlc.narg(category)::text IS NULL OR c.category = sqlc.narg(category))
+  AND NOT EXISTS (
+      SELECT 1 FROM analytics_prizes a
+      WHERE a.category = c.category AND a.year = y.year AND a.laureates_count > 0
+  )
+ORDER BY c.category, y.year;
+
+-- name: AnalyticsLaureatesByYear :many
+SELECT year,
+       COUNT(*) FILTER (WHERE type = 'person')::INT AS persons_count,
+       COUNT(*) FILTER (WHERE type = 'organization')::INT AS organizations_count,
+       COUNT(*) FILTER (WHERE gender = 'male')::INT AS male_count,
+       COUNT(*) FILTER (WHERE gender = 'female')::INT AS female_count,
+       COUNT(*) FILTER (WHERE gender = 'other')::INT AS other_gender_count,
+       COUNT(*) FILTER (WHERE type = 'person' AND gender IS NULL)::INT AS unknown_gender_count
+FROM analytics_awards
+WHERE sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category)
+GROUP BY year
+ORDER BY year;
+
+-- name: AnalyticsLaureatesByDecade :many
+SELECT decade,
+       COUNT(*) FILTER (WHERE type = 'person')::INT AS persons_count,
+       COUNT(*) FILTER (WHERE type = 'organization')::INT AS organizations_count,
+       COUNT(*) FILTER (WHERE gender = 'male')::INT AS male_count,
+       COUNT(*) FILTER (WHERE gender = 'female')::INT AS female_count,
+       COUNT(*) FILTER (WHERE gender = 'other')::INT AS other_gender_count,
+       COUNT(*) FILTER (WHERE type = 'person' AND gender IS NULL)::INT AS unknown_gender_count
+FROM analytics_awards
+WHERE sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category)
+GROUP BY decade
+ORDER BY decade;
+
+-- name: AnalyticsLaureatesByCategory :many
+SELECT category,
+       COUNT(*) FILTER (WHERE type = 'person')::INT AS persons_count,
+       COUNT(*) FILTER (WHERE type = 'organization')::INT AS organizations_count,
+       COUNT(*) FILTER (WHERE gender = 'male')::INT AS male_count,
+       COUNT(*) FILTER (WHERE gender = 'female')::INT AS female_count,
+       COUNT(*) FILTER (WHERE gender = 'other')::INT AS other_gender_count,
+       COUNT(*) FILTER (WHERE type = 'person' AND gender IS NULL)::INT AS unknown_gender_count
+FROM analytics_awards
+GROUP BY category
+ORDER BY category;
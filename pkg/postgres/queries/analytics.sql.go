@@ -0,0 +1,516 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: analytics.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const AnalyticsLaureatesByCategory = `-- name: AnalyticsLaureatesByCategory :many
+SELECT category,
+       COUNT(*) FILTER (WHERE type = 'person')::INT AS persons_count,
+       COUNT(*) FILTER (WHERE type = 'organization')::INT AS organizations_count,
+       COUNT(*) FILTER (WHERE gender = 'male')::INT AS male_count,
+       COUNT(*) FILTER (WHERE gender = 'female')::INT AS female_count,
+       COUNT(*) FILTER (WHERE gender = 'other')::INT AS other_gender_count,
+       COUNT(*) FILTER (WHERE type = 'person' AND gender IS NULL)::INT AS unknown_gender_count
+FROM analytics_awards
+GROUP BY category
+ORDER BY category
+`
+
+type AnalyticsLaureatesByCategoryRow struct {
+	Category           string
+	PersonsCount       int32
+	OrganizationsCount int32
+	MaleCount          int32
+	FemaleCount        int32
+	OtherGenderCount   int32
+	UnknownGenderCount int32
+}
+
+func (q *Queries) AnalyticsLaureatesByCategory(ctx context.Context) ([]AnalyticsLaureatesByCategoryRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsLaureatesByCategory)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsLaureatesByCategoryRow
+	for rows.Next() {
+		var i AnalyticsLaureatesByCategoryRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.PersonsCount,
+			&i.OrganizationsCount,
+			&i.MaleCount,
+			&i.FemaleCount,
+			&i.OtherGenderCount,
+			&i.UnknownGenderCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsLaureatesByDecade = `-- name: AnalyticsLaureatesByDecade :many
+SELECT decade,
+       COUNT(*) FILTER (WHERE type = 'person')::INT AS persons_count,
+       COUNT(*) FILTER (WHERE type = 'organization')::INT AS organizations_count,
+       COUNT(*) FILTER (WHERE gender = 'male')::INT AS male_count,
+       COUNT(*) FILTER (WHERE gender = 'female')::INT AS female_count,
+       COUNT(*) FILTER (WHERE gender = 'other')::INT AS other_gender_count,
+       COUNT(*) FILTER (WHERE type = 'person' AND gender IS NULL)::INT AS unknown_gender_count
+FROM analytics_awards
+WHERE $1::text IS NULL OR category = $1
+GROUP BY decade
+ORDER BY decade
+`
+
+type AnalyticsLaureatesByDecadeRow struct {
+	Decade             int32
+	PersonsCount       int32
+	OrganizationsCount int32
+	MaleCount          int32
+	FemaleCount        int32
+	OtherGenderCount   int32
+	UnknownGenderCount int32
+}
+
+func (q *Queries) AnalyticsLaureatesByDecade(ctx context.Context, category pgtype.Text) ([]AnalyticsLaureatesByDecadeRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsLaureatesByDecade, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsLaureatesByDecadeRow
+	for rows.Next() {
+		var i AnalyticsLaureatesByDecadeRow
+		if err := rows.Scan(
+			&i.Decade,
+			&i.PersonsCount,
+			&i.OrganizationsCount,
+			&i.MaleCount,
+			&i.FemaleCount,
+			&i.OtherGenderCount,
+			&i.UnknownGenderCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsLaureatesByYear = `-- name: AnalyticsLaureatesByYear :many
+SELECT year,
+       COUNT(*) FILTER (WHERE type = 'person')::INT AS persons_count,
+       COUNT(*) FILTER (WHERE type = 'organization')::INT AS organizations_count,
+       COUNT(*) FILTER (WHERE gender = 'male')::INT AS male_count,
+       COUNT(*) FILTER (WHERE gender = 'female')::INT AS female_count,
+       COUNT(*) FILTER (WHERE gender = 'other')::INT AS other_gender_count,
+       COUNT(*) FILTER (WHERE type = 'person' AND gender IS NULL)::INT AS unknown_gender_count
+FROM analytics_awards
+WHERE $1::text IS NULL OR category = $1
+GROUP BY year
+ORDER BY year
+`
+
+type AnalyticsLaureatesByYearRow struct {
+	Year               int32
+	PersonsCount       int32
+	OrganizationsCount int32
+	MaleCount          int32
+	FemaleCount        int32
+	OtherGenderCount   int32
+	UnknownGenderCount int32
+}
+
+func (q *Queries) AnalyticsLaureatesByYear(ctx context.Context, category pgtype.Text) ([]AnalyticsLaureatesByYearRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsLaureatesByYear, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsLaureatesByYearRow
+	for rows.Next() {
+		var i AnalyticsLaureatesByYearRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.PersonsCount,
+			&i.OrganizationsCount,
+			&i.MaleCount,
+			&i.FemaleCount,
+			&i.OtherGenderCount,
+			&i.UnknownGenderCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsLaureatesPerPrize = `-- name: AnalyticsLaureatesPerPrize :many
+SELECT laureates_count, COUNT(*)::INT AS prizes_count
+FROM analytics_prizes
+GROUP BY laureates_count
+ORDER BY laureates_count
+`
+
+type AnalyticsLaureatesPerPrizeRow struct {
+	LaureatesCount int32
+	PrizesCount    int32
+}
+
+func (q *Queries) AnalyticsLaureatesPerPrize(ctx context.Context) ([]AnalyticsLaureatesPerPrizeRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsLaureatesPerPrize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsLaureatesPerPrizeRow
+	for rows.Next() {
+		var i AnalyticsLaureatesPerPrizeRow
+		if err := rows.Scan(&i.LaureatesCount, &i.PrizesCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsMultipleWinners = `-- name: AnalyticsMultipleWinners :many
+SELECT laureate_id, firstname, surname, share, prizes_count, first_year, last_year FROM analytics_laureates
+WHERE prizes_count > 1
+ORDER BY prizes_count DESC, first_year, laureate_id
+`
+
+func (q *Queries) AnalyticsMultipleWinners(ctx context.Context) ([]AnalyticsLaureate, error) {
+	rows, err := q.db.Query(ctx, AnalyticsMultipleWinners)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsLaureate
+	for rows.Next() {
+		var i AnalyticsLaureate
+		if err := rows.Scan(
+			&i.LaureateID,
+			&i.Firstname,
+			&i.Surname,
+			&i.Share,
+			&i.PrizesCount,
+			&i.FirstYear,
+			&i.LastYear,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsNotAwardedYears = `-- name: AnalyticsNotAwardedYears :many
+WITH categories AS (
+    SELECT category, MIN(year) AS first_year
+    FROM analytics_prizes
+    GROUP BY category
+)
+SELECT c.category, y.year::INT AS year
+FROM categories c
+CROSS JOIN LATERAL generate_series(c.first_year, (SELECT MAX(year) FROM analytics_prizes)) AS y(year)
+WHERE ($1::text IS NULL OR c.category = $1)
+  AND NOT EXISTS (
+      SELECT 1 FROM analytics_prizes a
+      WHERE a.category = c.category AND a.year = y.year AND a.laureates_count > 0
+  )
+ORDER BY c.category, y.year
+`
+
+type AnalyticsNotAwardedYearsRow struct {
+	Category string
+	Year     int32
+}
+
+// Years from the first prize of a category to the last year of the dataset
+// in which the category has no awarded prize, whether there is a prize row or not
+func (q *Queries) AnalyticsNotAwardedYears(ctx context.Context, category pgtype.Text) ([]AnalyticsNotAwardedYearsRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsNotAwardedYears, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsNotAwardedYearsRow
+	for rows.Next() {
+		var i AnalyticsNotAwardedYearsRow
+		if err := rows.Scan(&i.Category, &i.Year); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsPrizesByCategory = `-- name: AnalyticsPrizesByCategory :many
+SELECT category,
+       COUNT(*) FILTER (WHERE laureates_count > 0)::INT AS awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 0)::INT AS not_awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 1)::INT AS solo_count,
+       COUNT(*) FILTER (WHERE laureates_count > 1)::INT AS shared_count,
+       COALESCE(SUM(laureates_count), 0)::INT AS laureates_count
+FROM analytics_prizes
+GROUP BY category
+ORDER BY category
+`
+
+type AnalyticsPrizesByCategoryRow struct {
+	Category        string
+	AwardedCount    int32
+	NotAwardedCount int32
+	SoloCount       int32
+	SharedCount     int32
+	LaureatesCount  int32
+}
+
+func (q *Queries) AnalyticsPrizesByCategory(ctx context.Context) ([]AnalyticsPrizesByCategoryRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsPrizesByCategory)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsPrizesByCategoryRow
+	for rows.Next() {
+		var i AnalyticsPrizesByCategoryRow
+		if err := rows.Scan(
+			&i.Category,
+			&i.AwardedCount,
+			&i.NotAwardedCount,
+			&i.SoloCount,
+			&i.SharedCount,
+			&i.LaureatesCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsPrizesByDecade = `-- name: AnalyticsPrizesByDecade :many
+SELECT decade,
+       COUNT(*) FILTER (WHERE laureates_count > 0)::INT AS awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 0)::INT AS not_awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 1)::INT AS solo_count,
+       COUNT(*) FILTER (WHERE laureates_count > 1)::INT AS shared_count,
+       COALESCE(SUM(laureates_count), 0)::INT AS laureates_count
+FROM analytics_prizes
+WHERE $1::text IS NULL OR category = $1
+GROUP BY decade
+ORDER BY decade
+`
+
+type AnalyticsPrizesByDecadeRow struct {
+	Decade          int32
+	AwardedCount    int32
+	NotAwardedCount int32
+	SoloCount       int32
+	SharedCount     int32
+	LaureatesCount  int32
+}
+
+func (q *Queries) AnalyticsPrizesByDecade(ctx context.Context, category pgtype.Text) ([]AnalyticsPrizesByDecadeRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsPrizesByDecade, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsPrizesByDecadeRow
+	for rows.Next() {
+		var i AnalyticsPrizesByDecadeRow
+		if err := rows.Scan(
+			&i.Decade,
+			&i.AwardedCount,
+			&i.NotAwardedCount,
+			&i.SoloCount,
+			&i.SharedCount,
+			&i.LaureatesCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsPrizesByYear = `-- name: AnalyticsPrizesByYear :many
+SELECT year,
+       COUNT(*) FILTER (WHERE laureates_count > 0)::INT AS awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 0)::INT AS not_awarded_count,
+       COUNT(*) FILTER (WHERE laureates_count = 1)::INT AS solo_count,
+       COUNT(*) FILTER (WHERE laureates_count > 1)::INT AS shared_count,
+       COALESCE(SUM(laureates_count), 0)::INT AS laureates_count
+FROM analytics_prizes
+WHERE $1::text IS NULL OR category = $1
+GROUP BY year
+ORDER BY year
+`
+
+type AnalyticsPrizesByYearRow struct {
+	Year            int32
+	AwardedCount    int32
+	NotAwardedCount int32
+	SoloCount       int32
+	SharedCount     int32
+	LaureatesCount  int32
+}
+
+func (q *Queries) AnalyticsPrizesByYear(ctx context.Context, category pgtype.Text) ([]AnalyticsPrizesByYearRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsPrizesByYear, category)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsPrizesByYearRow
+	for rows.Next() {
+		var i AnalyticsPrizesByYearRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.AwardedCount,
+			&i.NotAwardedCount,
+			&i.SoloCount,
+			&i.SharedCount,
+			&i.LaureatesCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsPrizesPerLaureate = `-- name: AnalyticsPrizesPerLaureate :many
+SELECT prizes_count, COUNT(*)::INT AS laureates_count
+FROM analytics_laureates
+GROUP BY prizes_count
+ORDER BY prizes_count
+`
+
+type AnalyticsPrizesPerLaureateRow struct {
+	PrizesCount    int32
+	LaureatesCount int32
+}
+
+func (q *Queries) AnalyticsPrizesPerLaureate(ctx context.Context) ([]AnalyticsPrizesPerLaureateRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsPrizesPerLaureate)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsPrizesPerLaureateRow
+	for rows.Next() {
+		var i AnalyticsPrizesPerLaureateRow
+		if err := rows.Scan(&i.PrizesCount, &i.LaureatesCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const AnalyticsShares = `-- name: AnalyticsShares :many
+SELECT share, COUNT(*)::INT AS laureates_count
+FROM analytics_laureates
+GROUP BY share
+ORDER BY share
+`
+
+type AnalyticsSharesRow struct {
+	Share          int32
+	LaureatesCount int32
+}
+
+func (q *Queries) AnalyticsShares(ctx context.Context) ([]AnalyticsSharesRow, error) {
+	rows, err := q.db.Query(ctx, AnalyticsShares)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalyticsSharesRow
+	for rows.Next() {
+		var i AnalyticsSharesRow
+		if err := rows.Scan(&i.Share, &i.LaureatesCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const RefreshAnalyticsAwards = `-- name: RefreshAnalyticsAwards :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY analytics_awards
+`
+
+func (q *Queries) RefreshAnalyticsAwards(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, RefreshAnalyticsAwards)
+	return err
+}
+
+const RefreshAnalyticsLaureates = `-- name: RefreshAnalyticsLaureates :exec
+REFRESH MATERIALIZED VIEW CONCURRENTLY analytics_laureates
+`
+
+func (q *Queries) RefreshAnalyticsLaureates(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, RefreshAnalyticsLaureates)
+	return err
+}
+
+const RefreshAnalyticsPrizes = `-- name: RefreshAnalyticsPrizes :exec
+
+REFRESH MATERIALIZED VIEW CONCURRENTLY analytics_prizes
+`
+
+// Analytics read materialized views, see schema.sql
+func (q *Queries) RefreshAnalyticsPrizes(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, RefreshAnalyticsPrizes)
+	return err
+}
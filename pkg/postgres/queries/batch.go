@@ -76,9 +76,11 @@ func (b *AddPrizeBatchResults) Close() error {
 }
 
 const CreateLaureate = `-- name: CreateLaureate :batchone
-INSERT INTO laureates (id, firstname, surname, motivation, share)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, firstname, surname, motivation, share, updated_at
+INSERT INTO laureates (id, firstname, surname, motivation, share, type, gender,
+                       birth_date, birth_city, birth_country, birth_country_code,
+                       death_date, death_city, death_country, death_country_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code
 `
 
 type CreateLaureateBatchResults struct {
@@ -88,11 +90,21 @@ type CreateLaureateBatchResults struct {
 }
 
 type CreateLaureateParams struct {
-	ID         int32
-	Firstname  string
-	Surname    pgtype.Text
-	Motivation string
-	Share      int32
+	ID               int32
+	Firstname        string
+	Surname          pgtype.Text
+	Motivation       string
+	Share            int32
+	Type             string
+	Gender           pgtype.Text
+	BirthDate        pgtype.Date
+	BirthCity        pgtype.Text
+	BirthCountry     pgtype.Text
+	BirthCountryCode pgtype.Text
+	DeathDate        pgtype.Date
+	DeathCity        pgtype.Text
+	DeathCountry     pgtype.Text
+	DeathCountryCode pgtype.Text
 }
 
 func (q *Queries) CreateLaureate(ctx context.Context, arg []CreateLaureateParams) *CreateLaureateBatchResults {
@@ -104,6 +116,16 @@ func (q *Queries) CreateLaureate(ctx context.Context, arg []CreateLaureateParams
 			a.Surname,
 			a.Motivation,
 			a.Share,
+			a.Type,
+			a.Gender,
+			a.BirthDate,
+			a.BirthCity,
+			a.BirthCountry,
+			a.BirthCountryCode,
+			a.DeathDate,
+			a.DeathCity,
+			a.DeathCountry,
+			a.DeathCountryCode,
 		}
 		batch.Queue(CreateLaureate, vals...)
 	}
@@ -129,6 +151,16 @@ func (b *CreateLaureateBatchResults) QueryRow(f func(int, Laureate, error)) {
 			&i.Motivation,
 			&i.Share,
 			&i.UpdatedAt,
+			&i.Type,
+			&i.Gender,
+			&i.BirthDate,
+			&i.BirthCity,
+			&i.BirthCountry,
+			&i.BirthCountryCode,
+			&i.DeathDate,
+			&i.DeathCity,
+			&i.DeathCountry,
+			&i.DeathCountryCode,
 		)
 		if f != nil {
 			f(t, i, err)
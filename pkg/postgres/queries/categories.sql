@@ -0,0 +1,50 @@
+-- name: ListCategories :many
+SELECT * FROM categories
+ORDER BY slug;
+
+-- name: GetCategory :one
+SELECT * FROM categories
+WHERE slug = $1;
+
+-- name: GetCategoryForUpdate :one
+SELECT * FROM categories
+WHERE slug = $1
+FOR UPDATE;
+
+-- name: CreateCategory :one
+INSERT INTO categories (slug, name, localized_names, established_year)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: UpdateCategory :one
+UPDATE categories
+SET name = $2, localized_names = $3, established_year = $4, updated_at = NOW()
+WHERE slug = $1
+RETURNING *;
+
+-- name: DeleteCategory :execrows
+DELETE FROM categories WHERE slug = $1;
+
+-- name: GetFirstPrizeYear :one
+-- 0 if the category has no prizes
+SELECT COALESCE(MIN(year), 0)::int FROM prizes
+WHERE category = $1;
+
+-- name: GetCategorySummaries :many
+-- Categories with the counts of their prizes and laureates, including categories without prizes.
+-- first_year and last_year are 0 if the category has no prizes.
+SELECT
+  c.slug,
+  c.name,
+  c.localized_names,
+  c.established_year,
+  COUNT(DISTINCT p.id) AS prizes_count,
+  COUNT(DISTINCT ptl.laureate_id) AS laureates_count,
+  COALESCE(MIN(p.year), 0)::int AS first_year,
+  COALESCE(MAX(p.year), 0)::int AS last_year
+FROM categories c
+LEFT JOIN prizes p ON p.category = c.slug
+LEFT JOIN prizes_to_laureates ptl ON ptl.prize_id = p.id
+WHERE sqlc.narg(slugs)::text[] IS NULL OR c.slug = ANY(sqlc.narg(slugs)::text[])
+GROUP BY c.slug
+ORDER BY c.slug;
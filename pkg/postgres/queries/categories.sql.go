@@ -0,0 +1,231 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: categories.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const CreateCategory = `-- name: CreateCategory :one
+INSERT INTO categories (slug, name, localized_names, established_year)
+VALUES ($1, $2, $3, $4)
+RETURNING slug, name, localized_names, established_year, created_at, updated_at
+`
+
+type CreateCategoryParams struct {
+	Slug            string
+	Name            string
+	LocalizedNames  []byte
+	EstablishedYear int32
+}
+
+func (q *Queries) CreateCategory(ctx context.Context, arg CreateCategoryParams) (Category, error) {
+	row := q.db.QueryRow(ctx, CreateCategory,
+		arg.Slug,
+		arg.Name,
+		arg.LocalizedNames,
+		arg.EstablishedYear,
+	)
+	var i Category
+	err := row.Scan(
+		&i.Slug,
+		&i.Name,
+		&i.LocalizedNames,
+		&i.EstablishedYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const DeleteCategory = `-- name: DeleteCategory :execrows
+DELETE FROM categories WHERE slug = $1
+`
+
+func (q *Queries) DeleteCategory(ctx context.Context, slug string) (int64, error) {
+	result, err := q.db.Exec(ctx, DeleteCategory, slug)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const GetCategory = `-- name: GetCategory :one
+SELECT slug, name, localized_names, established_year, created_at, updated_at FROM categories
+WHERE slug = $1
+`
+
+func (q *Queries) GetCategory(ctx context.Context, slug string) (Category, error) {
+	row := q.db.QueryRow(ctx, GetCategory, slug)
+	var i Category
+	err := row.Scan(
+		&i.Slug,
+		&i.Name,
+		&i.LocalizedNames,
+		&i.EstablishedYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetCategoryForUpdate = `-- name: GetCategoryForUpdate :one
+SELECT slug, name, localized_names, established_year, created_at, updated_at FROM categories
+WHERE slug = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetCategoryForUpdate(ctx context.Context, slug string) (Category, error) {
+	row := q.db.QueryRow(ctx, GetCategoryForUpdate, slug)
+	var i Category
+	err := row.Scan(
+		&i.Slug,
+		&i.Name,
+		&i.LocalizedNames,
+		&i.EstablishedYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const GetCategorySummaries = `-- name: GetCategorySummaries :many
+SELECT
+  c.slug,
+  c.name,
+  c.localized_names,
+  c.established_year,
+  COUNT(DISTINCT p.id) AS prizes_count,
+  COUNT(DISTINCT ptl.laureate_id) AS laureates_count,
+  COALESCE(MIN(p.year), 0)::int AS first_year,
+  COALESCE(MAX(p.year), 0)::int AS last_year
+FROM categories c
+LEFT JOIN prizes p ON p.category = c.slug
+LEFT JOIN prizes_to_laureates ptl ON ptl.prize_id = p.id
+WHERE $1::text[] IS NULL OR c.slug = ANY($1::text[])
+GROUP BY c.slug
+ORDER BY c.slug
+`
+
+type GetCategorySummariesRow struct {
+	Slug            string
+	Name            string
+	LocalizedNames  []byte
+	EstablishedYear int32
+	PrizesCount     int64
+	LaureatesCount  int64
+	FirstYear       int32
+	LastYear        int32
+}
+
+// Categories with the counts of their prizes and laureates, including categories without prizes.
+// first_year and last_year are 0 if the category has no prizes.
+func (q *Queries) GetCategorySummaries(ctx context.Context, slugs []string) ([]GetCategorySummariesRow, error) {
+	rows, err := q.db.Query(ctx, GetCategorySummaries, slugs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCategorySummariesRow
+	for rows.Next() {
+		var i GetCategorySummariesRow
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Name,
+			&i.LocalizedNames,
+			&i.EstablishedYear,
+			&i.PrizesCount,
+			&i.LaureatesCount,
+			&i.FirstYear,
+			&i.LastYear,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetFirstPrizeYear = `-- name: GetFirstPrizeYear :one
+SELECT COALESCE(MIN(year), 0)::int FROM prizes
+WHERE category = $1
+`
+
+// 0 if the category has no prizes
+func (q *Queries) GetFirstPrizeYear(ctx context.Context, category string) (int32, error) {
+	row := q.db.QueryRow(ctx, GetFirstPrizeYear, category)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const ListCategories = `-- name: ListCategories :many
+SELECT slug, name, localized_names, established_year, created_at, updated_at FROM categories
+ORDER BY slug
+`
+
+func (q *Queries) ListCategories(ctx context.Context) ([]Category, error) {
+	rows, err := q.db.Query(ctx, ListCategories)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Category
+	for rows.Next() {
+		var i Category
+		if err := rows.Scan(
+			&i.Slug,
+			&i.Name,
+			&i.LocalizedNames,
+			&i.EstablishedYear,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const UpdateCategory = `-- name: UpdateCategory :one
+UPDATE categories
+SET name = $2, localized_names = $3, established_year = $4, updated_at = NOW()
+WHERE slug = $1
+RETURNING slug, name, localized_names, established_year, created_at, updated_at
+`
+
+type UpdateCategoryParams struct {
+	Slug            string
+	Name            string
+	LocalizedNames  []byte
+	EstablishedYear int32
+}
+
+func (q *Queries) UpdateCategory(ctx context.Context, arg UpdateCategoryParams) (Category, error) {
+	row := q.db.QueryRow(ctx, UpdateCategory,
+		arg.Slug,
+		arg.Name,
+		arg.LocalizedNames,
+		arg.EstablishedYear,
+	)
+	var i Category
+	err := row.Scan(
+		&i.Slug,
+		&i.Name,
+		&i.LocalizedNames,
+		&i.EstablishedYear,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
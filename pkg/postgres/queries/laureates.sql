@@ -6,27 +6,26 @@ SELECT * FROM laureates
 SELECT * FROM laureates
             ORDER BY id;
 
--- name: ListLaureatesPaginated :many
-SELECT * FROM laureates
-            ORDER BY id
-            LIMIT $1 OFFSET $2;
-
--- name: CountLaureates :one
-SELECT COUNT(*) FROM laureates;
-
 -- name: CreateLaureate :batchone
-INSERT INTO laureates (id, firstname, surname, motivation, share)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO laureates (id, firstname, surname, motivation, share, type, gender,
+                       birth_date, birth_city, birth_country, birth_country_code,
+                       death_date, death_city, death_country, death_country_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 RETURNING *;
 
 -- name: CreateLaureateSingle :one
-INSERT INTO laureates (id, firstname, surname, motivation, share)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO laureates (id, firstname, surname, motivation, share, type, gender,
+                       birth_date, birth_city, birth_country, birth_country_code,
+                       death_date, death_city, death_country, death_country_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 RETURNING *;
 
 -- name: UpdateLaureate :one
 UPDATE laureates
-SET firstname = $2, surname = $3, motivation = $4, share = $5, updated_at = NOW()
+SET firstname = $2, surname = $3, motivation = $4, share = $5, type = $6, gender = $7,
+    birth_date = $8, birth_city = $9, birth_country = $10, birth_country_code = $11,
+    death_date = $12, death_city = $13, death_country = $14, death_country_code = $15,
+    updated_at = NOW()
 WHERE id = $1
 RETURNING *;
 
@@ -39,4 +38,75 @@ VALUES ($1, $2);
 
 -- name: LinkLaureateToPrizeSingle :exec
 INSERT INTO prizes_to_laureates (prize_id, laureate_id)
-VALUES ($1, $2);
\ No newline at end of file
+VALUES ($1, $2);
+
+-- name: GetLaureateForUpdate :one
+SELECT * FROM laureates
+         WHERE id = $1
+         FOR UPDATE;
+
+-- name: GetPrizeIdsByLaureate :many
+SELECT prize_id FROM prizes_to_laureates
+            WHERE laureate_id = $1
+            ORDER BY prize_id;
+
+-- name: LinkLaureateToPrizeIfMissing :execrows
+INSERT INTO prizes_to_laureates (prize_id, laureate_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UnlinkLaureateFromPrize :execrows
+DELETE FROM prizes_to_laureates
+WHERE prize_id = $1 AND laureate_id = $2;
+
+-- name: GetLaureatesByIds :many
+SELECT * FROM laureates
+         WHERE id = ANY(@ids::int[]);
+
+-- name: GetLaureatesByPrizeIds :many
+SELECT ptl.prize_id, sqlc.embed(laureates)
+FROM prizes_to_laureates ptl
+INNER JOIN laureates ON laureates.id = ptl.laureate_id
+WHERE ptl.prize_id = ANY(@prize_ids::int[])
+ORDER BY ptl.prize_id, laureates.id;
+
+-- A two letter country is a code of the birth country, a longer one a part of its name.
+-- Birth years are inclusive, laureates with an unknown birth date do not match them.
+-- name: ListLaureatesFiltered :many
+SELECT * FROM laureates
+WHERE (sqlc.narg(name)::text IS NULL
+       OR firstname ILIKE '%' || sqlc.narg(name) || '%'
+       OR surname ILIKE '%' || sqlc.narg(name) || '%')
+  AND (sqlc.narg(share)::int IS NULL OR share = sqlc.narg(share))
+  AND (sqlc.narg(type)::text IS NULL OR type = sqlc.narg(type))
+  AND (sqlc.narg(gender)::text IS NULL OR gender = sqlc.narg(gender))
+  AND (sqlc.narg(country)::text IS NULL
+       OR CASE WHEN LENGTH(sqlc.narg(country)) = 2 THEN birth_country_code = UPPER(sqlc.narg(country))
+               ELSE birth_country ILIKE '%' || sqlc.narg(country) || '%' END)
+  AND (sqlc.narg(born_from)::int IS NULL OR birth_date >= MAKE_DATE(sqlc.narg(born_from), 1, 1))
+  AND (sqlc.narg(born_to)::int IS NULL OR birth_date < MAKE_DATE(sqlc.narg(born_to) + 1, 1, 1))
+ORDER BY id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: CountLaureatesFiltered :one
+SELECT COUNT(*) FROM laureates
+WHERE (sqlc.narg(name)::text IS NULL
+       OR firstname ILIKE '%' || sqlc.narg(name) || '%'
+       OR surname ILIKE '%' || sqlc.narg(name) || '%')
+  AND (sqlc.narg(share)::int IS NULL OR share = sqlc.narg(share))
+  AND (sqlc.narg(type)::text IS NULL OR type = sqlc.narg(type))
+  AND (sqlc.narg(gender)::text IS NULL OR gender = sqlc.narg(gender))
+  AND (sqlc.narg(country)::text IS NULL
+       OR CASE WHEN LENGTH(sqlc.narg(country)) = 2 THEN birth_country_code = UPPER(sqlc.narg(country))
+               ELSE birth_country ILIKE '%' || sqlc.narg(country) || '%' END)
+  AND (sqlc.narg(born_from)::int IS NULL OR birth_date >= MAKE_DATE(sqlc.narg(born_from), 1, 1))
+  AND (sqlc.narg(born_to)::int IS NULL OR birth_date < MAKE_DATE(sqlc.narg(born_to) + 1, 1, 1));
+
+-- ExportLaureates is read through a cursor by the export, see internal/export
+-- name: ExportLaureates :many
+SELECT id, firstname, surname, motivation, share, updated_at FROM laureates
+WHERE (sqlc.narg(name)::text IS NULL
+       OR firstname ILIKE '%' || sqlc.narg(name) || '%'
+       OR surname ILIKE '%' || sqlc.narg(name) || '%')
+  AND (sqlc.narg(share)::int IS NULL OR share = sqlc.narg(share))
+ORDER BY id;
@@ -11,29 +11,70 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const CountLaureates = `-- name: CountLaureates :one
+const CountLaureatesFiltered = `-- name: CountLaureatesFiltered :one
 SELECT COUNT(*) FROM laureates
+WHERE ($1::text IS NULL
+       OR firstname ILIKE '%' || $1 || '%'
+       OR surname ILIKE '%' || $1 || '%')
+  AND ($2::int IS NULL OR share = $2)
+  AND ($3::text IS NULL OR type = $3)
+  AND ($4::text IS NULL OR gender = $4)
+  AND ($5::text IS NULL
+       OR CASE WHEN LENGTH($5) = 2 THEN birth_country_code = UPPER($5)
+               ELSE birth_country ILIKE '%' || $5 || '%' END)
+  AND ($6::int IS NULL OR birth_date >= MAKE_DATE($6, 1, 1))
+  AND ($7::int IS NULL OR birth_date < MAKE_DATE($7 + 1, 1, 1))
 `
 
-func (q *Queries) CountLaureates(ctx context.Context) (int64, error) {
-	row := q.db.QueryRow(ctx, CountLaureates)
+type CountLaureatesFilteredParams struct {
+	Name     pgtype.Text
+	Share    pgtype.Int4
+	Type     pgtype.Text
+	Gender   pgtype.Text
+	Country  pgtype.Text
+	BornFrom pgtype.Int4
+	BornTo   pgtype.Int4
+}
+
+func (q *Queries) CountLaureatesFiltered(ctx context.Context, arg CountLaureatesFilteredParams) (int64, error) {
+	row := q.db.QueryRow(ctx, CountLaureatesFiltered,
+		arg.Name,
+		arg.Share,
+		arg.Type,
+		arg.Gender,
+		arg.Country,
+		arg.BornFrom,
+		arg.BornTo,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const CreateLaureateSingle = `-- name: CreateLaureateSingle :one
-INSERT INTO laureates (id, firstname, surname, motivation, share)
-VALUES ($1, $2, $3, $4, $5)
-RETURNING id, firstname, surname, motivation, share, updated_at
+INSERT INTO laureates (id, firstname, surname, motivation, share, type, gender,
+                       birth_date, birth_city, birth_country, birth_country_code,
+                       death_date, death_city, death_country, death_country_code)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+RETURNING id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code
 `
 
 type CreateLaureateSingleParams struct {
-	ID         int32
-	Firstname  string
-	Surname    pgtype.Text
-	Motivation string
-	Share      int32
+	ID               int32
+	Firstname        string
+	Surname          pgtype.Text
+	Motivation       string
+	Share            int32
+	Type             string
+	Gender           pgtype.Text
+	BirthDate        pgtype.Date
+	BirthCity        pgtype.Text
+	BirthCountry     pgtype.Text
+	BirthCountryCode pgtype.Text
+	DeathDate        pgtype.Date
+	DeathCity        pgtype.Text
+	DeathCountry     pgtype.Text
+	DeathCountryCode pgtype.Text
 }
 
 func (q *Queries) CreateLaureateSingle(ctx context.Context, arg CreateLaureateSingleParams) (Laureate, error) {
@@ -43,6 +84,16 @@ func (q *Queries) CreateLaureateSingle(ctx context.Context, arg CreateLaureateSi
 		arg.Surname,
 		arg.Motivation,
 		arg.Share,
+		arg.Type,
+		arg.Gender,
+		arg.BirthDate,
+		arg.BirthCity,
+		arg.BirthCountry,
+		arg.BirthCountryCode,
+		arg.DeathDate,
+		arg.DeathCity,
+		arg.DeathCountry,
+		arg.DeathCountryCode,
 	)
 	var i Laureate
 	err := row.Scan(
@@ -52,6 +103,16 @@ func (q *Queries) CreateLaureateSingle(ctx context.Context, arg CreateLaureateSi
 		&i.Motivation,
 		&i.Share,
 		&i.UpdatedAt,
+		&i.Type,
+		&i.Gender,
+		&i.BirthDate,
+		&i.BirthCity,
+		&i.BirthCountry,
+		&i.BirthCountryCode,
+		&i.DeathDate,
+		&i.DeathCity,
+		&i.DeathCountry,
+		&i.DeathCountryCode,
 	)
 	return i, err
 }
@@ -65,8 +126,59 @@ func (q *Queries) DeleteLaureate(ctx context.Context, id int32) error {
 	return err
 }
 
-const GetLaureate = `-- name: GetLaureate :one
+const ExportLaureates = `-- name: ExportLaureates :many
 SELECT id, firstname, surname, motivation, share, updated_at FROM laureates
+WHERE ($1::text IS NULL
+       OR firstname ILIKE '%' || $1 || '%'
+       OR surname ILIKE '%' || $1 || '%')
+  AND ($2::int IS NULL OR share = $2)
+ORDER BY id
+`
+
+type ExportLaureatesParams struct {
+	Name  pgtype.Text
+	Share pgtype.Int4
+}
+
+type ExportLaureatesRow struct {
+	ID         int32
+	Firstname  string
+	Surname    pgtype.Text
+	Motivation string
+	Share      int32
+	UpdatedAt  pgtype.Timestamp
+}
+
+// ExportLaureates is read through a cursor by the export, see internal/export
+func (q *Queries) ExportLaureates(ctx context.Context, arg ExportLaureatesParams) ([]ExportLaureatesRow, error) {
+	rows, err := q.db.Query(ctx, ExportLaureates, arg.Name, arg.Share)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ExportLaureatesRow
+	for rows.Next() {
+		var i ExportLaureatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Firstname,
+			&i.Surname,
+			&i.Motivation,
+			&i.Share,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetLaureate = `-- name: GetLaureate :one
+SELECT id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code FROM laureates
          WHERE id = $1
 `
 
@@ -80,10 +192,188 @@ func (q *Queries) GetLaureate(ctx context.Context, id int32) (Laureate, error) {
 		&i.Motivation,
 		&i.Share,
 		&i.UpdatedAt,
+		&i.Type,
+		&i.Gender,
+		&i.BirthDate,
+		&i.BirthCity,
+		&i.BirthCountry,
+		&i.BirthCountryCode,
+		&i.DeathDate,
+		&i.DeathCity,
+		&i.DeathCountry,
+		&i.DeathCountryCode,
 	)
 	return i, err
 }
 
+const GetLaureateForUpdate = `-- name: GetLaureateForUpdate :one
+SELECT id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code FROM laureates
+         WHERE id = $1
+         FOR UPDATE
+`
+
+func (q *Queries) GetLaureateForUpdate(ctx context.Context, id int32) (Laureate, error) {
+	row := q.db.QueryRow(ctx, GetLaureateForUpdate, id)
+	var i Laureate
+	err := row.Scan(
+		&i.ID,
+		&i.Firstname,
+		&i.Surname,
+		&i.Motivation,
+		&i.Share,
+		&i.UpdatedAt,
+		&i.Type,
+		&i.Gender,
+		&i.BirthDate,
+		&i.BirthCity,
+		&i.BirthCountry,
+		&i.BirthCountryCode,
+		&i.DeathDate,
+		&i.DeathCity,
+		&i.DeathCountry,
+		&i.DeathCountryCode,
+	)
+	return i, err
+}
+
+const GetLaureatesByIds = `-- name: GetLaureatesByIds :many
+SELECT id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code FROM laureates
+         WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetLaureatesByIds(ctx context.Context, ids []int32) ([]Laureate, error) {
+	rows, err := q.db.Query(ctx, GetLaureatesByIds, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Laureate
+	for rows.Next() {
+		var i Laureate
+		if err := rows.Scan(
+			&i.ID,
+			&i.Firstname,
+			&i.Surname,
+			&i.Motivation,
+			&i.Share,
+			&i.UpdatedAt,
+			&i.Type,
+			&i.Gender,
+			&i.BirthDate,
+			&i.BirthCity,
+			&i.BirthCountry,
+			&i.BirthCountryCode,
+			&i.DeathDate,
+			&i.DeathCity,
+			&i.DeathCountry,
+			&i.DeathCountryCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetLaureatesByPrizeIds = `-- name: GetLaureatesByPrizeIds :many
+SELECT ptl.prize_id, laureates.id, laureates.firstname, laureates.surname, laureates.motivation, laureates.share, laureates.updated_at, laureates.type, laureates.gender, laureates.birth_date, laureates.birth_city, laureates.birth_country, laureates.birth_country_code, laureates.death_date, laureates.death_city, laureates.death_country, laureates.death_country_code
+FROM prizes_to_laureates ptl
+INNER JOIN laureates ON laureates.id = ptl.laureate_id
+WHERE ptl.prize_id = ANY($1::int[])
+ORDER BY ptl.prize_id, laureates.id
+`
+
+type GetLaureatesByPrizeIdsRow struct {
+	PrizeID  int32
+	Laureate Laureate
+}
+
+func (q *Queries) GetLaureatesByPrizeIds(ctx context.Context, prizeIds []int32) ([]GetLaureatesByPrizeIdsRow, error) {
+	rows, err := q.db.Query(ctx, GetLaureatesByPrizeIds, prizeIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLaureatesByPrizeIdsRow
+	for rows.Next() {
+		var i GetLaureatesByPrizeIdsRow
+		if err := rows.Scan(
+			&i.PrizeID,
+			&i.Laureate.ID,
+			&i.Laureate.Firstname,
+			&i.Laureate.Surname,
+			&i.Laureate.Motivation,
+			&i.Laureate.Share,
+			&i.Laureate.UpdatedAt,
+			&i.Laureate.Type,
+			&i.Laureate.Gender,
+			&i.Laureate.BirthDate,
+			&i.Laureate.BirthCity,
+			&i.Laureate.BirthCountry,
+			&i.Laureate.BirthCountryCode,
+			&i.Laureate.DeathDate,
+			&i.Laureate.DeathCity,
+			&i.Laureate.DeathCountry,
+			&i.Laureate.DeathCountryCode,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetPrizeIdsByLaureate = `-- name: GetPrizeIdsByLaureate :many
+SELECT prize_id FROM prizes_to_laureates
+            WHERE laureate_id = $1
+            ORDER BY prize_id
+`
+
+func (q *Queries) GetPrizeIdsByLaureate(ctx context.Context, laureateID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, GetPrizeIdsByLaureate, laureateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var prize_id int32
+		if err := rows.Scan(&prize_id); err != nil {
+			return nil, err
+		}
+		items = append(items, prize_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const LinkLaureateToPrizeIfMissing = `-- name: LinkLaureateToPrizeIfMissing :execrows
+INSERT INTO prizes_to_laureates (prize_id, laureate_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type LinkLaureateToPrizeIfMissingParams struct {
+	PrizeID    int32
+	LaureateID int32
+}
+
+func (q *Queries) LinkLaureateToPrizeIfMissing(ctx context.Context, arg LinkLaureateToPrizeIfMissingParams) (int64, error) {
+	result, err := q.db.Exec(ctx, LinkLaureateToPrizeIfMissing, arg.PrizeID, arg.LaureateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const LinkLaureateToPrizeSingle = `-- name: LinkLaureateToPrizeSingle :exec
 INSERT INTO prizes_to_laureates (prize_id, laureate_id)
 VALUES ($1, $2)
@@ -100,7 +390,7 @@ func (q *Queries) LinkLaureateToPrizeSingle(ctx context.Context, arg LinkLaureat
 }
 
 const ListLaureates = `-- name: ListLaureates :many
-SELECT id, firstname, surname, motivation, share, updated_at FROM laureates
+SELECT id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code FROM laureates
             ORDER BY id
 `
 
@@ -120,6 +410,16 @@ func (q *Queries) ListLaureates(ctx context.Context) ([]Laureate, error) {
 			&i.Motivation,
 			&i.Share,
 			&i.UpdatedAt,
+			&i.Type,
+			&i.Gender,
+			&i.BirthDate,
+			&i.BirthCity,
+			&i.BirthCountry,
+			&i.BirthCountryCode,
+			&i.DeathDate,
+			&i.DeathCity,
+			&i.DeathCountry,
+			&i.DeathCountryCode,
 		); err != nil {
 			return nil, err
 		}
@@ -131,19 +431,49 @@ func (q *Queries) ListLaureates(ctx context.Context) ([]Laureate, error) {
 	return items, nil
 }
 
-const ListLaureatesPaginated = `-- name: ListLaureatesPaginated :many
-SELECT id, firstname, surname, motivation, share, updated_at FROM laureates
-            ORDER BY id
-            LIMIT $1 OFFSET $2
+const ListLaureatesFiltered = `-- name: ListLaureatesFiltered :many
+SELECT id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code FROM laureates
+WHERE ($1::text IS NULL
+       OR firstname ILIKE '%' || $1 || '%'
+       OR surname ILIKE '%' || $1 || '%')
+  AND ($2::int IS NULL OR share = $2)
+  AND ($3::text IS NULL OR type = $3)
+  AND ($4::text IS NULL OR gender = $4)
+  AND ($5::text IS NULL
+       OR CASE WHEN LENGTH($5) = 2 THEN birth_country_code = UPPER($5)
+               ELSE birth_country ILIKE '%' || $5 || '%' END)
+  AND ($6::int IS NULL OR birth_date >= MAKE_DATE($6, 1, 1))
+  AND ($7::int IS NULL OR birth_date < MAKE_DATE($7 + 1, 1, 1))
+ORDER BY id
+LIMIT $9 OFFSET $8
 `
 
-type ListLaureatesPaginatedParams struct {
-	Limit  int32
-	Offset int32
+type ListLaureatesFilteredParams struct {
+	Name       pgtype.Text
+	Share      pgtype.Int4
+	Type       pgtype.Text
+	Gender     pgtype.Text
+	Country    pgtype.Text
+	BornFrom   pgtype.Int4
+	BornTo     pgtype.Int4
+	PageOffset int32
+	PageLimit  int32
 }
 
-func (q *Queries) ListLaureatesPaginated(ctx context.Context, arg ListLaureatesPaginatedParams) ([]Laureate, error) {
-	rows, err := q.db.Query(ctx, ListLaureatesPaginated, arg.Limit, arg.Offset)
+// A two letter country is a code of the birth country, a longer one a part of its name.
+// Birth years are inclusive, laureates with an unknown birth date do not match them.
+func (q *Queries) ListLaureatesFiltered(ctx context.Context, arg ListLaureatesFilteredParams) ([]Laureate, error) {
+	rows, err := q.db.Query(ctx, ListLaureatesFiltered,
+		arg.Name,
+		arg.Share,
+		arg.Type,
+		arg.Gender,
+		arg.Country,
+		arg.BornFrom,
+		arg.BornTo,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +488,16 @@ func (q *Queries) ListLaureatesPaginated(ctx context.Context, arg ListLaureatesP
 			&i.Motivation,
 			&i.Share,
 			&i.UpdatedAt,
+			&i.Type,
+			&i.Gender,
+			&i.BirthDate,
+			&i.BirthCity,
+			&i.BirthCountry,
+			&i.BirthCountryCode,
+			&i.DeathDate,
+			&i.DeathCity,
+			&i.DeathCountry,
+			&i.DeathCountryCode,
 		); err != nil {
 			return nil, err
 		}
@@ -169,19 +509,50 @@ func (q *Queries) ListLaureatesPaginated(ctx context.Context, arg ListLaureatesP
 	return items, nil
 }
 
+const UnlinkLaureateFromPrize = `-- name: UnlinkLaureateFromPrize :execrows
+DELETE FROM prizes_to_laureates
+WHERE prize_id = $1 AND laureate_id = $2
+`
+
+type UnlinkLaureateFromPrizeParams struct {
+	PrizeID    int32
+	LaureateID int32
+}
+
+func (q *Queries) UnlinkLaureateFromPrize(ctx context.Context, arg UnlinkLaureateFromPrizeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, UnlinkLaureateFromPrize, arg.PrizeID, arg.LaureateID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const UpdateLaureate = `-- name: UpdateLaureate :one
 UPDATE laureates
-SET firstname = $2, surname = $3, motivation = $4, share = $5, updated_at = NOW()
+SET firstname = $2, surname = $3, motivation = $4, share = $5, type = $6, gender = $7,
+    birth_date = $8, birth_city = $9, birth_country = $10, birth_country_code = $11,
+    death_date = $12, death_city = $13, death_country = $14, death_country_code = $15,
+    updated_at = NOW()
 WHERE id = $1
-RETURNING id, firstname, surname, motivation, share, updated_at
+RETURNING id, firstname, surname, motivation, share, updated_at, type, gender, birth_date, birth_city, birth_country, birth_country_code, death_date, death_city, death_country, death_country_code
 `
 
 type UpdateLaureateParams struct {
-	ID         int32
-	Firstname  string
-	Surname    pgtype.Text
-	Motivation string
-	Share      int32
+	ID               int32
+	Firstname        string
+	Surname          pgtype.Text
+	Motivation       string
+	Share            int32
+	Type             string
+	Gender           pgtype.Text
+	BirthDate        pgtype.Date
+	BirthCity        pgtype.Text
+	BirthCountry     pgtype.Text
+	BirthCountryCode pgtype.Text
+	DeathDate        pgtype.Date
+	DeathCity        pgtype.Text
+	DeathCountry     pgtype.Text
+	DeathCountryCode pgtype.Text
 }
 
 func (q *Queries) UpdateLaureate(ctx context.Context, arg UpdateLaureateParams) (Laureate, error) {
@@ -191,6 +562,16 @@ func (q *Queries) UpdateLaureate(ctx context.Context, arg UpdateLaureateParams)
 		arg.Surname,
 		arg.Motivation,
 		arg.Share,
+		arg.Type,
+		arg.Gender,
+		arg.BirthDate,
+		arg.BirthCity,
+		arg.BirthCountry,
+		arg.BirthCountryCode,
+		arg.DeathDate,
+		arg.DeathCity,
+		arg.DeathCountry,
+		arg.DeathCountryCode,
 	)
 	var i Laureate
 	err := row.Scan(
@@ -200,6 +581,16 @@ func (q *Queries) UpdateLaureate(ctx context.Context, arg UpdateLaureateParams)
 		&i.Motivation,
 		&i.Share,
 		&i.UpdatedAt,
+		&i.Type,
+		&i.Gender,
+		&i.BirthDate,
+		&i.BirthCity,
+		&i.BirthCountry,
+		&i.BirthCountryCode,
+		&i.DeathDate,
+		&i.DeathCity,
+		&i.DeathCountry,
+		&i.DeathCountryCode,
 	)
 	return i, err
 }
@@ -8,13 +8,104 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Affiliation struct {
+	ID      int32
+	Name    string
+	City    string
+	Country string
+}
+
+type AnalyticsAward struct {
+	PrizeID    int32
+	LaureateID int32
+	Year       int32
+	Decade     int32
+	Category   string
+	Type       string
+	Gender     pgtype.Text
+}
+
+type AnalyticsLaureate struct {
+	LaureateID  int32
+	Firstname   string
+	Surname     string
+	Share       int32
+	PrizesCount int32
+	FirstYear   int32
+	LastYear    int32
+}
+
+type AnalyticsPrize struct {
+	PrizeID        int32
+	Year           int32
+	Decade         int32
+	Category       string
+	LaureatesCount int32
+}
+
+type AwardAffiliation struct {
+	PrizeID       int32
+	LaureateID    int32
+	AffiliationID int32
+}
+
+type Category struct {
+	Slug            string
+	Name            string
+	LocalizedNames  []byte
+	EstablishedYear int32
+	CreatedAt       pgtype.Timestamptz
+	UpdatedAt       pgtype.Timestamptz
+}
+
+type CategoryDecadeStat struct {
+	Category       string
+	Decade         int32
+	PrizesCount    int32
+	LaureatesCount int32
+	UpdatedAt      pgtype.Timestamptz
+}
+
 type Laureate struct {
-	ID         int32
-	Firstname  string
-	Surname    pgtype.Text
-	Motivation string
-	Share      int32
-	UpdatedAt  pgtype.Timestamp
+	ID               int32
+	Firstname        string
+	Surname          pgtype.Text
+	Motivation       string
+	Share            int32
+	UpdatedAt        pgtype.Timestamp
+	Type             string
+	Gender           pgtype.Text
+	BirthDate        pgtype.Date
+	BirthCity        pgtype.Text
+	BirthCountry     pgtype.Text
+	BirthCountryCode pgtype.Text
+	DeathDate        pgtype.Date
+	DeathCity        pgtype.Text
+	DeathCountry     pgtype.Text
+	DeathCountryCode pgtype.Text
+}
+
+type LaureateProfile struct {
+	LaureateID  int32
+	Firstname   string
+	Surname     string
+	Motivation  string
+	Share       int32
+	PrizesCount int32
+	Prizes      []byte
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type Outbox struct {
+	ID            int64
+	Subject       string
+	Payload       []byte
+	Headers       []byte
+	CreatedAt     pgtype.Timestamptz
+	Attempts      int32
+	NextAttemptAt pgtype.Timestamptz
+	LastError     pgtype.Text
+	DeliveredAt   pgtype.Timestamptz
 }
 
 type Prize struct {
@@ -28,3 +119,75 @@ type PrizesToLaureate struct {
 	PrizeID    int32
 	LaureateID int32
 }
+
+type ProjectionCheckpoint struct {
+	Name           string
+	StreamSequence int64
+	UpdatedAt      pgtype.Timestamptz
+}
+
+type ProjectionEvent struct {
+	EventID        string
+	StreamSequence int64
+	AppliedAt      pgtype.Timestamptz
+}
+
+type ProjectionLaureate struct {
+	ID         int32
+	Firstname  string
+	Surname    string
+	Motivation string
+	Share      int32
+}
+
+type ProjectionPrize struct {
+	ID       int32
+	Year     int32
+	Category string
+}
+
+type ProjectionPrizeLaureate struct {
+	PrizeID    int32
+	LaureateID int32
+}
+
+type RateLimitBucket struct {
+	Key       string
+	Tokens    float64
+	UpdatedAt pgtype.Timestamptz
+}
+
+type SchemaVersion struct {
+	ID      bool
+	Version int32
+}
+
+type Webhook struct {
+	ID                  int64
+	Url                 string
+	EventTypes          []string
+	Secret              string
+	Description         string
+	Active              bool
+	ConsecutiveFailures int32
+	DisabledAt          pgtype.Timestamptz
+	DisabledReason      pgtype.Text
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        string
+	EventType      string
+	Payload        []byte
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	ResponseStatus pgtype.Int4
+	LastError      pgtype.Text
+	DurationMs     pgtype.Int4
+	CreatedAt      pgtype.Timestamptz
+	CompletedAt    pgtype.Timestamptz
+}
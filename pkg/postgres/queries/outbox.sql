@@ -0,0 +1,50 @@
+-- name: EnqueueOutbox :one
+INSERT INTO outbox (subject, payload, headers)
+VALUES ($1, $2, $3)
+RETURNING id;
+
+-- LockOutboxRelay serializes claims of all relays until the end of the transaction
+-- name: LockOutboxRelay :exec
+SELECT pg_advisory_xact_lock(hashtext('outbox_relay'));
+
+-- ClaimPendingOutbox leases due events until lease_until, other relays skip them meanwhile.
+-- Events are published in order: the batch ends before the first undelivered event
+-- which is not due, i.e. waits for a retry or is leased by another relay.
+-- name: ClaimPendingOutbox :many
+WITH pending AS (
+    SELECT o.id, o.next_attempt_at <= NOW() AS due
+    FROM outbox o
+    WHERE o.delivered_at IS NULL
+    ORDER BY o.id
+    LIMIT sqlc.arg(batch_size)
+)
+UPDATE outbox
+SET next_attempt_at = sqlc.arg(lease_until)
+WHERE outbox.id IN (
+    SELECT p.id FROM pending p
+    WHERE p.id < COALESCE((SELECT MIN(w.id) FROM pending w WHERE NOT w.due), 9223372036854775807)
+)
+RETURNING outbox.id, outbox.subject, outbox.payload, outbox.headers, outbox.attempts;
+
+-- name: ReleaseOutbox :exec
+UPDATE outbox
+SET next_attempt_at = NOW()
+WHERE id = ANY(@ids::bigint[]) AND delivered_at IS NULL;
+
+-- name: MarkOutboxDelivered :exec
+UPDATE outbox
+SET delivered_at = NOW(), attempts = attempts + 1, last_error = NULL
+WHERE id = $1;
+
+-- name: MarkOutboxFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+WHERE id = $1;
+
+-- name: CountPendingOutbox :one
+SELECT COUNT(*) FROM outbox
+WHERE delivered_at IS NULL;
+
+-- name: DeleteDeliveredOutbox :execrows
+DELETE FROM outbox
+WHERE delivered_at < $1;
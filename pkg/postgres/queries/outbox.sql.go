@@ -0,0 +1,164 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: outbox.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const ClaimPendingOutbox = `-- name: ClaimPendingOutbox :many
+WITH pending AS (
+    SELECT o.id, o.next_attempt_at <= NOW() AS due
+    FROM outbox o
+    WHERE o.delivered_at IS NULL
+    ORDER BY o.id
+    LIMIT $2
+)
+UPDATE outbox
+SET next_attempt_at = $1
+WHERE outbox.id IN (
+    SELECT p.id FROM pending p
+    WHERE p.id < COALESCE((SELECT MIN(w.id) FROM pending w WHERE NOT w.due), 9223372036854775807)
+)
+RETURNING outbox.id, outbox.subject, outbox.payload, outbox.headers, outbox.attempts
+`
+
+type ClaimPendingOutboxParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+type ClaimPendingOutboxRow struct {
+	ID       int64
+	Subject  string
+	Payload  []byte
+	Headers  []byte
+	Attempts int32
+}
+
+// ClaimPendingOutbox leases due events until lease_until, other relays skip them meanwhile.
+// Events are published in order: the batch ends before the first undelivered event
+// which is not due, i.e. waits for a retry or is leased by another relay.
+func (q *Queries) ClaimPendingOutbox(ctx context.Context, arg ClaimPendingOutboxParams) ([]ClaimPendingOutboxRow, error) {
+	rows, err := q.db.Query(ctx, ClaimPendingOutbox, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimPendingOutboxRow
+	for rows.Next() {
+		var i ClaimPendingOutboxRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Subject,
+			&i.Payload,
+			&i.Headers,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const CountPendingOutbox = `-- name: CountPendingOutbox :one
+SELECT COUNT(*) FROM outbox
+WHERE delivered_at IS NULL
+`
+
+func (q *Queries) CountPendingOutbox(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, CountPendingOutbox)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const DeleteDeliveredOutbox = `-- name: DeleteDeliveredOutbox :execrows
+DELETE FROM outbox
+WHERE delivered_at < $1
+`
+
+func (q *Queries) DeleteDeliveredOutbox(ctx context.Context, deliveredAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, DeleteDeliveredOutbox, deliveredAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const EnqueueOutbox = `-- name: EnqueueOutbox :one
+INSERT INTO outbox (subject, payload, headers)
+VALUES ($1, $2, $3)
+RETURNING id
+`
+
+type EnqueueOutboxParams struct {
+	Subject string
+	Payload []byte
+	Headers []byte
+}
+
+func (q *Queries) EnqueueOutbox(ctx context.Context, arg EnqueueOutboxParams) (int64, error) {
+	row := q.db.QueryRow(ctx, EnqueueOutbox, arg.Subject, arg.Payload, arg.Headers)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const LockOutboxRelay = `-- name: LockOutboxRelay :exec
+SELECT pg_advisory_xact_lock(hashtext('outbox_relay'))
+`
+
+// LockOutboxRelay serializes claims of all relays until the end of the transaction
+func (q *Queries) LockOutboxRelay(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, LockOutboxRelay)
+	return err
+}
+
+const MarkOutboxDelivered = `-- name: MarkOutboxDelivered :exec
+UPDATE outbox
+SET delivered_at = NOW(), attempts = attempts + 1, last_error = NULL
+WHERE id = $1
+`
+
+func (q *Queries) MarkOutboxDelivered(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, MarkOutboxDelivered, id)
+	return err
+}
+
+const MarkOutboxFailed = `-- name: MarkOutboxFailed :exec
+UPDATE outbox
+SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+WHERE id = $1
+`
+
+type MarkOutboxFailedParams struct {
+	ID            int64
+	LastError     pgtype.Text
+	NextAttemptAt pgtype.Timestamptz
+}
+
+func (q *Queries) MarkOutboxFailed(ctx context.Context, arg MarkOutboxFailedParams) error {
+	_, err := q.db.Exec(ctx, MarkOutboxFailed, arg.ID, arg.LastError, arg.NextAttemptAt)
+	return err
+}
+
+const ReleaseOutbox = `-- name: ReleaseOutbox :exec
+UPDATE outbox
+SET next_attempt_at = NOW()
+WHERE id = ANY($1::bigint[]) AND delivered_at IS NULL
+`
+
+func (q *Queries) ReleaseOutbox(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, ReleaseOutbox, ids)
+	return err
+}
@@ -55,12 +55,67 @@ RETURNING *;
 -- name: DeletePrize :exec
 DELETE FROM prizes WHERE id = $1;
 
--- name: GetCategories :many
-SELECT DISTINCT category FROM prizes ORDER BY category;
-
 -- name: GetLaureatesByPrizeId :many
 SELECT l.* 
 FROM laureates l
 INNER JOIN prizes_to_laureates ptl ON l.id = ptl.laureate_id
 WHERE ptl.prize_id = $1
-ORDER BY l.id;
\ No newline at end of file
+ORDER BY l.id;
+
+-- name: GetPrizeForUpdate :one
+SELECT * FROM prizes WHERE id = $1 FOR UPDATE;
+
+-- name: GetLaureateIdsByPrize :many
+SELECT laureate_id FROM prizes_to_laureates
+WHERE prize_id = $1
+ORDER BY laureate_id;
+
+-- name: GetPrizesByIds :many
+SELECT * FROM prizes
+WHERE id = ANY(@ids::int[]);
+
+-- name: GetPrizesByLaureateIds :many
+SELECT ptl.laureate_id, sqlc.embed(prizes)
+FROM prizes_to_laureates ptl
+INNER JOIN prizes ON prizes.id = ptl.prize_id
+WHERE ptl.laureate_id = ANY(@laureate_ids::int[])
+ORDER BY ptl.laureate_id, prizes.year, prizes.id;
+
+-- name: ListPrizesFiltered :many
+SELECT * FROM prizes
+WHERE (sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category))
+  AND (sqlc.narg(year_from)::int IS NULL OR year >= sqlc.narg(year_from))
+  AND (sqlc.narg(year_to)::int IS NULL OR year <= sqlc.narg(year_to))
+ORDER BY year DESC, category, id
+LIMIT sqlc.arg(page_limit) OFFSET sqlc.arg(page_offset);
+
+-- name: CountPrizesFiltered :one
+SELECT COUNT(*) FROM prizes
+WHERE (sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category))
+  AND (sqlc.narg(year_from)::int IS NULL OR year >= sqlc.narg(year_from))
+  AND (sqlc.narg(year_to)::int IS NULL OR year <= sqlc.narg(year_to));
+
+-- ExportPrizes is read through a cursor by the export, see internal/export
+-- name: ExportPrizes :many
+SELECT id, year, category, updated_at FROM prizes
+WHERE (sqlc.narg(category)::text IS NULL OR category = sqlc.narg(category))
+  AND (sqlc.narg(year_from)::int IS NULL OR year >= sqlc.narg(year_from))
+  AND (sqlc.narg(year_to)::int IS NULL OR year <= sqlc.narg(year_to))
+ORDER BY year DESC, category, id;
+
+-- ExportAwards is read through a cursor by the export, see internal/export
+-- name: ExportAwards :many
+SELECT
+  p.id AS prize_id, p.year, p.category,
+  l.id AS laureate_id, l.firstname, l.surname, l.motivation, l.share
+FROM prizes p
+INNER JOIN prizes_to_laureates ptl ON ptl.prize_id = p.id
+INNER JOIN laureates l ON l.id = ptl.laureate_id
+WHERE (sqlc.narg(category)::text IS NULL OR p.category = sqlc.narg(category))
+  AND (sqlc.narg(year_from)::int IS NULL OR p.year >= sqlc.narg(year_from))
+  AND (sqlc.narg(year_to)::int IS NULL OR p.year <= sqlc.narg(year_to))
+  AND (sqlc.narg(name)::text IS NULL
+       OR l.firstname ILIKE '%' || sqlc.narg(name) || '%'
+       OR l.surname ILIKE '%' || sqlc.narg(name) || '%')
+  AND (sqlc.narg(share)::int IS NULL OR l.share = sqlc.narg(share))
+ORDER BY p.year DESC, p.category, p.id, l.id;
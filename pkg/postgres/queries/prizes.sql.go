@@ -45,6 +45,26 @@ func (q *Queries) CountPrizes(ctx context.Context) (int64, error) {
 	return count, err
 }
 
+const CountPrizesFiltered = `-- name: CountPrizesFiltered :one
+SELECT COUNT(*) FROM prizes
+WHERE ($1::text IS NULL OR category = $1)
+  AND ($2::int IS NULL OR year >= $2)
+  AND ($3::int IS NULL OR year <= $3)
+`
+
+type CountPrizesFilteredParams struct {
+	Category pgtype.Text
+	YearFrom pgtype.Int4
+	YearTo   pgtype.Int4
+}
+
+func (q *Queries) CountPrizesFiltered(ctx context.Context, arg CountPrizesFilteredParams) (int64, error) {
+	row := q.db.QueryRow(ctx, CountPrizesFiltered, arg.Category, arg.YearFrom, arg.YearTo)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const DeletePrize = `-- name: DeletePrize :exec
 DELETE FROM prizes WHERE id = $1
 `
@@ -54,23 +74,137 @@ func (q *Queries) DeletePrize(ctx context.Context, id int32) error {
 	return err
 }
 
-const GetCategories = `-- name: GetCategories :many
-SELECT DISTINCT category FROM prizes ORDER BY category
+const ExportAwards = `-- name: ExportAwards :many
+SELECT
+  p.id AS prize_id, p.year, p.category,
+  l.id AS laureate_id, l.firstname, l.surname, l.motivation, l.share
+FROM prizes p
+INNER JOIN prizes_to_laureates ptl ON ptl.prize_id = p.id
+INNER JOIN laureates l ON l.id = ptl.laureate_id
+WHERE ($1::text IS NULL OR p.category = $1)
+  AND ($2::int IS NULL OR p.year >= $2)
+  AND ($3::int IS NULL OR p.year <= $3)
+  AND ($4::text IS NULL
+       OR l.firstname ILIKE '%' || $4 || '%'
+       OR l.surname ILIKE '%' || $4 || '%')
+  AND ($5::int IS NULL OR l.share = $5)
+ORDER BY p.year DESC, p.category, p.id, l.id
 `
 
-func (q *Queries) GetCategories(ctx context.Context) ([]string, error) {
-	rows, err := q.db.Query(ctx, GetCategories)
+type ExportAwardsParams struct {
+	Category pgtype.Text
+	YearFrom pgtype.Int4
+	YearTo   pgtype.Int4
+	Name     pgtype.Text
+	Share    pgtype.Int4
+}
+
+type ExportAwardsRow struct {
+	PrizeID    int32
+	Year       int32
+	Category   string
+	LaureateID int32
+	Firstname  string
+	Surname    pgtype.Text
+	Motivation string
+	Share      int32
+}
+
+// ExportAwards is read through a cursor by the export, see internal/export
+func (q *Queries) ExportAwards(ctx context.Context, arg ExportAwardsParams) ([]ExportAwardsRow, error) {
+	rows, err := q.db.Query(ctx, ExportAwards,
+		arg.Category,
+		arg.YearFrom,
+		arg.YearTo,
+		arg.Name,
+		arg.Share,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []string
+	var items []ExportAwardsRow
 	for rows.Next() {
-		var category string
-		if err := rows.Scan(&category); err != nil {
+		var i ExportAwardsRow
+		if err := rows.Scan(
+			&i.PrizeID,
+			&i.Year,
+			&i.Category,
+			&i.LaureateID,
+			&i.Firstname,
+			&i.Surname,
+			&i.Motivation,
+			&i.Share,
+		); err != nil {
 			return nil, err
 		}
-		items = append(items, category)
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ExportPrizes = `-- name: ExportPrizes :many
+SELECT id, year, category, updated_at FROM prizes
+WHERE ($1::text IS NULL OR category = $1)
+  AND ($2::int IS NULL OR year >= $2)
+  AND ($3::int IS NULL OR year <= $3)
+ORDER BY year DESC, category, id
+`
+
+type ExportPrizesParams struct {
+	Category pgtype.Text
+	YearFrom pgtype.Int4
+	YearTo   pgtype.Int4
+}
+
+// ExportPrizes is read through a cursor by the export, see internal/export
+func (q *Queries) ExportPrizes(ctx context.Context, arg ExportPrizesParams) ([]Prize, error) {
+	rows, err := q.db.Query(ctx, ExportPrizes, arg.Category, arg.YearFrom, arg.YearTo)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Prize
+	for rows.Next() {
+		var i Prize
+		if err := rows.Scan(
+			&i.ID,
+			&i.Year,
+			&i.Category,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetLaureateIdsByPrize = `-- name: GetLaureateIdsByPrize :many
+SELECT laureate_id FROM prizes_to_laureates
+WHERE prize_id = $1
+ORDER BY laureate_id
+`
+
+func (q *Queries) GetLaureateIdsByPrize(ctx context.Context, prizeID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, GetLaureateIdsByPrize, prizeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var laureate_id int32
+		if err := rows.Scan(&laureate_id); err != nil {
+			return nil, err
+		}
+		items = append(items, laureate_id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -79,7 +213,7 @@ func (q *Queries) GetCategories(ctx context.Context) ([]string, error) {
 }
 
 const GetLaureatesByPrizeId = `-- name: GetLaureatesByPrizeId :many
-SELECT l.id, l.firstname, l.surname, l.motivation, l.share, l.updated_at 
+SELECT l.id, l.firstname, l.surname, l.motivation, l.share, l.updated_at, l.type, l.gender, l.birth_date, l.birth_city, l.birth_country, l.birth_country_code, l.death_date, l.death_city, l.death_country, l.death_country_code 
 FROM laureates l
 INNER JOIN prizes_to_laureates ptl ON l.id = ptl.laureate_id
 WHERE ptl.prize_id = $1
@@ -102,6 +236,16 @@ func (q *Queries) GetLaureatesByPrizeId(ctx context.Context, prizeID int32) ([]L
 			&i.Motivation,
 			&i.Share,
 			&i.UpdatedAt,
+			&i.Type,
+			&i.Gender,
+			&i.BirthDate,
+			&i.BirthCity,
+			&i.BirthCountry,
+			&i.BirthCountryCode,
+			&i.DeathDate,
+			&i.DeathCity,
+			&i.DeathCountry,
+			&i.DeathCountryCode,
 		); err != nil {
 			return nil, err
 		}
@@ -129,6 +273,22 @@ func (q *Queries) GetPrize(ctx context.Context, id int32) (Prize, error) {
 	return i, err
 }
 
+const GetPrizeForUpdate = `-- name: GetPrizeForUpdate :one
+SELECT id, year, category, updated_at FROM prizes WHERE id = $1 FOR UPDATE
+`
+
+func (q *Queries) GetPrizeForUpdate(ctx context.Context, id int32) (Prize, error) {
+	row := q.db.QueryRow(ctx, GetPrizeForUpdate, id)
+	var i Prize
+	err := row.Scan(
+		&i.ID,
+		&i.Year,
+		&i.Category,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const GetPrizeWithLaureates = `-- name: GetPrizeWithLaureates :many
 SELECT 
   p.id as prize_id, p.year, p.category,
@@ -231,6 +391,123 @@ func (q *Queries) GetPrizesByCategoryWithLaureates(ctx context.Context, category
 	return items, nil
 }
 
+const GetPrizesByIds = `-- name: GetPrizesByIds :many
+SELECT id, year, category, updated_at FROM prizes
+WHERE id = ANY($1::int[])
+`
+
+func (q *Queries) GetPrizesByIds(ctx context.Context, ids []int32) ([]Prize, error) {
+	rows, err := q.db.Query(ctx, GetPrizesByIds, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Prize
+	for rows.Next() {
+		var i Prize
+		if err := rows.Scan(
+			&i.ID,
+			&i.Year,
+			&i.Category,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetPrizesByLaureateIds = `-- name: GetPrizesByLaureateIds :many
+SELECT ptl.laureate_id, prizes.id, prizes.year, prizes.category, prizes.updated_at
+FROM prizes_to_laureates ptl
+INNER JOIN prizes ON prizes.id = ptl.prize_id
+WHERE ptl.laureate_id = ANY($1::int[])
+ORDER BY ptl.laureate_id, prizes.year, prizes.id
+`
+
+type GetPrizesByLaureateIdsRow struct {
+	LaureateID int32
+	Prize      Prize
+}
+
+func (q *Queries) GetPrizesByLaureateIds(ctx context.Context, laureateIds []int32) ([]GetPrizesByLaureateIdsRow, error) {
+	rows, err := q.db.Query(ctx, GetPrizesByLaureateIds, laureateIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPrizesByLaureateIdsRow
+	for rows.Next() {
+		var i GetPrizesByLaureateIdsRow
+		if err := rows.Scan(
+			&i.LaureateID,
+			&i.Prize.ID,
+			&i.Prize.Year,
+			&i.Prize.Category,
+			&i.Prize.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListPrizesFiltered = `-- name: ListPrizesFiltered :many
+SELECT id, year, category, updated_at FROM prizes
+WHERE ($1::text IS NULL OR category = $1)
+  AND ($2::int IS NULL OR year >= $2)
+  AND ($3::int IS NULL OR year <= $3)
+ORDER BY year DESC, category, id
+LIMIT $5 OFFSET $4
+`
+
+type ListPrizesFilteredParams struct {
+	Category   pgtype.Text
+	YearFrom   pgtype.Int4
+	YearTo     pgtype.Int4
+	PageOffset int32
+	PageLimit  int32
+}
+
+func (q *Queries) ListPrizesFiltered(ctx context.Context, arg ListPrizesFilteredParams) ([]Prize, error) {
+	rows, err := q.db.Query(ctx, ListPrizesFiltered,
+		arg.Category,
+		arg.YearFrom,
+		arg.YearTo,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Prize
+	for rows.Next() {
+		var i Prize
+		if err := rows.Scan(
+			&i.ID,
+			&i.Year,
+			&i.Category,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const PrizesByCategory = `-- name: PrizesByCategory :many
 SELECT id, year, category, updated_at FROM prizes WHERE category = $1 ORDER BY year DESC
 `
@@ -0,0 +1,117 @@
+-- name: GetProjectionCheckpoint :one
+SELECT stream_sequence FROM projection_checkpoints
+WHERE name = $1;
+
+-- name: SaveProjectionCheckpoint :exec
+INSERT INTO projection_checkpoints (name, stream_sequence, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (name) DO UPDATE SET stream_sequence = EXCLUDED.stream_sequence, updated_at = NOW();
+
+-- name: MarkProjectionEventApplied :execrows
+INSERT INTO projection_events (event_id, stream_sequence)
+VALUES ($1, $2)
+ON CONFLICT (event_id) DO NOTHING;
+
+-- name: TruncateProjection :exec
+TRUNCATE projection_prizes, projection_laureates, projection_prize_laureates,
+    category_decade_stats, laureate_profiles, projection_events, projection_checkpoints;
+
+-- name: UpsertProjectionPrize :exec
+INSERT INTO projection_prizes (id, year, category)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET year = EXCLUDED.year, category = EXCLUDED.category;
+
+-- name: GetProjectionPrize :one
+SELECT * FROM projection_prizes
+WHERE id = $1;
+
+-- name: DeleteProjectionPrize :exec
+DELETE FROM projection_prizes
+WHERE id = $1;
+
+-- name: UpsertProjectionLaureate :exec
+INSERT INTO projection_laureates (id, firstname, surname, motivation, share)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    firstname = EXCLUDED.firstname,
+    surname = EXCLUDED.surname,
+    motivation = EXCLUDED.motivation,
+    share = EXCLUDED.share;
+
+-- name: DeleteProjectionLaureate :exec
+DELETE FROM projection_laureates
+WHERE id = $1;
+
+-- name: LinkProjectionLaureate :exec
+INSERT INTO projection_prize_laureates (prize_id, laureate_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: UnlinkProjectionLaureate :exec
+DELETE FROM projection_prize_laureates
+WHERE prize_id = $1 AND laureate_id = $2;
+
+-- name: DeleteProjectionPrizeLinks :many
+DELETE FROM projection_prize_laureates
+WHERE prize_id = $1
+RETURNING laureate_id;
+
+-- name: DeleteProjectionLaureateLinks :many
+DELETE FROM projection_prize_laureates
+WHERE laureate_id = $1
+RETURNING prize_id;
+
+-- name: ListProjectionPrizeLaureateIds :many
+SELECT laureate_id FROM projection_prize_laureates
+WHERE prize_id = $1;
+
+-- name: RefreshCategoryDecadeStats :exec
+-- Recomputes the counts of the category and decade, the row is removed when there are no prizes
+WITH counts AS (
+    SELECT COUNT(DISTINCT p.id)::int AS prizes_count, COUNT(DISTINCT pl.laureate_id)::int AS laureates_count
+    FROM projection_prizes p
+    LEFT JOIN projection_prize_laureates pl ON pl.prize_id = p.id
+    WHERE p.category = sqlc.arg(category)::text AND p.year / 10 * 10 = sqlc.arg(decade)::int
+), deleted AS (
+    DELETE FROM category_decade_stats s
+    USING counts
+    WHERE counts.prizes_count = 0 AND s.category = sqlc.arg(category)::text AND s.decade = sqlc.arg(decade)::int
+)
+INSERT INTO category_decade_stats (category, decade, prizes_count, laureates_count, updated_at)
+SELECT sqlc.arg(category)::text, sqlc.arg(decade)::int, prizes_count, laureates_count, NOW()
+FROM counts
+WHERE prizes_count > 0
+ON CONFLICT (category, decade) DO UPDATE SET
+    prizes_count = EXCLUDED.prizes_count,
+    laureates_count = EXCLUDED.laureates_count,
+    updated_at = NOW();
+
+-- name: RefreshLaureateProfile :exec
+-- Recomputes the profile of the laureate, the profile is removed with the laureate
+WITH deleted AS (
+    DELETE FROM laureate_profiles
+    WHERE laureate_id = sqlc.arg(laureate_id)::int
+      AND NOT EXISTS (SELECT 1 FROM projection_laureates WHERE id = sqlc.arg(laureate_id)::int)
+)
+INSERT INTO laureate_profiles (laureate_id, firstname, surname, motivation, share, prizes_count, prizes, updated_at)
+SELECT l.id, l.firstname, l.surname, l.motivation, l.share,
+    COUNT(p.id)::int,
+    COALESCE(
+        jsonb_agg(jsonb_build_object('id', p.id, 'year', p.year, 'category', p.category) ORDER BY p.year, p.id)
+            FILTER (WHERE p.id IS NOT NULL),
+        '[]'::jsonb
+    ),
+    NOW()
+FROM projection_laureates l
+LEFT JOIN projection_prize_laureates pl ON pl.laureate_id = l.id
+LEFT JOIN projection_prizes p ON p.id = pl.prize_id
+WHERE l.id = sqlc.arg(laureate_id)::int
+GROUP BY l.id
+ON CONFLICT (laureate_id) DO UPDATE SET
+    firstname = EXCLUDED.firstname,
+    surname = EXCLUDED.surname,
+    motivation = EXCLUDED.motivation,
+    share = EXCLUDED.share,
+    prizes_count = EXCLUDED.prizes_count,
+    prizes = EXCLUDED.prizes,
+    updated_at = NOW();
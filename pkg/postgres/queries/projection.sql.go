@@ -0,0 +1,321 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: projection.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const DeleteProjectionLaureate = `-- name: DeleteProjectionLaureate :exec
+DELETE FROM projection_laureates
+WHERE id = $1
+`
+
+func (q *Queries) DeleteProjectionLaureate(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, DeleteProjectionLaureate, id)
+	return err
+}
+
+const DeleteProjectionLaureateLinks = `-- name: DeleteProjectionLaureateLinks :many
+DELETE FROM projection_prize_laureates
+WHERE laureate_id = $1
+RETURNING prize_id
+`
+
+func (q *Queries) DeleteProjectionLaureateLinks(ctx context.Context, laureateID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, DeleteProjectionLaureateLinks, laureateID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var prize_id int32
+		if err := rows.Scan(&prize_id); err != nil {
+			return nil, err
+		}
+		items = append(items, prize_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const DeleteProjectionPrize = `-- name: DeleteProjectionPrize :exec
+DELETE FROM projection_prizes
+WHERE id = $1
+`
+
+func (q *Queries) DeleteProjectionPrize(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, DeleteProjectionPrize, id)
+	return err
+}
+
+const DeleteProjectionPrizeLinks = `-- name: DeleteProjectionPrizeLinks :many
+DELETE FROM projection_prize_laureates
+WHERE prize_id = $1
+RETURNING laureate_id
+`
+
+func (q *Queries) DeleteProjectionPrizeLinks(ctx context.Context, prizeID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, DeleteProjectionPrizeLinks, prizeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var laureate_id int32
+		if err := rows.Scan(&laureate_id); err != nil {
+			return nil, err
+		}
+		items = append(items, laureate_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const GetProjectionCheckpoint = `-- name: GetProjectionCheckpoint :one
+SELECT stream_sequence FROM projection_checkpoints
+WHERE name = $1
+`
+
+func (q *Queries) GetProjectionCheckpoint(ctx context.Context, name string) (int64, error) {
+	row := q.db.QueryRow(ctx, GetProjectionCheckpoint, name)
+	var stream_sequence int64
+	err := row.Scan(&stream_sequence)
+	return stream_sequence, err
+}
+
+const GetProjectionPrize = `-- name: GetProjectionPrize :one
+SELECT id, year, category FROM projection_prizes
+WHERE id = $1
+`
+
+func (q *Queries) GetProjectionPrize(ctx context.Context, id int32) (ProjectionPrize, error) {
+	row := q.db.QueryRow(ctx, GetProjectionPrize, id)
+	var i ProjectionPrize
+	err := row.Scan(&i.ID, &i.Year, &i.Category)
+	return i, err
+}
+
+const LinkProjectionLaureate = `-- name: LinkProjectionLaureate :exec
+INSERT INTO projection_prize_laureates (prize_id, laureate_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type LinkProjectionLaureateParams struct {
+	PrizeID    int32
+	LaureateID int32
+}
+
+func (q *Queries) LinkProjectionLaureate(ctx context.Context, arg LinkProjectionLaureateParams) error {
+	_, err := q.db.Exec(ctx, LinkProjectionLaureate, arg.PrizeID, arg.LaureateID)
+	return err
+}
+
+const ListProjectionPrizeLaureateIds = `-- name: ListProjectionPrizeLaureateIds :many
+SELECT laureate_id FROM projection_prize_laureates
+WHERE prize_id = $1
+`
+
+func (q *Queries) ListProjectionPrizeLaureateIds(ctx context.Context, prizeID int32) ([]int32, error) {
+	rows, err := q.db.Query(ctx, ListProjectionPrizeLaureateIds, prizeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int32
+	for rows.Next() {
+		var laureate_id int32
+		if err := rows.Scan(&laureate_id); err != nil {
+			return nil, err
+		}
+		items = append(items, laureate_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const MarkProjectionEventApplied = `-- name: MarkProjectionEventApplied :execrows
+INSERT INTO projection_events (event_id, stream_sequence)
+VALUES ($1, $2)
+ON CONFLICT (event_id) DO NOTHING
+`
+
+type MarkProjectionEventAppliedParams struct {
+	EventID        string
+	StreamSequence int64
+}
+
+func (q *Queries) MarkProjectionEventApplied(ctx context.Context, arg MarkProjectionEventAppliedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, MarkProjectionEventApplied, arg.EventID, arg.StreamSequence)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const RefreshCategoryDecadeStats = `-- name: RefreshCategoryDecadeStats :exec
+WITH counts AS (
+    SELECT COUNT(DISTINCT p.id)::int AS prizes_count, COUNT(DISTINCT pl.laureate_id)::int AS laureates_count
+    FROM projection_prizes p
+    LEFT JOIN projection_prize_laureates pl ON pl.prize_id = p.id
+    WHERE p.category = $1::text AND p.year / 10 * 10 = $2::int
+), deleted AS (
+    DELETE FROM category_decade_stats s
+    USING counts
+    WHERE counts.prizes_count = 0 AND s.category = $1::text AND s.decade = $2::int
+)
+INSERT INTO category_decade_stats (category, decade, prizes_count, laureates_count, updated_at)
+SELECT $1::text, $2::int, prizes_count, laureates_count, NOW()
+FROM counts
+WHERE prizes_count > 0
+ON CONFLICT (category, decade) DO UPDATE SET
+    prizes_count = EXCLUDED.prizes_count,
+    laureates_count = EXCLUDED.laureates_count,
+    updated_at = NOW()
+`
+
+type RefreshCategoryDecadeStatsParams struct {
+	Category string
+	Decade   int32
+}
+
+// Recomputes the counts of the category and decade, the row is removed when there are no prizes
+func (q *Queries) RefreshCategoryDecadeStats(ctx context.Context, arg RefreshCategoryDecadeStatsParams) error {
+	_, err := q.db.Exec(ctx, RefreshCategoryDecadeStats, arg.Category, arg.Decade)
+	return err
+}
+
+const RefreshLaureateProfile = `-- name: RefreshLaureateProfile :exec
+WITH deleted AS (
+    DELETE FROM laureate_profiles
+    WHERE laureate_id = $1::int
+      AND NOT EXISTS (SELECT 1 FROM projection_laureates WHERE id = $1::int)
+)
+INSERT INTO laureate_profiles (laureate_id, firstname, surname, motivation, share, prizes_count, prizes, updated_at)
+SELECT l.id, l.firstname, l.surname, l.motivation, l.share,
+    COUNT(p.id)::int,
+    COALESCE(
+        jsonb_agg(jsonb_build_object('id', p.id, 'year', p.year, 'category', p.category) ORDER BY p.year, p.id)
+            FILTER (WHERE p.id IS NOT NULL),
+        '[]'::jsonb
+    ),
+    NOW()
+FROM projection_laureates l
+LEFT JOIN projection_prize_laureates pl ON pl.laureate_id = l.id
+LEFT JOIN projection_prizes p ON p.id = pl.prize_id
+WHERE l.id = $1::int
+GROUP BY l.id
+ON CONFLICT (laureate_id) DO UPDATE SET
+    firstname = EXCLUDED.firstname,
+    surname = EXCLUDED.surname,
+    motivation = EXCLUDED.motivation,
+    share = EXCLUDED.share,
+    prizes_count = EXCLUDED.prizes_count,
+    prizes = EXCLUDED.prizes,
+    updated_at = NOW()
+`
+
+// Recomputes the profile of the laureate, the profile is removed with the laureate
+func (q *Queries) RefreshLaureateProfile(ctx context.Context, laureateID int32) error {
+	_, err := q.db.Exec(ctx, RefreshLaureateProfile, laureateID)
+	return err
+}
+
+const SaveProjectionCheckpoint = `-- name: SaveProjectionCheckpoint :exec
+INSERT INTO projection_checkpoints (name, stream_sequence, updated_at)
+VALUES ($1, $2, NOW())
+ON CONFLICT (name) DO UPDATE SET stream_sequence = EXCLUDED.stream_sequence, updated_at = NOW()
+`
+
+type SaveProjectionCheckpointParams struct {
+	Name           string
+	StreamSequence int64
+}
+
+func (q *Queries) SaveProjectionCheckpoint(ctx context.Context, arg SaveProjectionCheckpointParams) error {
+	_, err := q.db.Exec(ctx, SaveProjectionCheckpoint, arg.Name, arg.StreamSequence)
+	return err
+}
+
+const TruncateProjection = `-- name: TruncateProjection :exec
+TRUNCATE projection_prizes, projection_laureates, projection_prize_laureates,
+    category_decade_stats, laureate_profiles, projection_events, projection_checkpoints
+`
+
+func (q *Queries) TruncateProjection(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, TruncateProjection)
+	return err
+}
+
+const UnlinkProjectionLaureate = `-- name: UnlinkProjectionLaureate :exec
+DELETE FROM projection_prize_laureates
+WHERE prize_id = $1 AND laureate_id = $2
+`
+
+type UnlinkProjectionLaureateParams struct {
+	PrizeID    int32
+	LaureateID int32
+}
+
+func (q *Queries) UnlinkProjectionLaureate(ctx context.Context, arg UnlinkProjectionLaureateParams) error {
+	_, err := q.db.Exec(ctx, UnlinkProjectionLaureate, arg.PrizeID, arg.LaureateID)
+	return err
+}
+
+const UpsertProjectionLaureate = `-- name: UpsertProjectionLaureate :exec
+INSERT INTO projection_laureates (id, firstname, surname, motivation, share)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (id) DO UPDATE SET
+    firstname = EXCLUDED.firstname,
+    surname = EXCLUDED.surname,
+    motivation = EXCLUDED.motivation,
+    share = EXCLUDED.share
+`
+
+type UpsertProjectionLaureateParams struct {
+	ID         int32
+	Firstname  string
+	Surname    string
+	Motivation string
+	Share      int32
+}
+
+func (q *Queries) UpsertProjectionLaureate(ctx context.Context, arg UpsertProjectionLaureateParams) error {
+	_, err := q.db.Exec(ctx, UpsertProjectionLaureate,
+		arg.ID,
+		arg.Firstname,
+		arg.Surname,
+		arg.Motivation,
+		arg.Share,
+	)
+	return err
+}
+
+const UpsertProjectionPrize = `-- name: UpsertProjectionPrize :exec
+INSERT INTO projection_prizes (id, year, category)
+VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET year = EXCLUDED.year, category = EXCLUDED.category
+`
+
+type UpsertProjectionPrizeParams struct {
+	ID       int32
+	Year     int32
+	Category string
+}
+
+func (q *Queries) UpsertProjectionPrize(ctx context.Context, arg UpsertProjectionPrizeParams) error {
+	_, err := q.db.Exec(ctx, UpsertProjectionPrize, arg.ID, arg.Year, arg.Category)
+	return err
+}
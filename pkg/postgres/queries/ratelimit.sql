@@ -0,0 +1,22 @@
+-- TakeRateLimitToken refills the bucket for the time elapsed since its last take and takes a token.
+-- A new bucket starts full. Without a token the bucket is left as is: the refill is computed
+-- from updated_at anyway, and updated_at older than now() tells the caller that the take failed.
+-- name: TakeRateLimitToken :one
+INSERT INTO rate_limit_buckets AS b (key, tokens, updated_at)
+VALUES (sqlc.arg(key), sqlc.arg(burst)::float8 - 1, now())
+ON CONFLICT (key) DO UPDATE SET
+    tokens = CASE
+        WHEN LEAST(sqlc.arg(burst)::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * sqlc.arg(rate)::float8) >= 1
+        THEN LEAST(sqlc.arg(burst)::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * sqlc.arg(rate)::float8) - 1
+        ELSE b.tokens
+    END,
+    updated_at = CASE
+        WHEN LEAST(sqlc.arg(burst)::float8, b.tokens + EXTRACT(EPOCH FROM now() - b.updated_at)::float8 * sqlc.arg(rate)::float8) >= 1
+        THEN now()
+        ELSE b.updated_at
+    END
+RETURNING tokens, updated_at, now()::timestamptz AS now;
+
+-- name: DeleteStaleRateLimitBuckets :exec
+DELETE FROM rate_limit_buckets
+WHERE updated_at < $1;
@@ -0,0 +1,2 @@
+-- name: GetSchemaVersion :one
+SELECT version FROM schema_version;
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: schema.sql
+
+package queries
+
+import (
+	"context"
+)
+
+const GetSchemaVersion = `-- name: GetSchemaVersion :one
+SELECT version FROM schema_version
+`
+
+func (q *Queries) GetSchemaVersion(ctx context.Context) (int32, error) {
+	row := q.db.QueryRow(ctx, GetSchemaVersion)
+	var version int32
+	err := row.Scan(&version)
+	return version, err
+}
@@ -3,7 +3,8 @@ SELECT
   COALESCE(
     GREATEST(
       (SELECT MAX(updated_at) FROM laureates),
-      (SELECT MAX(updated_at) FROM prizes)
+      (SELECT MAX(updated_at) FROM prizes),
+      (SELECT MAX(updated_at) FROM categories)
     ),
     NOW()
   ) as last_update;
@@ -12,4 +13,4 @@ SELECT
 SELECT 
   (SELECT COUNT(*) FROM laureates) as laureates_count,
   (SELECT COUNT(*) FROM prizes) as prizes_count,
-  (SELECT COUNT(DISTINCT category) FROM prizes) as categories_count;
+  (SELECT COUNT(*) FROM categories) as categories_count;
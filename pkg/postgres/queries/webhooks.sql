@@ -0,0 +1,110 @@
+-- name: CreateWebhook :one
+INSERT INTO webhooks (url, event_types, secret, description)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetWebhook :one
+SELECT * FROM webhooks
+WHERE id = $1;
+
+-- name: ListWebhooks :many
+SELECT * FROM webhooks
+ORDER BY id;
+
+-- name: UpdateWebhook :one
+-- Activating a webhook clears its failures, an empty secret keeps the current one
+UPDATE webhooks
+SET url = sqlc.arg(url),
+    event_types = sqlc.arg(event_types),
+    description = sqlc.arg(description),
+    secret = COALESCE(NULLIF(sqlc.arg(secret)::text, ''), secret),
+    consecutive_failures = CASE WHEN sqlc.arg(active)::boolean AND NOT active THEN 0 ELSE consecutive_failures END,
+    disabled_at = CASE WHEN sqlc.arg(active)::boolean THEN NULL ELSE COALESCE(disabled_at, NOW()) END,
+    disabled_reason = CASE WHEN sqlc.arg(active)::boolean THEN NULL ELSE COALESCE(disabled_reason, 'disabled by user') END,
+    active = sqlc.arg(active)::boolean,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = $1;
+
+-- name: EnqueueWebhookDeliveries :execrows
+-- Creates a delivery of the event for every active webhook subscribed to its type.
+-- Enqueueing the same event again does nothing.
+INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+SELECT w.id, sqlc.arg(event_id), sqlc.arg(event_type)::text, sqlc.arg(payload)
+FROM webhooks w
+WHERE w.active
+  AND (sqlc.arg(event_type)::text = ANY(w.event_types)
+    OR split_part(sqlc.arg(event_type)::text, '.', 1) || '.*' = ANY(w.event_types)
+    OR '*' = ANY(w.event_types))
+ON CONFLICT (webhook_id, event_id) DO NOTHING;
+
+-- name: ClaimDueWebhookDeliveries :many
+-- Leases due deliveries by moving their next attempt to lease_until, so they are not claimed again
+-- while being sent; a delivery whose result was not recorded is retried after the lease
+WITH due AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND w.active
+    ORDER BY d.id
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = sqlc.arg(lease_until)
+FROM due, webhooks w
+WHERE d.id = due.id AND w.id = d.webhook_id
+RETURNING d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.attempts, w.url, w.secret;
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered', attempts = attempts + 1, response_status = $2, duration_ms = $3,
+    last_error = NULL, completed_at = NOW()
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+-- status is pending while attempts remain, failed otherwise
+UPDATE webhook_deliveries
+SET status = sqlc.arg(status), attempts = attempts + 1, response_status = sqlc.arg(response_status),
+    duration_ms = sqlc.arg(duration_ms), last_error = sqlc.arg(last_error), next_attempt_at = sqlc.arg(next_attempt_at),
+    completed_at = CASE WHEN sqlc.arg(status) = 'failed' THEN NOW() END
+WHERE id = sqlc.arg(id);
+
+-- name: ResetWebhookFailures :exec
+UPDATE webhooks
+SET consecutive_failures = 0
+WHERE id = $1 AND consecutive_failures > 0;
+
+-- name: RecordWebhookFailure :one
+-- Counts a failed attempt and disables the webhook after disable_after consecutive failures
+UPDATE webhooks
+SET consecutive_failures = consecutive_failures + 1,
+    active = active AND consecutive_failures + 1 < sqlc.arg(disable_after)::int,
+    disabled_at = CASE WHEN active AND consecutive_failures + 1 >= sqlc.arg(disable_after)::int THEN NOW() ELSE disabled_at END,
+    disabled_reason = CASE WHEN active AND consecutive_failures + 1 >= sqlc.arg(disable_after)::int
+        THEN sqlc.arg(reason)::text ELSE disabled_reason END,
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING active, consecutive_failures;
+
+-- name: ListWebhookDeliveries :many
+SELECT id, webhook_id, event_id, event_type, status, attempts, next_attempt_at, response_status,
+    last_error, duration_ms, created_at, completed_at
+FROM webhook_deliveries
+WHERE webhook_id = sqlc.arg(webhook_id)
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status)::text)
+ORDER BY id DESC
+LIMIT sqlc.arg(row_limit);
+
+-- name: RedeliverWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', next_attempt_at = NOW(), completed_at = NULL
+WHERE id = $1 AND webhook_id = $2;
+
+-- name: DeleteCompletedWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending' AND completed_at < $1;
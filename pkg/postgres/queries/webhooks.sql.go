@@ -0,0 +1,451 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhooks.sql
+
+package queries
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const ClaimDueWebhookDeliveries = `-- name: ClaimDueWebhookDeliveries :many
+WITH due AS (
+    SELECT d.id
+    FROM webhook_deliveries d
+    JOIN webhooks w ON w.id = d.webhook_id
+    WHERE d.status = 'pending' AND d.next_attempt_at <= NOW() AND w.active
+    ORDER BY d.id
+    LIMIT $2
+    FOR UPDATE OF d SKIP LOCKED
+)
+UPDATE webhook_deliveries d
+SET next_attempt_at = $1
+FROM due, webhooks w
+WHERE d.id = due.id AND w.id = d.webhook_id
+RETURNING d.id, d.webhook_id, d.event_id, d.event_type, d.payload, d.attempts, w.url, w.secret
+`
+
+type ClaimDueWebhookDeliveriesParams struct {
+	LeaseUntil pgtype.Timestamptz
+	BatchSize  int32
+}
+
+type ClaimDueWebhookDeliveriesRow struct {
+	ID        int64
+	WebhookID int64
+	EventID   string
+	EventType string
+	Payload   []byte
+	Attempts  int32
+	Url       string
+	Secret    string
+}
+
+// Leases due deliveries by moving their next attempt to lease_until, so they are not claimed again
+// while being sent; a delivery whose result was not recorded is retried after the lease
+func (q *Queries) ClaimDueWebhookDeliveries(ctx context.Context, arg ClaimDueWebhookDeliveriesParams) ([]ClaimDueWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, ClaimDueWebhookDeliveries, arg.LeaseUntil, arg.BatchSize)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClaimDueWebhookDeliveriesRow
+	for rows.Next() {
+		var i ClaimDueWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.Attempts,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const CreateWebhook = `-- name: CreateWebhook :one
+INSERT INTO webhooks (url, event_types, secret, description)
+VALUES ($1, $2, $3, $4)
+RETURNING id, url, event_types, secret, description, active, consecutive_failures, disabled_at, disabled_reason, created_at, updated_at
+`
+
+type CreateWebhookParams struct {
+	Url         string
+	EventTypes  []string
+	Secret      string
+	Description string
+}
+
+func (q *Queries) CreateWebhook(ctx context.Context, arg CreateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, CreateWebhook,
+		arg.Url,
+		arg.EventTypes,
+		arg.Secret,
+		arg.Description,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Description,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const DeleteCompletedWebhookDeliveries = `-- name: DeleteCompletedWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries
+WHERE status <> 'pending' AND completed_at < $1
+`
+
+func (q *Queries) DeleteCompletedWebhookDeliveries(ctx context.Context, completedAt pgtype.Timestamptz) (int64, error) {
+	result, err := q.db.Exec(ctx, DeleteCompletedWebhookDeliveries, completedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const DeleteWebhook = `-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE id = $1
+`
+
+func (q *Queries) DeleteWebhook(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, DeleteWebhook, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const EnqueueWebhookDeliveries = `-- name: EnqueueWebhookDeliveries :execrows
+INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload)
+SELECT w.id, $1, $2::text, $3
+FROM webhooks w
+WHERE w.active
+  AND ($2::text = ANY(w.event_types)
+    OR split_part($2::text, '.', 1) || '.*' = ANY(w.event_types)
+    OR '*' = ANY(w.event_types))
+ON CONFLICT (webhook_id, event_id) DO NOTHING
+`
+
+type EnqueueWebhookDeliveriesParams struct {
+	EventID   string
+	EventType string
+	Payload   []byte
+}
+
+// Creates a delivery of the event for every active webhook subscribed to its type.
+// Enqueueing the same event again does nothing.
+func (q *Queries) EnqueueWebhookDeliveries(ctx context.Context, arg EnqueueWebhookDeliveriesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, EnqueueWebhookDeliveries, arg.EventID, arg.EventType, arg.Payload)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const GetWebhook = `-- name: GetWebhook :one
+SELECT id, url, event_types, secret, description, active, consecutive_failures, disabled_at, disabled_reason, created_at, updated_at FROM webhooks
+WHERE id = $1
+`
+
+func (q *Queries) GetWebhook(ctx context.Context, id int64) (Webhook, error) {
+	row := q.db.QueryRow(ctx, GetWebhook, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Description,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const ListWebhookDeliveries = `-- name: ListWebhookDeliveries :many
+SELECT id, webhook_id, event_id, event_type, status, attempts, next_attempt_at, response_status,
+    last_error, duration_ms, created_at, completed_at
+FROM webhook_deliveries
+WHERE webhook_id = $1
+  AND ($2::text IS NULL OR status = $2::text)
+ORDER BY id DESC
+LIMIT $3
+`
+
+type ListWebhookDeliveriesParams struct {
+	WebhookID int64
+	Status    pgtype.Text
+	RowLimit  int32
+}
+
+type ListWebhookDeliveriesRow struct {
+	ID             int64
+	WebhookID      int64
+	EventID        string
+	EventType      string
+	Status         string
+	Attempts       int32
+	NextAttemptAt  pgtype.Timestamptz
+	ResponseStatus pgtype.Int4
+	LastError      pgtype.Text
+	DurationMs     pgtype.Int4
+	CreatedAt      pgtype.Timestamptz
+	CompletedAt    pgtype.Timestamptz
+}
+
+func (q *Queries) ListWebhookDeliveries(ctx context.Context, arg ListWebhookDeliveriesParams) ([]ListWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, ListWebhookDeliveries, arg.WebhookID, arg.Status, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListWebhookDeliveriesRow
+	for rows.Next() {
+		var i ListWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.Status,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.ResponseStatus,
+			&i.LastError,
+			&i.DurationMs,
+			&i.CreatedAt,
+			&i.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const ListWebhooks = `-- name: ListWebhooks :many
+SELECT id, url, event_types, secret, description, active, consecutive_failures, disabled_at, disabled_reason, created_at, updated_at FROM webhooks
+ORDER BY id
+`
+
+func (q *Queries) ListWebhooks(ctx context.Context) ([]Webhook, error) {
+	rows, err := q.db.Query(ctx, ListWebhooks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.Url,
+			&i.EventTypes,
+			&i.Secret,
+			&i.Description,
+			&i.Active,
+			&i.ConsecutiveFailures,
+			&i.DisabledAt,
+			&i.DisabledReason,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const MarkWebhookDeliveryDelivered = `-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered', attempts = attempts + 1, response_status = $2, duration_ms = $3,
+    last_error = NULL, completed_at = NOW()
+WHERE id = $1
+`
+
+type MarkWebhookDeliveryDeliveredParams struct {
+	ID             int64
+	ResponseStatus pgtype.Int4
+	DurationMs     pgtype.Int4
+}
+
+func (q *Queries) MarkWebhookDeliveryDelivered(ctx context.Context, arg MarkWebhookDeliveryDeliveredParams) error {
+	_, err := q.db.Exec(ctx, MarkWebhookDeliveryDelivered, arg.ID, arg.ResponseStatus, arg.DurationMs)
+	return err
+}
+
+const MarkWebhookDeliveryFailed = `-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = $1, attempts = attempts + 1, response_status = $2,
+    duration_ms = $3, last_error = $4, next_attempt_at = $5,
+    completed_at = CASE WHEN $1 = 'failed' THEN NOW() END
+WHERE id = $6
+`
+
+type MarkWebhookDeliveryFailedParams struct {
+	Status         string
+	ResponseStatus pgtype.Int4
+	DurationMs     pgtype.Int4
+	LastError      pgtype.Text
+	NextAttemptAt  pgtype.Timestamptz
+	ID             int64
+}
+
+// status is pending while attempts remain, failed otherwise
+func (q *Queries) MarkWebhookDeliveryFailed(ctx context.Context, arg MarkWebhookDeliveryFailedParams) error {
+	_, err := q.db.Exec(ctx, MarkWebhookDeliveryFailed,
+		arg.Status,
+		arg.ResponseStatus,
+		arg.DurationMs,
+		arg.LastError,
+		arg.NextAttemptAt,
+		arg.ID,
+	)
+	return err
+}
+
+const RecordWebhookFailure = `-- name: RecordWebhookFailure :one
+UPDATE webhooks
+SET consecutive_failures = consecutive_failures + 1,
+    active = active AND consecutive_failures + 1 < $1::int,
+    disabled_at = CASE WHEN active AND consecutive_failures + 1 >= $1::int THEN NOW() ELSE disabled_at END,
+    disabled_reason = CASE WHEN active AND consecutive_failures + 1 >= $1::int
+        THEN $2::text ELSE disabled_reason END,
+    updated_at = NOW()
+WHERE id = $3
+RETURNING active, consecutive_failures
+`
+
+type RecordWebhookFailureParams struct {
+	DisableAfter int32
+	Reason       string
+	ID           int64
+}
+
+type RecordWebhookFailureRow struct {
+	Active              bool
+	ConsecutiveFailures int32
+}
+
+// Counts a failed attempt and disables the webhook after disable_after consecutive failures
+func (q *Queries) RecordWebhookFailure(ctx context.Context, arg RecordWebhookFailureParams) (RecordWebhookFailureRow, error) {
+	row := q.db.QueryRow(ctx, RecordWebhookFailure, arg.DisableAfter, arg.Reason, arg.ID)
+	var i RecordWebhookFailureRow
+	err := row.Scan(&i.Active, &i.ConsecutiveFailures)
+	return i, err
+}
+
+const RedeliverWebhookDelivery = `-- name: RedeliverWebhookDelivery :execrows
+UPDATE webhook_deliveries
+SET status = 'pending', next_attempt_at = NOW(), completed_at = NULL
+WHERE id = $1 AND webhook_id = $2
+`
+
+type RedeliverWebhookDeliveryParams struct {
+	ID        int64
+	WebhookID int64
+}
+
+func (q *Queries) RedeliverWebhookDelivery(ctx context.Context, arg RedeliverWebhookDeliveryParams) (int64, error) {
+	result, err := q.db.Exec(ctx, RedeliverWebhookDelivery, arg.ID, arg.WebhookID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const ResetWebhookFailures = `-- name: ResetWebhookFailures :exec
+UPDATE webhooks
+SET consecutive_failures = 0
+WHERE id = $1 AND consecutive_failures > 0
+`
+
+func (q *Queries) ResetWebhookFailures(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, ResetWebhookFailures, id)
+	return err
+}
+
+const UpdateWebhook = `-- name: UpdateWebhook :one
+UPDATE webhooks
+SET url = $1,
+    event_types = $2,
+    description = $3,
+    secret = COALESCE(NULLIF($4::text, ''), secret),
+    consecutive_failures = CASE WHEN $5::boolean AND NOT active THEN 0 ELSE consecutive_failures END,
+    disabled_at = CASE WHEN $5::boolean THEN NULL ELSE COALESCE(disabled_at, NOW()) END,
+    disabled_reason = CASE WHEN $5::boolean THEN NULL ELSE COALESCE(disabled_reason, 'disabled by user') END,
+    active = $5::boolean,
+    updated_at = NOW()
+WHERE id = $6
+RETURNING id, url, event_types, secret, description, active, consecutive_failures, disabled_at, disabled_reason, created_at, updated_at
+`
+
+type UpdateWebhookParams struct {
+	Url         string
+	EventTypes  []string
+	Description string
+	Secret      string
+	Active      bool
+	ID          int64
+}
+
+// Activating a webhook clears its failures, an empty secret keeps the current one
+func (q *Queries) UpdateWebhook(ctx context.Context, arg UpdateWebhookParams) (Webhook, error) {
+	row := q.db.QueryRow(ctx, UpdateWebhook,
+		arg.Url,
+		arg.EventTypes,
+		arg.Description,
+		arg.Secret,
+		arg.Active,
+		arg.ID,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.Url,
+		&i.EventTypes,
+		&i.Secret,
+		&i.Description,
+		&i.Active,
+		&i.ConsecutiveFailures,
+		&i.DisabledAt,
+		&i.DisabledReason,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
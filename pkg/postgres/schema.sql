@@ -14,8 +14,289 @@ CREATE TABLE IF NOT EXISTS prizes (
     updated_at TIMESTAMP DEFAULT NOW()
 );
 
+-- Prize categories, prizes reference them by slug. The slug of a category can not be changed.
+-- localized_names maps language codes to names, e.g. {"ru": "Физика"}.
+CREATE TABLE IF NOT EXISTS categories (
+    slug VARCHAR(100) PRIMARY KEY CHECK (slug ~ '^[a-z0-9]+(-[a-z0-9]+)*$'),
+    name VARCHAR(100) NOT NULL,
+    localized_names JSONB NOT NULL DEFAULT '{}',
+    established_year INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO categories (slug, name, localized_names, established_year) VALUES
+    ('chemistry', 'Chemistry', '{"ru": "Химия", "sv": "Kemi"}', 1901),
+    ('economics', 'Economic Sciences', '{"ru": "Экономика", "sv": "Ekonomiska vetenskaper"}', 1969),
+    ('literature', 'Literature', '{"ru": "Литература", "sv": "Litteratur"}', 1901),
+    ('medicine', 'Physiology or Medicine', '{"ru": "Физиология и медицина", "sv": "Fysiologi eller medicin"}', 1901),
+    ('peace', 'Peace', '{"ru": "Премия мира", "sv": "Fred"}', 1901),
+    ('physics', 'Physics', '{"ru": "Физика", "sv": "Fysik"}', 1901)
+ON CONFLICT (slug) DO NOTHING;
+
+-- Categories used to be free text: they are turned into slugs ("Physics " becomes "physics"),
+-- the ones still unknown are added with the year of their first prize
+UPDATE prizes
+SET category = TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(category), '[^a-z0-9]+', '-', 'g'))
+WHERE category <> TRIM(BOTH '-' FROM REGEXP_REPLACE(LOWER(category), '[^a-z0-9]+', '-', 'g'));
+
+INSERT INTO categories (slug, name, established_year)
+SELECT category, INITCAP(REPLACE(category, '-', ' ')), MIN(year)
+FROM prizes
+GROUP BY category
+ON CONFLICT (slug) DO NOTHING;
+
+-- Added once, re-adding it on every run would check all prizes again
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint
+                   WHERE conname = 'prizes_category_fkey' AND conrelid = 'prizes'::regclass) THEN
+        ALTER TABLE prizes ADD CONSTRAINT prizes_category_fkey FOREIGN KEY (category) REFERENCES categories (slug);
+    END IF;
+END $$;
+
 CREATE TABLE IF NOT EXISTS prizes_to_laureates (
     prize_id INT REFERENCES prizes(id) ON DELETE CASCADE,
     laureate_id INT REFERENCES laureates(id) ON DELETE CASCADE,
     PRIMARY KEY (prize_id, laureate_id)
 );
+
+-- The primary key covers lookups by prize, prizes of laureates are loaded in batches by laureate
+CREATE INDEX IF NOT EXISTS prizes_to_laureates_laureate_idx ON prizes_to_laureates (laureate_id);
+CREATE INDEX IF NOT EXISTS prizes_category_year_idx ON prizes (category, year);
+
+-- Laureates are persons or organizations, organizations have no gender.
+-- Dates are NULL when unknown or incomplete in the dataset (e.g. 1863-00-00),
+-- the birth of an organization is its foundation.
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS type VARCHAR(16) NOT NULL DEFAULT 'person'
+    CHECK (type IN ('person', 'organization'));
+
+-- Organizations used to be stored as persons without a surname, they are marked once
+-- when a database of an earlier version (or without a version) is upgraded
+DO $$
+BEGIN
+    IF to_regclass('schema_version') IS NOT NULL THEN
+        IF (SELECT version FROM schema_version) >= 8 THEN
+            RETURN;
+        END IF;
+    END IF;
+    UPDATE laureates SET type = 'organization' WHERE type = 'person' AND COALESCE(surname, '') = '';
+END $$;
+
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS gender VARCHAR(16)
+    CHECK (gender IN ('male', 'female', 'other'));
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS birth_date DATE;
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS birth_city VARCHAR(255);
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS birth_country VARCHAR(255);
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS birth_country_code CHAR(2);
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS death_date DATE;
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS death_city VARCHAR(255);
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS death_country VARCHAR(255);
+ALTER TABLE laureates ADD COLUMN IF NOT EXISTS death_country_code CHAR(2);
+
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_constraint
+                   WHERE conname = 'laureates_organization_gender_check' AND conrelid = 'laureates'::regclass) THEN
+        ALTER TABLE laureates ADD CONSTRAINT laureates_organization_gender_check CHECK (type = 'person' OR gender IS NULL);
+    END IF;
+END $$;
+
+CREATE INDEX IF NOT EXISTS laureates_birth_country_code_idx ON laureates (birth_country_code);
+
+-- Universities and institutes where laureates did the awarded work
+CREATE TABLE IF NOT EXISTS affiliations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    city VARCHAR(255) NOT NULL DEFAULT '',
+    country VARCHAR(255) NOT NULL DEFAULT '',
+    UNIQUE (name, city, country)
+);
+
+-- Affiliations of a laureate for a prize, a laureate may have several affiliations per prize
+CREATE TABLE IF NOT EXISTS award_affiliations (
+    prize_id INT NOT NULL,
+    laureate_id INT NOT NULL,
+    affiliation_id INT NOT NULL REFERENCES affiliations(id) ON DELETE CASCADE,
+    PRIMARY KEY (prize_id, laureate_id, affiliation_id),
+    FOREIGN KEY (prize_id, laureate_id) REFERENCES prizes_to_laureates (prize_id, laureate_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS award_affiliations_laureate_idx ON award_affiliations (laureate_id);
+
+CREATE TABLE IF NOT EXISTS rate_limit_buckets (
+    key VARCHAR(255) PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Domain events written in the transaction of the data change,
+-- published to NATS by the outbox relay
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    subject VARCHAR(255) NOT NULL,
+    payload BYTEA NOT NULL,
+    headers JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS outbox_pending_idx ON outbox (next_attempt_at) WHERE delivered_at IS NULL;
+
+-- Read model built by the projector (cmd/lab4/projector) from the EVENTS stream.
+-- projection_* tables are its copies of prizes, laureates and links,
+-- category_decade_stats and laureate_profiles are denormalized for reads.
+-- The projector owns these tables, they are truncated on rebuild.
+CREATE TABLE IF NOT EXISTS projection_prizes (
+    id INT PRIMARY KEY,
+    year INT NOT NULL,
+    category VARCHAR(100) NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS projection_laureates (
+    id INT PRIMARY KEY,
+    firstname VARCHAR(100) NOT NULL,
+    surname VARCHAR(100) NOT NULL,
+    motivation TEXT NOT NULL,
+    share INT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS projection_prize_laureates (
+    prize_id INT NOT NULL,
+    laureate_id INT NOT NULL,
+    PRIMARY KEY (prize_id, laureate_id)
+);
+
+CREATE INDEX IF NOT EXISTS projection_prize_laureates_laureate_idx ON projection_prize_laureates (laureate_id);
+
+CREATE TABLE IF NOT EXISTS category_decade_stats (
+    category VARCHAR(100) NOT NULL,
+    decade INT NOT NULL,
+    prizes_count INT NOT NULL,
+    laureates_count INT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (category, decade)
+);
+
+-- prizes is an array of {"id", "year", "category"} ordered by year
+CREATE TABLE IF NOT EXISTS laureate_profiles (
+    laureate_id INT PRIMARY KEY,
+    firstname VARCHAR(100) NOT NULL,
+    surname VARCHAR(100) NOT NULL,
+    motivation TEXT NOT NULL,
+    share INT NOT NULL,
+    prizes_count INT NOT NULL,
+    prizes JSONB NOT NULL DEFAULT '[]',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Ids of applied events, so redelivered and republished events are applied once
+CREATE TABLE IF NOT EXISTS projection_events (
+    event_id VARCHAR(64) PRIMARY KEY,
+    stream_sequence BIGINT NOT NULL,
+    applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Stream sequence of the last applied event per projection
+CREATE TABLE IF NOT EXISTS projection_checkpoints (
+    name VARCHAR(100) PRIMARY KEY,
+    stream_sequence BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- HTTP callbacks of partners. event_types holds event types, "prize.*", "laureate.*" or "*".
+-- Endpoints are disabled (active = false) after disable_after consecutive failed attempts.
+CREATE TABLE IF NOT EXISTS webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    url TEXT NOT NULL,
+    event_types TEXT[] NOT NULL,
+    secret TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    consecutive_failures INT NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMPTZ,
+    disabled_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Delivery history, one row per webhook and event. status is pending, delivered or failed.
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id BIGSERIAL PRIMARY KEY,
+    webhook_id BIGINT NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id VARCHAR(64) NOT NULL,
+    event_type VARCHAR(255) NOT NULL,
+    payload BYTEA NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    response_status INT,
+    last_error TEXT,
+    duration_ms INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    UNIQUE (webhook_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS webhook_deliveries_webhook_idx ON webhook_deliveries (webhook_id, id DESC);
+
+-- Analytics (/api/v1/analytics) are read from materialized views,
+-- refreshed by the importer after every import (parser.ParseAndStore).
+-- A prize without laureates was not awarded that year.
+CREATE MATERIALIZED VIEW IF NOT EXISTS analytics_prizes AS
+SELECT p.id AS prize_id,
+       p.year,
+       p.year / 10 * 10 AS decade,
+       p.category,
+       COUNT(pl.laureate_id)::INT AS laureates_count
+FROM prizes p
+LEFT JOIN prizes_to_laureates pl ON pl.prize_id = p.id
+GROUP BY p.id;
+
+-- Unique indexes allow REFRESH MATERIALIZED VIEW CONCURRENTLY, which does not block reads
+CREATE UNIQUE INDEX IF NOT EXISTS analytics_prizes_id_idx ON analytics_prizes (prize_id);
+
+CREATE MATERIALIZED VIEW IF NOT EXISTS analytics_laureates AS
+SELECT l.id AS laureate_id,
+       l.firstname,
+       COALESCE(l.surname, '')::VARCHAR(100) AS surname,
+       l.share,
+       COUNT(p.id)::INT AS prizes_count,
+       COALESCE(MIN(p.year), 0)::INT AS first_year,
+       COALESCE(MAX(p.year), 0)::INT AS last_year
+FROM laureates l
+LEFT JOIN prizes_to_laureates pl ON pl.laureate_id = l.id
+LEFT JOIN prizes p ON p.id = pl.prize_id
+GROUP BY l.id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS analytics_laureates_id_idx ON analytics_laureates (laureate_id);
+
+-- One row per awarded laureate of a prize with the type and gender of the laureate,
+-- counts of persons by gender and of organizations per year, decade or category
+CREATE MATERIALIZED VIEW IF NOT EXISTS analytics_awards AS
+SELECT pl.prize_id,
+       pl.laureate_id,
+       p.year,
+       p.year / 10 * 10 AS decade,
+       p.category,
+       l.type,
+       l.gender
+FROM prizes_to_laureates pl
+JOIN prizes p ON p.id = pl.prize_id
+JOIN laureates l ON l.id = pl.laureate_id;
+
+CREATE UNIQUE INDEX IF NOT EXISTS analytics_awards_id_idx ON analytics_awards (prize_id, laureate_id);
+
+-- Single row table with the version of this schema.
+-- Bump the version here and postgres.SchemaVersion together on every schema change.
+CREATE TABLE IF NOT EXISTS schema_version (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    version INT NOT NULL
+);
+
+INSERT INTO schema_version (version) VALUES (9)
+ON CONFLICT (id) DO UPDATE SET version = EXCLUDED.version;
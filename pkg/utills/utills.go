@@ -2,6 +2,7 @@ package utills
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgtype"
 )
@@ -43,3 +44,25 @@ func StringToPgText(s string) pgtype.Text {
 	}
 	return res
 }
+
+// OptionalPgText converts an empty string to NULL
+func OptionalPgText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
+// PgDate parses a YYYY-MM-DD date, an empty or invalid date is NULL
+func PgDate(s string) pgtype.Date {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return pgtype.Date{}
+	}
+	return pgtype.Date{Time: t, Valid: true}
+}
+
+// FormatPgDate formats a date as YYYY-MM-DD, NULL as an empty string
+func FormatPgDate(d pgtype.Date) string {
+	if !d.Valid {
+		return ""
+	}
+	return d.Time.Format(time.DateOnly)
+}